@@ -3,8 +3,10 @@ package address
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
@@ -20,19 +22,75 @@ const (
 	getKeyPath      = "/:address/key/:key"
 	getESDTTokens   = "/:address/esdt"
 	getESDTBalance  = "/:address/esdt/:tokenIdentifier"
+	getProofPath    = "/:address/proof"
+	getKeyProofPath = "/:address/key/:key/proof"
+	getTxsPath      = "/:address/transactions"
+)
+
+const (
+	defaultAddressTransactionsPageSize = 20
+	maxAddressTransactionsPageSize     = 100
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
-	GetESDTBalance(address string, key string) (string, string, error)
-	GetAllESDTTokens(address string) ([]string, error)
+	GetBalance(address string, options AccountQueryOptions) (*big.Int, error)
+	GetUsername(address string, options AccountQueryOptions) (string, error)
+	GetValueForKey(address string, key string, options AccountQueryOptions) (string, error)
+	GetAccount(address string, options AccountQueryOptions) (state.UserAccountHandler, error)
+	GetESDTBalance(address string, key string, options AccountQueryOptions) (string, string, error)
+	GetAllESDTTokens(address string, options AccountQueryOptions) ([]string, error)
+	GetProof(address string, options AccountQueryOptions) (*AccountProof, error)
+	GetProofForKey(address string, key string, options AccountQueryOptions) (*AccountProof, error)
+	GetAddressTransactions(address string, beforeNonce uint64, size uint64) (*AddressTransactions, error)
 	IsInterfaceNil() bool
 }
 
+// AccountQueryOptions holds the options of an account query. If a block nonce or a block hash is provided,
+// the account is read from the state committed by that block instead of the current state
+type AccountQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
+}
+
+// IsHistorical returns true if the query targets the state of a past block
+func (options AccountQueryOptions) IsHistorical() bool {
+	return options.HasBlockNonce || len(options.BlockHash) > 0
+}
+
+// AccountProof holds an account, or a value from its data trie, together with the Merkle proofs
+// that prove it against the state root hash of a block
+type AccountProof struct {
+	Account          state.UserAccountHandler
+	BlockNonce       uint64
+	BlockHash        []byte
+	RootHash         []byte
+	Proof            [][]byte
+	Key              []byte
+	Value            []byte
+	DataTrieRootHash []byte
+	DataTrieProof    [][]byte
+}
+
+// AddressTransactions holds a page of the transactions history of an address, newest first, together with
+// the total number of indexed transactions of the address
+type AddressTransactions struct {
+	Transactions []*AddressTransaction `json:"transactions"`
+	Total        uint64                `json:"total"`
+}
+
+// AddressTransaction holds a transaction, smart contract result or receipt which touched an address
+type AddressTransaction struct {
+	Hash       string `json:"hash"`
+	Type       string `json:"type"`
+	BlockNonce uint64 `json:"blockNonce"`
+	BlockHash  string `json:"blockHash"`
+	Round      uint64 `json:"round"`
+	Epoch      uint32 `json:"epoch"`
+	Timestamp  uint64 `json:"timestamp"`
+}
+
 type accountResponse struct {
 	Address  string `json:"address"`
 	Nonce    uint64 `json:"nonce"`
@@ -43,6 +101,25 @@ type accountResponse struct {
 	RootHash []byte `json:"rootHash"`
 }
 
+type accountProofResponse struct {
+	Account    accountResponse `json:"account"`
+	BlockNonce uint64          `json:"blockNonce"`
+	BlockHash  string          `json:"blockHash"`
+	RootHash   string          `json:"rootHash"`
+	Proof      []string        `json:"proof"`
+}
+
+type keyProofResponse struct {
+	Key              string   `json:"key"`
+	Value            string   `json:"value"`
+	BlockNonce       uint64   `json:"blockNonce"`
+	BlockHash        string   `json:"blockHash"`
+	RootHash         string   `json:"rootHash"`
+	Proof            []string `json:"proof"`
+	DataTrieRootHash string   `json:"dataTrieRootHash"`
+	DataTrieProof    []string `json:"dataTrieProof"`
+}
+
 type esdtTokenData struct {
 	TokenIdentifier string `json:"tokenIdentifier"`
 	Balance         string `json:"balance"`
@@ -57,6 +134,9 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
 	router.RegisterHandler(http.MethodGet, getESDTBalance, GetESDTBalance)
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetESDTTokens)
+	router.RegisterHandler(http.MethodGet, getProofPath, GetProof)
+	router.RegisterHandler(http.MethodGet, getKeyProofPath, GetProofForKey)
+	router.RegisterHandler(http.MethodGet, getTxsPath, GetAddressTransactions)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -98,7 +178,20 @@ func GetAccount(c *gin.Context) {
 	}
 
 	addr := c.Param("address")
-	acc, err := facade.GetAccount(addr)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	acc, err := facade.GetAccount(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -141,7 +234,20 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := facade.GetBalance(addr)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, err := facade.GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -183,7 +289,20 @@ func GetUsername(c *gin.Context) {
 		return
 	}
 
-	userName, err := facade.GetUsername(addr)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetUsername.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	userName, err := facade.GetUsername(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -238,7 +357,20 @@ func GetValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := facade.GetValueForKey(addr, key)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := facade.GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -294,7 +426,20 @@ func GetESDTBalance(c *gin.Context) {
 		return
 	}
 
-	balance, freeze, err := facade.GetESDTBalance(addr, tokenIdentifier)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, freeze, err := facade.GetESDTBalance(addr, tokenIdentifier, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -343,7 +488,20 @@ func GetESDTTokens(c *gin.Context) {
 		return
 	}
 
-	tokens, err := facade.GetAllESDTTokens(addr)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokens, err := facade.GetAllESDTTokens(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -366,6 +524,276 @@ func GetESDTTokens(c *gin.Context) {
 	)
 }
 
+// GetProof returns the account for the address parameter together with its Merkle proof
+func GetProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	accountProof, err := facade.GetProof(addr, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	response := accountProofResponse{
+		Account:    accountResponseFromBaseAccount(addr, accountProof.Account),
+		BlockNonce: accountProof.BlockNonce,
+		BlockHash:  hex.EncodeToString(accountProof.BlockHash),
+		RootHash:   hex.EncodeToString(accountProof.RootHash),
+		Proof:      encodeProofNodes(accountProof.Proof),
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": response},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetProofForKey returns the value for the given address and key together with the Merkle proofs
+// of both the account and the key
+func GetProofForKey(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	keyProof, err := facade.GetProofForKey(addr, key, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	response := keyProofResponse{
+		Key:              hex.EncodeToString(keyProof.Key),
+		Value:            hex.EncodeToString(keyProof.Value),
+		BlockNonce:       keyProof.BlockNonce,
+		BlockHash:        hex.EncodeToString(keyProof.BlockHash),
+		RootHash:         hex.EncodeToString(keyProof.RootHash),
+		Proof:            encodeProofNodes(keyProof.Proof),
+		DataTrieRootHash: hex.EncodeToString(keyProof.DataTrieRootHash),
+		DataTrieProof:    encodeProofNodes(keyProof.DataTrieProof),
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": response},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetAddressTransactions returns a page of the transactions history of the address parameter, newest first.
+// The page is selected through the size and the optional beforeNonce query parameters. The next page is requested
+// by providing the block nonce of the last returned transaction as beforeNonce
+func GetAddressTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	beforeNonce, size, err := getPaginationParameters(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	transactions, err := facade.GetAddressTransactions(addr, beforeNonce, size)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": transactions.Transactions, "total": transactions.Total},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getPaginationParameters(c *gin.Context) (uint64, uint64, error) {
+	beforeNonce := uint64(math.MaxUint64)
+	beforeNonceStr := c.Request.URL.Query().Get("beforeNonce")
+	if beforeNonceStr != "" {
+		var err error
+		beforeNonce, err = strconv.ParseUint(beforeNonceStr, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%w: beforeNonce: %s", errors.ErrInvalidQueryParameter, err.Error())
+		}
+	}
+
+	size := uint64(defaultAddressTransactionsPageSize)
+	sizeStr := c.Request.URL.Query().Get("size")
+	if sizeStr != "" {
+		var err error
+		size, err = strconv.ParseUint(sizeStr, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%w: size: %s", errors.ErrInvalidQueryParameter, err.Error())
+		}
+	}
+	if size == 0 || size > maxAddressTransactionsPageSize {
+		return 0, 0, fmt.Errorf("%w: size should be between 1 and %d", errors.ErrInvalidQueryParameter, maxAddressTransactionsPageSize)
+	}
+
+	return beforeNonce, size, nil
+}
+
+func getAccountQueryOptions(c *gin.Context) (AccountQueryOptions, error) {
+	options := AccountQueryOptions{}
+
+	blockNonceStr := c.Request.URL.Query().Get("blockNonce")
+	if blockNonceStr != "" {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return AccountQueryOptions{}, fmt.Errorf("%w: %s", errors.ErrInvalidBlockNonce, err.Error())
+		}
+
+		options.BlockNonce = blockNonce
+		options.HasBlockNonce = true
+	}
+
+	blockHashStr := c.Request.URL.Query().Get("blockHash")
+	if blockHashStr != "" {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil {
+			return AccountQueryOptions{}, fmt.Errorf("%w: %s", errors.ErrInvalidBlockHash, err.Error())
+		}
+
+		options.BlockHash = blockHash
+	}
+
+	if options.HasBlockNonce && len(options.BlockHash) > 0 {
+		return AccountQueryOptions{}, errors.ErrBlockNonceAndHashProvided
+	}
+
+	return options, nil
+}
+
+func encodeProofNodes(proof [][]byte) []string {
+	encodedNodes := make([]string, 0, len(proof))
+	for _, encNode := range proof {
+		encodedNodes = append(encodedNodes, hex.EncodeToString(encNode))
+	}
+
+	return encodedNodes
+}
+
 func accountResponseFromBaseAccount(address string, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -1,10 +1,12 @@
 package address_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -85,6 +87,37 @@ type usernameResponse struct {
 	Code  string               `json:"code"`
 }
 
+type proofResponseData struct {
+	Proof struct {
+		Account struct {
+			Address string `json:"address"`
+			Balance string `json:"balance"`
+		} `json:"account"`
+		Key              string   `json:"key"`
+		Value            string   `json:"value"`
+		BlockNonce       uint64   `json:"blockNonce"`
+		RootHash         string   `json:"rootHash"`
+		Proof            []string `json:"proof"`
+		DataTrieRootHash string   `json:"dataTrieRootHash"`
+		DataTrieProof    []string `json:"dataTrieProof"`
+	} `json:"proof"`
+}
+
+type proofResponse struct {
+	Data  proofResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+type addressTransactionsResponse struct {
+	Data struct {
+		Transactions []*address.AddressTransaction `json:"transactions"`
+		Total        uint64                        `json:"total"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 func TestAddressRoute_EmptyTrailReturns404(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{}
@@ -231,6 +264,87 @@ func TestGetBalance_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	assert.Equal(t, response.Error, apiErrors.ErrInvalidAppContext.Error())
 }
 
+func TestGetBalance_InvalidBlockNonceShouldError(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		BalanceHandler: func(s string) (i *big.Int, e error) {
+			return big.NewInt(0), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=not-a-number", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetBalance_InvalidBlockHashShouldError(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		BalanceHandler: func(s string) (i *big.Int, e error) {
+			return big.NewInt(0), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockHash=not-hex", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockHash.Error()))
+}
+
+func TestGetBalance_BlockNonceAndBlockHashShouldError(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		BalanceHandler: func(s string) (i *big.Int, e error) {
+			return big.NewInt(0), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=10&blockHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrBlockNonceAndHashProvided.Error()))
+}
+
+func TestGetBalance_AtBlockNonceShouldWork(t *testing.T) {
+	t.Parallel()
+	amount := big.NewInt(10)
+	facade := mock.Facade{
+		BalanceHandler: func(s string) (i *big.Int, e error) {
+			return amount, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, amount.String(), getValueForKey(response.Data, "balance"))
+}
+
 func TestGetValueForKey_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -595,6 +709,218 @@ func TestGetESDTTokens_ShouldWork(t *testing.T) {
 	assert.Equal(t, []string{testValue1, testValue2}, esdtTokenResponseObj.Data.Tokens)
 }
 
+func TestGetProof_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetProof_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetProofCalled: func(_ string) (*address.AccountProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResponseObj := proofResponse{}
+	loadResponse(resp.Body, &proofResponseObj)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(proofResponseObj.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(proofResponseObj.Error, expectedErr.Error()))
+}
+
+func TestGetProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	account, _ := state.NewUserAccount([]byte(testAddress))
+	_ = account.AddToBalance(big.NewInt(100))
+	facade := mock.Facade{
+		GetProofCalled: func(addr string) (*address.AccountProof, error) {
+			assert.Equal(t, testAddress, addr)
+			return &address.AccountProof{
+				Account:    account,
+				BlockNonce: 37,
+				RootHash:   []byte("root hash"),
+				Proof:      [][]byte{[]byte("node1"), []byte("node2")},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/proof", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResponseObj := proofResponse{}
+	loadResponse(resp.Body, &proofResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "100", proofResponseObj.Data.Proof.Account.Balance)
+	assert.Equal(t, uint64(37), proofResponseObj.Data.Proof.BlockNonce)
+	assert.Equal(t, hex.EncodeToString([]byte("root hash")), proofResponseObj.Data.Proof.RootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("node1")), hex.EncodeToString([]byte("node2"))}, proofResponseObj.Data.Proof.Proof)
+}
+
+func TestGetProofForKey_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetProofForKeyCalled: func(_ string, _ string) (*address.AccountProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/key/aabb/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResponseObj := proofResponse{}
+	loadResponse(resp.Body, &proofResponseObj)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(proofResponseObj.Error, expectedErr.Error()))
+}
+
+func TestGetProofForKey_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testKey := "aabb"
+	account, _ := state.NewUserAccount([]byte(testAddress))
+	facade := mock.Facade{
+		GetProofForKeyCalled: func(addr string, key string) (*address.AccountProof, error) {
+			assert.Equal(t, testAddress, addr)
+			assert.Equal(t, testKey, key)
+			return &address.AccountProof{
+				Account:          account,
+				RootHash:         []byte("root hash"),
+				Proof:            [][]byte{[]byte("node1")},
+				Key:              []byte{0xaa, 0xbb},
+				Value:            []byte("value"),
+				DataTrieRootHash: []byte("data trie root hash"),
+				DataTrieProof:    [][]byte{[]byte("node2")},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/key/%s/proof", testAddress, testKey), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResponseObj := proofResponse{}
+	loadResponse(resp.Body, &proofResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, testKey, proofResponseObj.Data.Proof.Key)
+	assert.Equal(t, hex.EncodeToString([]byte("value")), proofResponseObj.Data.Proof.Value)
+	assert.Equal(t, hex.EncodeToString([]byte("data trie root hash")), proofResponseObj.Data.Proof.DataTrieRootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("node2"))}, proofResponseObj.Data.Proof.DataTrieProof)
+}
+
+func TestGetAddressTransactions_InvalidSizeShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAddressTransactionsCalled: func(_ string, _ uint64, _ uint64) (*address.AddressTransactions, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	for _, query := range []string{"size=0", "size=101", "size=abc", "beforeNonce=-1"} {
+		req, _ := http.NewRequest("GET", "/address/testAddress/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := addressTransactionsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	}
+}
+
+func TestGetAddressTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAddressTransactionsCalled: func(_ string, beforeNonce uint64, size uint64) (*address.AddressTransactions, error) {
+			assert.Equal(t, uint64(math.MaxUint64), beforeNonce)
+			assert.Equal(t, uint64(20), size)
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAddressTransactions.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAddressTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	facade := mock.Facade{
+		GetAddressTransactionsCalled: func(addr string, beforeNonce uint64, size uint64) (*address.AddressTransactions, error) {
+			assert.Equal(t, testAddress, addr)
+			assert.Equal(t, uint64(10), beforeNonce)
+			assert.Equal(t, uint64(5), size)
+			return &address.AddressTransactions{
+				Transactions: []*address.AddressTransaction{
+					{Hash: "aa", Type: "normal", BlockNonce: 37},
+				},
+				Total: 11,
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?beforeNonce=10&size=5", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(11), response.Data.Total)
+	assert.Equal(t, 1, len(response.Data.Transactions))
+	assert.Equal(t, "aa", response.Data.Transactions[0].Hash)
+	assert.Equal(t, uint64(37), response.Data.Transactions[0].BlockNonce)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -606,6 +932,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
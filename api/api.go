@@ -8,11 +8,15 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/state"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -148,6 +152,30 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		block.Routes(wrappedBlockRouter)
 	}
 
+	stateRoutes := ws.Group("/state")
+	wrappedStateRouter, err := wrapper.NewRouterWrapper("state", stateRoutes, routesConfig)
+	if err == nil {
+		state.Routes(wrappedStateRouter)
+	}
+
+	delegationRoutes := ws.Group("/delegation")
+	wrappedDelegationRouter, err := wrapper.NewRouterWrapper("delegation", delegationRoutes, routesConfig)
+	if err == nil {
+		delegation.Routes(wrappedDelegationRouter)
+	}
+
+	governanceRoutes := ws.Group("/governance")
+	wrappedGovernanceRouter, err := wrapper.NewRouterWrapper("governance", governanceRoutes, routesConfig)
+	if err == nil {
+		governance.Routes(wrappedGovernanceRouter)
+	}
+
+	eventsRoutes := ws.Group("/events")
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
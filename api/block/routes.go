@@ -15,8 +15,20 @@ import (
 )
 
 const (
-	getBlockByNoncePath = "/by-nonce/:nonce"
-	getBlockByHashPath  = "/by-hash/:hash"
+	getBlockByNoncePath      = "/by-nonce/:nonce"
+	getBlockByHashPath       = "/by-hash/:hash"
+	getLatestBlockPath       = "/latest"
+	getBlocksByRangePath     = "/range"
+	getHyperblockByNoncePath = "/hyperblock/by-nonce/:nonce"
+	getHyperblockByHashPath  = "/hyperblock/by-hash/:hash"
+)
+
+const (
+	// maxBlocksInRange is the maximum number of blocks which can be fetched with a single range request
+	maxBlocksInRange = 100
+	// maxBlocksInRangeWithTxs is the maximum number of blocks which can be fetched with a single range request
+	// when the transactions are also requested
+	maxBlocksInRangeWithTxs = 20
 )
 
 var log = logger.GetOrCreate("api/block")
@@ -25,6 +37,10 @@ var log = logger.GetOrCreate("api/block")
 type BlockService interface {
 	GetBlockByHash(hash string, withTxs bool) (*APIBlock, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*APIBlock, error)
+	GetLatestBlock(withTxs bool) (*APIBlock, error)
+	GetBlocksByRange(from uint64, to uint64, withTxs bool) ([]*APIBlock, error)
+	GetHyperblockByNonce(nonce uint64) (*APIHyperblock, error)
+	GetHyperblockByHash(hash string) (*APIHyperblock, error)
 }
 
 // APIBlock represents the structure for block that is returned by api routes
@@ -56,10 +72,30 @@ type APIMiniBlock struct {
 	Transactions     []*transaction.ApiTransactionResult `json:"transactions,omitempty"`
 }
 
+// APIHyperblock represents a metablock together with all the shard blocks it notarizes. The transactions of
+// the metablock and of the notarized shard blocks are flattened in a single list. The metachain does not store
+// the intra-shard miniblocks and transactions, so HasAllTransactions is false whenever some of the transactions
+// announced by the notarized blocks could not be fetched from the local storage
+type APIHyperblock struct {
+	Nonce              uint64                              `json:"nonce"`
+	Round              uint64                              `json:"round"`
+	Hash               string                              `json:"hash"`
+	PrevBlockHash      string                              `json:"prevBlockHash"`
+	Epoch              uint32                              `json:"epoch"`
+	NumTxs             uint32                              `json:"numTxs"`
+	HasAllTransactions bool                                `json:"hasAllTransactions"`
+	ShardBlocks        []*APIBlock                         `json:"shardBlocks"`
+	Transactions       []*transaction.ApiTransactionResult `json:"transactions"`
+}
+
 // Routes defines block related routes
 func Routes(routes *wrapper.RouterWrapper) {
 	routes.RegisterHandler(http.MethodGet, getBlockByNoncePath, getBlockByNonce)
 	routes.RegisterHandler(http.MethodGet, getBlockByHashPath, getBlockByHash)
+	routes.RegisterHandler(http.MethodGet, getLatestBlockPath, getLatestBlock)
+	routes.RegisterHandler(http.MethodGet, getBlocksByRangePath, getBlocksByRange)
+	routes.RegisterHandler(http.MethodGet, getHyperblockByNoncePath, getHyperblockByNonce)
+	routes.RegisterHandler(http.MethodGet, getHyperblockByHashPath, getHyperblockByHash)
 }
 
 func getBlockByNonce(c *gin.Context) {
@@ -143,6 +179,168 @@ func getBlockByHash(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
 }
 
+func getLatestBlock(c *gin.Context) {
+	ef, ok := c.MustGet("facade").(BlockService)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return
+	}
+
+	withTxs, err := getQueryParamWithTxs(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	block, err := ef.GetLatestBlock(withTxs)
+	log.Debug(fmt.Sprintf("GetLatestBlock took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
+}
+
+func getBlocksByRange(c *gin.Context) {
+	ef, ok := c.MustGet("facade").(BlockService)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return
+	}
+
+	withTxs, err := getQueryParamWithTxs(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	from, to, err := getQueryParamsRange(c, withTxs)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	blocks, err := ef.GetBlocksByRange(from, to, withTxs)
+	log.Debug(fmt.Sprintf("GetBlocksByRange took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"blocks": blocks}, "", shared.ReturnCodeSuccess)
+}
+
+func getHyperblockByNonce(c *gin.Context) {
+	ef, ok := c.MustGet("facade").(BlockService)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return
+	}
+
+	nonce, err := getQueryParamNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	hyperblock, err := ef.GetHyperblockByNonce(nonce)
+	log.Debug(fmt.Sprintf("GetHyperblockByNonce took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"hyperblock": hyperblock}, "", shared.ReturnCodeSuccess)
+}
+
+func getHyperblockByHash(c *gin.Context) {
+	ef, ok := c.MustGet("facade").(BlockService)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return
+	}
+
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyBlockHash.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	hyperblock, err := ef.GetHyperblockByHash(hash)
+	log.Debug(fmt.Sprintf("GetHyperblockByHash took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"hyperblock": hyperblock}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamsRange(c *gin.Context, withTxs bool) (uint64, uint64, error) {
+	from, err := strconv.ParseUint(c.Request.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: from", errors.ErrInvalidQueryParameter)
+	}
+
+	to, err := strconv.ParseUint(c.Request.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: to", errors.ErrInvalidQueryParameter)
+	}
+
+	if from > to {
+		return 0, 0, errors.ErrInvalidBlockRange
+	}
+
+	maxBlocks := uint64(maxBlocksInRange)
+	if withTxs {
+		maxBlocks = maxBlocksInRangeWithTxs
+	}
+	if to-from >= maxBlocks {
+		return 0, 0, fmt.Errorf("%w: at most %d blocks can be requested", errors.ErrInvalidBlockRange, maxBlocks)
+	}
+
+	return from, to, nil
+}
+
 func getQueryParamWithTxs(c *gin.Context) (bool, error) {
 	withTxsStr := c.Request.URL.Query().Get("withTxs")
 	if withTxsStr == "" {
@@ -0,0 +1,396 @@
+package block_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockResponseData struct {
+	Block *block.APIBlock `json:"block"`
+}
+
+type blockResponse struct {
+	Data  blockResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+type blocksResponseData struct {
+	Blocks []*block.APIBlock `json:"blocks"`
+}
+
+type blocksResponse struct {
+	Data  blocksResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+type hyperblockResponseData struct {
+	Hyperblock *block.APIHyperblock `json:"hyperblock"`
+}
+
+type hyperblockResponse struct {
+	Data  hyperblockResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+var expectedErr = errors.New("expected error")
+
+func TestBlockRoutes_WrongFacadeShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	paths := []string{
+		"/block/latest",
+		"/block/range?from=1&to=2",
+		"/block/hyperblock/by-nonce/1",
+		"/block/hyperblock/by-hash/aa",
+	}
+	for _, path := range paths {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code, path)
+		assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error, path)
+	}
+}
+
+//------- latest
+
+func TestGetLatestBlock_InvalidWithTxsShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/block/latest?withTxs=not a bool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetLatestBlock_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetLatestBlockCalled: func(_ bool) (*block.APIBlock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/block/latest", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBlock.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetLatestBlock_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := &block.APIBlock{Nonce: 37, Hash: "aabb"}
+	facade := &mock.Facade{
+		GetLatestBlockCalled: func(withTxs bool) (*block.APIBlock, error) {
+			assert.True(t, withTxs)
+			return expectedBlock, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/block/latest?withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedBlock, response.Data.Block)
+}
+
+//------- range
+
+func TestGetBlocksByRange_InvalidRangeShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBlocksByRangeCalled: func(_ uint64, _ uint64, _ bool) ([]*block.APIBlock, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	invalidQueries := map[string]error{
+		"":                              apiErrors.ErrInvalidQueryParameter,
+		"?to=10":                        apiErrors.ErrInvalidQueryParameter,
+		"?from=1":                       apiErrors.ErrInvalidQueryParameter,
+		"?from=a&to=10":                 apiErrors.ErrInvalidQueryParameter,
+		"?from=1&to=b":                  apiErrors.ErrInvalidQueryParameter,
+		"?from=1&to=10&withTxs=invalid": apiErrors.ErrInvalidQueryParameter,
+		"?from=11&to=10":                apiErrors.ErrInvalidBlockRange,
+		"?from=0&to=100":                apiErrors.ErrInvalidBlockRange,
+		"?from=1&to=21&withTxs=true":    apiErrors.ErrInvalidBlockRange,
+	}
+	for query, expectedQueryErr := range invalidQueries {
+		req, _ := http.NewRequest("GET", "/block/range"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, expectedQueryErr.Error()), query)
+	}
+}
+
+func TestGetBlocksByRange_MaxBlocksShouldWork(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	facade := &mock.Facade{
+		GetBlocksByRangeCalled: func(from uint64, to uint64, withTxs bool) ([]*block.APIBlock, error) {
+			numCalls++
+			if withTxs {
+				assert.Equal(t, uint64(20), to-from+1)
+			} else {
+				assert.Equal(t, uint64(100), to-from+1)
+			}
+			return make([]*block.APIBlock, 0), nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	for _, query := range []string{"?from=0&to=99", "?from=1&to=20&withTxs=true"} {
+		req, _ := http.NewRequest("GET", "/block/range"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code, query)
+	}
+	assert.Equal(t, 2, numCalls)
+}
+
+func TestGetBlocksByRange_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBlocksByRangeCalled: func(_ uint64, _ uint64, _ bool) ([]*block.APIBlock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/block/range?from=1&to=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBlock.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlocksByRange_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlocks := []*block.APIBlock{{Nonce: 1}, {Nonce: 2}}
+	facade := &mock.Facade{
+		GetBlocksByRangeCalled: func(from uint64, to uint64, withTxs bool) ([]*block.APIBlock, error) {
+			assert.Equal(t, uint64(1), from)
+			assert.Equal(t, uint64(2), to)
+			assert.False(t, withTxs)
+			return expectedBlocks, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/block/range?from=1&to=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedBlocks, response.Data.Blocks)
+}
+
+//------- hyperblock
+
+func TestGetHyperblockByNonce_InvalidNonceShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/block/hyperblock/by-nonce/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetHyperblockByNonce_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetHyperblockByNonceCalled: func(_ uint64) (*block.APIHyperblock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/block/hyperblock/by-nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBlock.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetHyperblockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := &block.APIHyperblock{
+		Nonce:       37,
+		ShardBlocks: []*block.APIBlock{{Nonce: 38, Shard: 1}},
+	}
+	facade := &mock.Facade{
+		GetHyperblockByNonceCalled: func(nonce uint64) (*block.APIHyperblock, error) {
+			assert.Equal(t, uint64(37), nonce)
+			return expectedHyperblock, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/block/hyperblock/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.Hyperblock)
+	assert.Equal(t, expectedHyperblock.Nonce, response.Data.Hyperblock.Nonce)
+	assert.Equal(t, expectedHyperblock.ShardBlocks, response.Data.Hyperblock.ShardBlocks)
+}
+
+func TestGetHyperblockByHash_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetHyperblockByHashCalled: func(_ string) (*block.APIHyperblock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/block/hyperblock/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBlock.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetHyperblockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := &block.APIHyperblock{
+		Hash:               "aabb",
+		HasAllTransactions: true,
+	}
+	facade := &mock.Facade{
+		GetHyperblockByHashCalled: func(hash string) (*block.APIHyperblock, error) {
+			assert.Equal(t, "aabb", hash)
+			return expectedHyperblock, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/block/hyperblock/by-hash/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.Hyperblock)
+	assert.Equal(t, expectedHyperblock.Hash, response.Data.Hyperblock.Hash)
+	assert.True(t, response.Data.Hyperblock.HasAllTransactions)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	_ = jsonParser.Decode(destination)
+}
+
+func startNodeServer(handler block.BlockService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	blockRoutes := ws.Group("/block")
+	if handler != nil {
+		blockRoutes.Use(middleware.WithFacade(handler))
+	}
+	blockRouteWrapper, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
+	block.Routes(blockRouteWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	blockRoutes := ws.Group("/block")
+	blockRouteWrapper, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
+	block.Routes(blockRouteWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: true},
+					{Name: "/latest", Open: true},
+					{Name: "/range", Open: true},
+					{Name: "/hyperblock/by-nonce/:nonce", Open: true},
+					{Name: "/hyperblock/by-hash/:hash", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package delegation_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type contractsResponseData struct {
+	Contracts []*delegation.ContractMetaData `json:"contracts"`
+}
+
+type contractsResponse struct {
+	Data  contractsResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestGetContracts_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest("GET", "/delegation/contracts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetContracts_WrongFacadeShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/delegation/contracts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetContracts_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetDelegationContractsMetaDataCalled: func() ([]*delegation.ContractMetaData, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/delegation/contracts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetDelegationContracts.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetContracts_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedContracts := []*delegation.ContractMetaData{
+		{
+			Address:    "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqylllslmq6y6",
+			Name:       "staking provider",
+			Website:    "provider.com",
+			Identifier: "provider",
+		},
+	}
+	facade := &mock.Facade{
+		GetDelegationContractsMetaDataCalled: func() ([]*delegation.ContractMetaData, error) {
+			return expectedContracts, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/delegation/contracts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := contractsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedContracts, response.Data.Contracts)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	_ = jsonParser.Decode(destination)
+}
+
+func startNodeServer(handler delegation.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	delegationRoutes := ws.Group("/delegation")
+	if handler != nil {
+		delegationRoutes.Use(middleware.WithFacade(handler))
+	}
+	delegationRouteWrapper, _ := wrapper.NewRouterWrapper("delegation", delegationRoutes, getRoutesConfig())
+	delegation.Routes(delegationRouteWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	delegationRoutes := ws.Group("/delegation")
+	delegationRouteWrapper, _ := wrapper.NewRouterWrapper("delegation", delegationRoutes, getRoutesConfig())
+	delegation.Routes(delegationRouteWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"delegation": {
+				Routes: []config.RouteConfig{
+					{Name: "/contracts", Open: true},
+				},
+			},
+		},
+	}
+}
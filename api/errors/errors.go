@@ -28,6 +28,12 @@ var ErrGetValueForKey = errors.New("get value for key error")
 // ErrGetESDTTokens signals an error in getting esdt tokens for a given address
 var ErrGetESDTTokens = errors.New("get esdt tokens for account error")
 
+// ErrGetAddressTransactions signals an error in getting the transactions history of an address
+var ErrGetAddressTransactions = errors.New("get address transactions error")
+
+// ErrGetProof signals an error in getting the Merkle proof for an account or an account's key
+var ErrGetProof = errors.New("get proof error")
+
 // ErrGetESDTBalance signals an error in getting esdt balance for given address
 var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 
@@ -49,6 +55,15 @@ var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 // ErrInvalidBlockNonce signals an invalid block nonce was provided
 var ErrInvalidBlockNonce = errors.New("invalid block nonce")
 
+// ErrInvalidBlockHash signals that an invalid block hash has been provided
+var ErrInvalidBlockHash = errors.New("invalid block hash")
+
+// ErrBlockNonceAndRootHashProvided signals that both a block nonce and a root hash have been provided
+var ErrBlockNonceAndRootHashProvided = errors.New("only one of block nonce and root hash can be provided")
+
+// ErrBlockNonceAndHashProvided signals that both a block nonce and a block hash have been provided
+var ErrBlockNonceAndHashProvided = errors.New("only one of block nonce and block hash can be provided")
+
 // ErrInvalidQueryParameter signals and invalid query parameter was provided
 var ErrInvalidQueryParameter = errors.New("invalid query parameter")
 
@@ -61,6 +76,9 @@ var ErrGetTransaction = errors.New("getting transaction failed")
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrInvalidBlockRange signals that an invalid range of blocks has been provided
+var ErrInvalidBlockRange = errors.New("invalid block range")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -69,3 +87,33 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrGetStateDiff signals an error happening when trying to compute a state diff
+var ErrGetStateDiff = errors.New("getting state diff failed")
+
+// ErrGetDelegationContracts signals an error happening when trying to fetch the delegation contracts metadata
+var ErrGetDelegationContracts = errors.New("getting delegation contracts failed")
+
+// ErrGetGovernanceProposal signals an error happening when trying to fetch a governance proposal
+var ErrGetGovernanceProposal = errors.New("getting governance proposal failed")
+
+// ErrEmptyProposalReference signals that an empty governance proposal reference has been provided
+var ErrEmptyProposalReference = errors.New("empty proposal reference")
+
+// ErrGetVotingPower signals an error happening when trying to fetch the governance voting power of an address
+var ErrGetVotingPower = errors.New("getting voting power failed")
+
+// ErrGetStakingQueue signals an error happening when trying to fetch the staking queue
+var ErrGetStakingQueue = errors.New("getting staking queue failed")
+
+// ErrGetOwnerNodes signals an error happening when trying to fetch the nodes of an owner
+var ErrGetOwnerNodes = errors.New("getting owner nodes failed")
+
+// ErrGetLogEvents signals an error happening when trying to fetch log events
+var ErrGetLogEvents = errors.New("getting log events failed")
+
+// ErrEmptyLogIdentifier signals that an empty log event identifier has been provided
+var ErrEmptyLogIdentifier = errors.New("empty log identifier")
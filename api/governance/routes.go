@@ -0,0 +1,176 @@
+package governance
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getProposalPath    = "/proposal/:reference"
+	getVotingPowerPath = "/voting-power/:address"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetGovernanceProposal(reference string) (*Proposal, error)
+	GetGovernanceVotingPower(address string) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// Proposal represents a governance proposal together with its vote tally
+type Proposal struct {
+	Reference      string          `json:"reference"`
+	Issuer         string          `json:"issuer"`
+	StartVoteNonce uint64          `json:"startVoteNonce"`
+	EndVoteNonce   uint64          `json:"endVoteNonce"`
+	Status         string          `json:"status"`
+	Passed         bool            `json:"passed"`
+	Votes          *ProposalVotes  `json:"votes"`
+	Quorum         *ProposalQuorum `json:"quorum"`
+}
+
+// ProposalVotes holds the number of votes cast for each option of a proposal
+type ProposalVotes struct {
+	Yes     int64 `json:"yes"`
+	No      int64 `json:"no"`
+	Veto    int64 `json:"veto"`
+	Abstain int64 `json:"abstain"`
+	Total   int64 `json:"total"`
+}
+
+// ProposalQuorum holds the governance thresholds and how the current vote tally relates to them
+type ProposalQuorum struct {
+	MinQuorum        int64 `json:"minQuorum"`
+	MinPassThreshold int64 `json:"minPassThreshold"`
+	MinVetoThreshold int64 `json:"minVetoThreshold"`
+	QuorumReached    bool  `json:"quorumReached"`
+	Vetoed           bool  `json:"vetoed"`
+	Passing          bool  `json:"passing"`
+}
+
+// Routes defines governance related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getProposalPath, GetProposal)
+	router.RegisterHandler(http.MethodGet, getVotingPowerPath, GetVotingPower)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetProposal returns the governance proposal with the given reference, its status and its vote tally
+func GetProposal(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	reference := c.Param("reference")
+	if reference == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposal.Error(), errors.ErrEmptyProposalReference.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	proposal, err := facade.GetGovernanceProposal(reference)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposal.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposal": proposal},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetVotingPower returns the governance voting power of the given address
+func GetVotingPower(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetVotingPower.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	votingPower, err := facade.GetGovernanceVotingPower(addr)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetVotingPower.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"votingPower": votingPower},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -0,0 +1,218 @@
+package governance_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type proposalResponseData struct {
+	Proposal governance.Proposal `json:"proposal"`
+}
+
+type proposalResponse struct {
+	Data  proposalResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
+type votingPowerResponseData struct {
+	VotingPower uint64 `json:"votingPower"`
+}
+
+type votingPowerResponse struct {
+	Data  votingPowerResponseData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+const testReference = "0123456789012345678901234567890123456789"
+
+func TestGetProposal_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest("GET", "/governance/proposal/"+testReference, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetProposal_WrongFacadeShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/governance/proposal/"+testReference, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetProposal_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetGovernanceProposalCalled: func(_ string) (*governance.Proposal, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/governance/proposal/"+testReference, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceProposal.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetProposal_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedProposal := governance.Proposal{
+		Reference:      testReference,
+		Issuer:         "erd1issuer",
+		StartVoteNonce: 100,
+		EndVoteNonce:   1000,
+		Status:         "active",
+		Passed:         false,
+		Votes: &governance.ProposalVotes{
+			Yes:   3,
+			No:    1,
+			Total: 4,
+		},
+		Quorum: &governance.ProposalQuorum{
+			MinQuorum:        2,
+			MinPassThreshold: 1,
+			MinVetoThreshold: 2,
+			QuorumReached:    true,
+			Passing:          true,
+		},
+	}
+	facade := &mock.Facade{
+		GetGovernanceProposalCalled: func(reference string) (*governance.Proposal, error) {
+			assert.Equal(t, testReference, reference)
+			return &expectedProposal, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/governance/proposal/"+testReference, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proposalResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedProposal, response.Data.Proposal)
+}
+
+func TestGetVotingPower_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetGovernanceVotingPowerCalled: func(_ string) (uint64, error) {
+			return 0, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/governance/voting-power/erd1address", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetVotingPower.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetVotingPower_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetGovernanceVotingPowerCalled: func(address string) (uint64, error) {
+			assert.Equal(t, "erd1address", address)
+			return 7, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/governance/voting-power/erd1address", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := votingPowerResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, uint64(7), response.Data.VotingPower)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	_ = jsonParser.Decode(destination)
+}
+
+func startNodeServer(handler governance.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	governanceRoutes := ws.Group("/governance")
+	if handler != nil {
+		governanceRoutes.Use(middleware.WithFacade(handler))
+	}
+	governanceRouteWrapper, _ := wrapper.NewRouterWrapper("governance", governanceRoutes, getRoutesConfig())
+	governance.Routes(governanceRouteWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	governanceRoutes := ws.Group("/governance")
+	governanceRouteWrapper, _ := wrapper.NewRouterWrapper("governance", governanceRoutes, getRoutesConfig())
+	governance.Routes(governanceRouteWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"governance": {
+				Routes: []config.RouteConfig{
+					{Name: "/proposal/:reference", Open: true},
+					{Name: "/voting-power/:address", Open: true},
+				},
+			},
+		},
+	}
+}
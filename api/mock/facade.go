@@ -4,6 +4,13 @@ import (
 	"encoding/hex"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	apiState "github.com/ElrondNetwork/elrond-go/api/state"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -26,7 +33,7 @@ type Facade struct {
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32, guardianHex string, guardianSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler              func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction) error
 	SendBulkTransactionsHandler             func(txs []*transaction.Transaction) (uint64, error)
@@ -45,10 +52,143 @@ type Facade struct {
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
 	GetESDTBalanceCalled                    func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                  func(address string) ([]string, error)
+	GetProofCalled                          func(address string) (*address.AccountProof, error)
+	GetAddressTransactionsCalled            func(address string, beforeNonce uint64, size uint64) (*address.AddressTransactions, error)
+	GetProofForKeyCalled                    func(address string, key string) (*address.AccountProof, error)
+	GetStateDiffCalled                      func(fromRootHash string, toRootHash string) (*apiState.StateDiff, error)
+	GetDelegationContractsMetaDataCalled    func() ([]*delegation.ContractMetaData, error)
+	GetGovernanceProposalCalled             func(reference string) (*governance.Proposal, error)
+	GetGovernanceVotingPowerCalled          func(address string) (uint64, error)
+	GetStakingQueueCalled                   func() ([]*validator.QueuedNode, error)
+	GetOwnerNodesStatusCalled               func(address string) (*validator.OwnerNodes, error)
+	GetLogEventsByIdentifierCalled          func(identifier string) ([]*events.LogEvent, error)
+	GetBlockByHashCalled                    func(hash string, withTxs bool) (*block.APIBlock, error)
+	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetLatestBlockCalled                    func(withTxs bool) (*block.APIBlock, error)
+	GetBlocksByRangeCalled                  func(from uint64, to uint64, withTxs bool) ([]*block.APIBlock, error)
+	GetHyperblockByNonceCalled              func(nonce uint64) (*block.APIHyperblock, error)
+	GetHyperblockByHashCalled               func(hash string) (*block.APIHyperblock, error)
+}
+
+// GetBlockByHash -
+func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error) {
+	if f.GetBlockByHashCalled != nil {
+		return f.GetBlockByHashCalled(hash, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetBlockByNonce -
+func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error) {
+	if f.GetBlockByNonceCalled != nil {
+		return f.GetBlockByNonceCalled(nonce, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetLatestBlock -
+func (f *Facade) GetLatestBlock(withTxs bool) (*block.APIBlock, error) {
+	if f.GetLatestBlockCalled != nil {
+		return f.GetLatestBlockCalled(withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetBlocksByRange -
+func (f *Facade) GetBlocksByRange(from uint64, to uint64, withTxs bool) ([]*block.APIBlock, error) {
+	if f.GetBlocksByRangeCalled != nil {
+		return f.GetBlocksByRangeCalled(from, to, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (f *Facade) GetHyperblockByNonce(nonce uint64) (*block.APIHyperblock, error) {
+	if f.GetHyperblockByNonceCalled != nil {
+		return f.GetHyperblockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByHash -
+func (f *Facade) GetHyperblockByHash(hash string) (*block.APIHyperblock, error) {
+	if f.GetHyperblockByHashCalled != nil {
+		return f.GetHyperblockByHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetLogEventsByIdentifier -
+func (f *Facade) GetLogEventsByIdentifier(identifier string) ([]*events.LogEvent, error) {
+	if f.GetLogEventsByIdentifierCalled != nil {
+		return f.GetLogEventsByIdentifierCalled(identifier)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationContractsMetaData -
+func (f *Facade) GetDelegationContractsMetaData() ([]*delegation.ContractMetaData, error) {
+	if f.GetDelegationContractsMetaDataCalled != nil {
+		return f.GetDelegationContractsMetaDataCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *Facade) GetGovernanceProposal(reference string) (*governance.Proposal, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(reference)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotingPower -
+func (f *Facade) GetGovernanceVotingPower(address string) (uint64, error) {
+	if f.GetGovernanceVotingPowerCalled != nil {
+		return f.GetGovernanceVotingPowerCalled(address)
+	}
+
+	return 0, nil
+}
+
+// GetStakingQueue -
+func (f *Facade) GetStakingQueue() ([]*validator.QueuedNode, error) {
+	if f.GetStakingQueueCalled != nil {
+		return f.GetStakingQueueCalled()
+	}
+
+	return nil, nil
+}
+
+// GetOwnerNodesStatus -
+func (f *Facade) GetOwnerNodesStatus(address string) (*validator.OwnerNodes, error) {
+	if f.GetOwnerNodesStatusCalled != nil {
+		return f.GetOwnerNodesStatusCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (f *Facade) GetStateDiff(fromRootHash string, toRootHash string) (*apiState.StateDiff, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(fromRootHash, toRootHash)
+	}
+
+	return nil, nil
 }
 
 // GetUsername -
-func (f *Facade) GetUsername(address string) (string, error) {
+func (f *Facade) GetUsername(address string, _ address.AccountQueryOptions) (string, error) {
 	if f.GetUsernameCalled != nil {
 		return f.GetUsernameCalled(address)
 	}
@@ -94,12 +234,12 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *Facade) GetBalance(address string) (*big.Int, error) {
+func (f *Facade) GetBalance(address string, _ address.AccountQueryOptions) (*big.Int, error) {
 	return f.BalanceHandler(address)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *Facade) GetValueForKey(address string, key string) (string, error) {
+func (f *Facade) GetValueForKey(address string, key string, _ address.AccountQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
 		return f.GetValueForKeyCalled(address, key)
 	}
@@ -108,7 +248,7 @@ func (f *Facade) GetValueForKey(address string, key string) (string, error) {
 }
 
 // GetESDTBalance -
-func (f *Facade) GetESDTBalance(address string, key string) (string, string, error) {
+func (f *Facade) GetESDTBalance(address string, key string, _ address.AccountQueryOptions) (string, string, error) {
 	if f.GetESDTBalanceCalled != nil {
 		return f.GetESDTBalanceCalled(address, key)
 	}
@@ -117,7 +257,7 @@ func (f *Facade) GetESDTBalance(address string, key string) (string, string, err
 }
 
 // GetAllESDTTokens -
-func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
+func (f *Facade) GetAllESDTTokens(address string, _ address.AccountQueryOptions) ([]string, error) {
 	if f.GetAllESDTTokensCalled != nil {
 		return f.GetAllESDTTokensCalled(address)
 	}
@@ -126,7 +266,7 @@ func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
 }
 
 // GetAccount is the mock implementation of a handler's GetAccount method
-func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
+func (f *Facade) GetAccount(address string, _ address.AccountQueryOptions) (state.UserAccountHandler, error) {
 	return f.GetAccountHandler(address)
 }
 
@@ -143,8 +283,10 @@ func (f *Facade) CreateTransaction(
 	chainID string,
 	version uint32,
 	options uint32,
+	guardianHex string,
+	guardianSignatureHex string,
 ) (*transaction.Transaction, []byte, error) {
-	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
+	return f.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, chainID, version, options, guardianHex, guardianSignatureHex)
 }
 
 // GetTransaction is the mock implementation of a handler's GetTransaction method
@@ -235,6 +377,33 @@ func (f *Facade) GetNumCheckpointsFromPeerState() uint32 {
 	return 0
 }
 
+// GetProof -
+func (f *Facade) GetProof(address string, _ address.AccountQueryOptions) (*address.AccountProof, error) {
+	if f.GetProofCalled != nil {
+		return f.GetProofCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetProofForKey -
+func (f *Facade) GetProofForKey(address string, key string, _ address.AccountQueryOptions) (*address.AccountProof, error) {
+	if f.GetProofForKeyCalled != nil {
+		return f.GetProofForKeyCalled(address, key)
+	}
+
+	return nil, nil
+}
+
+// GetAddressTransactions -
+func (f *Facade) GetAddressTransactions(address string, beforeNonce uint64, size uint64) (*address.AddressTransactions, error) {
+	if f.GetAddressTransactionsCalled != nil {
+		return f.GetAddressTransactionsCalled(address, beforeNonce, size)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	return f == nil
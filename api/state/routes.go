@@ -0,0 +1,128 @@
+package state
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getStateDiffPath = "/diff"
+	fromParam        = "from"
+	toParam          = "to"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetStateDiff(fromRootHash string, toRootHash string) (*StateDiff, error)
+	IsInterfaceNil() bool
+}
+
+// StateDiff represents the changes of the state between two root hashes
+type StateDiff struct {
+	FromRootHash string         `json:"fromRootHash"`
+	ToRootHash   string         `json:"toRootHash"`
+	Accounts     []*AccountDiff `json:"accounts"`
+	Truncated    bool           `json:"truncated"`
+}
+
+// AccountDiff represents the change of a main trie leaf. The address is set only for keys which are account addresses
+type AccountDiff struct {
+	Type            string     `json:"type"`
+	Key             string     `json:"key"`
+	Address         string     `json:"address,omitempty"`
+	OldValue        string     `json:"oldValue,omitempty"`
+	NewValue        string     `json:"newValue,omitempty"`
+	DataTrieChanges []*KeyDiff `json:"dataTrieChanges,omitempty"`
+}
+
+// KeyDiff represents the change of a key from an account's data trie
+type KeyDiff struct {
+	Type     string `json:"type"`
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+// Routes defines state related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getStateDiffPath, GetStateDiff)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetStateDiff returns the accounts and the data trie keys which changed between two state root hashes
+func GetStateDiff(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	fromRootHash := c.Query(fromParam)
+	toRootHash := c.Query(toParam)
+	if len(fromRootHash) == 0 || len(toRootHash) == 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyRootHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	stateDiff, err := facade.GetStateDiff(fromRootHash, toRootHash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetStateDiff.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"diff": stateDiff},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -0,0 +1,174 @@
+package state_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/state"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type stateDiffResponseData struct {
+	Diff state.StateDiff `json:"diff"`
+}
+
+type stateDiffResponse struct {
+	Data  stateDiffResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestGetStateDiff_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetStateDiff_WrongFacadeShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetStateDiff_MissingRootHashShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyRootHash.Error()))
+}
+
+func TestGetStateDiff_FacadeErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetStateDiffCalled: func(_ string, _ string) (*state.StateDiff, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetStateDiff_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedDiff := &state.StateDiff{
+		FromRootHash: "aa",
+		ToRootHash:   "bb",
+		Accounts: []*state.AccountDiff{
+			{
+				Type:     "updated",
+				Key:      "0102",
+				OldValue: "03",
+				NewValue: "04",
+				DataTrieChanges: []*state.KeyDiff{
+					{Type: "inserted", Key: "05", NewValue: "06"},
+				},
+			},
+		},
+	}
+	facade := &mock.Facade{
+		GetStateDiffCalled: func(fromRootHash string, toRootHash string) (*state.StateDiff, error) {
+			assert.Equal(t, "aa", fromRootHash)
+			assert.Equal(t, "bb", toRootHash)
+			return expectedDiff, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, *expectedDiff, response.Data.Diff)
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	_ = jsonParser.Decode(destination)
+}
+
+func startNodeServer(handler state.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	stateRoutes := ws.Group("/state")
+	if handler != nil {
+		stateRoutes.Use(middleware.WithFacade(handler))
+	}
+	stateRouteWrapper, _ := wrapper.NewRouterWrapper("state", stateRoutes, getRoutesConfig())
+	state.Routes(stateRouteWrapper)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	stateRoutes := ws.Group("/state")
+	stateRouteWrapper, _ := wrapper.NewRouterWrapper("state", stateRoutes, getRoutesConfig())
+	state.Routes(stateRouteWrapper)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"state": {
+				Routes: []config.RouteConfig{
+					{Name: "/diff", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -31,7 +31,8 @@ const (
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	CreateTransaction(nonce uint64, value string, receiver string, sender string, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32,
+		guardianHex string, guardianSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
@@ -71,6 +72,9 @@ type SendTxRequest struct {
 	ChainID   string `form:"chainID" json:"chainID"`
 	Version   uint32 `form:"version" json:"version"`
 	Options   uint32 `json:"options,omitempty"`
+
+	GuardianAddr      string `json:"guardian,omitempty"`
+	GuardianSignature string `json:"guardianSignature,omitempty"`
 }
 
 //TxResponse represents the structure on which the response will be validated against
@@ -175,6 +179,8 @@ func SimulateTransaction(c *gin.Context) {
 		gtx.ChainID,
 		gtx.Version,
 		gtx.Options,
+		gtx.GuardianAddr,
+		gtx.GuardianSignature,
 	)
 	if err != nil {
 		c.JSON(
@@ -258,6 +264,8 @@ func SendTransaction(c *gin.Context) {
 		gtx.ChainID,
 		gtx.Version,
 		gtx.Options,
+		gtx.GuardianAddr,
+		gtx.GuardianSignature,
 	)
 	if err != nil {
 		c.JSON(
@@ -349,6 +357,8 @@ func SendMultipleTransactions(c *gin.Context) {
 			receivedTx.ChainID,
 			receivedTx.Version,
 			receivedTx.Options,
+			receivedTx.GuardianAddr,
+			receivedTx.GuardianSignature,
 		)
 		if err != nil {
 			continue
@@ -478,6 +488,8 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 		gtx.ChainID,
 		gtx.Version,
 		gtx.Options,
+		gtx.GuardianAddr,
+		gtx.GuardianSignature,
 	)
 	if err != nil {
 		c.JSON(
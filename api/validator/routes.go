@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
@@ -10,17 +11,50 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath = "/statistics"
+	queuePath      = "/queue"
+	ownerPath      = "/owner/:address"
+)
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetStakingQueue() ([]*QueuedNode, error)
+	GetOwnerNodesStatus(address string) (*OwnerNodes, error)
 	IsInterfaceNil() bool
 }
 
+// QueuedNode represents a node waiting in the staking queue
+type QueuedNode struct {
+	Position          int    `json:"position"`
+	BLSKey            string `json:"blsKey"`
+	Owner             string `json:"owner"`
+	RewardAddress     string `json:"rewardAddress"`
+	RegistrationNonce uint64 `json:"registrationNonce"`
+}
+
+// OwnerNodes holds the staked amounts of an owner together with the status of all its nodes
+type OwnerNodes struct {
+	Owner       string       `json:"owner"`
+	TotalStaked string       `json:"totalStaked"`
+	TopUp       string       `json:"topUp"`
+	Nodes       []*OwnerNode `json:"nodes"`
+}
+
+// OwnerNode represents the staking status of one of the nodes of an owner
+type OwnerNode struct {
+	BLSKey                string `json:"blsKey"`
+	Status                string `json:"status"`
+	Jailed                bool   `json:"jailed"`
+	RemainingUnBondPeriod uint64 `json:"remainingUnBondPeriod"`
+}
+
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, statisticsPath, Statistics)
+	router.RegisterHandler(http.MethodGet, queuePath, GetQueue)
+	router.RegisterHandler(http.MethodGet, ownerPath, GetOwnerNodes)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -82,3 +116,76 @@ func Statistics(c *gin.Context) {
 		},
 	)
 }
+
+// GetQueue returns the ordered list of the nodes waiting in the staking queue
+func GetQueue(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	queue, err := facade.GetStakingQueue()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetStakingQueue.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"queue": queue},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetOwnerNodes returns the staked amounts and the status of all the nodes of the given owner
+func GetOwnerNodes(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetOwnerNodes.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	ownerNodes, err := facade.GetOwnerNodesStatus(addr)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetOwnerNodes.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"owner": ownerNodes},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -28,6 +28,26 @@ type ValidatorStatisticsResponse struct {
 	Error  string                                 `json:"error"`
 }
 
+type queueResponseData struct {
+	Queue []*validator.QueuedNode `json:"queue"`
+}
+
+type queueResponse struct {
+	Data  queueResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+type ownerNodesResponseData struct {
+	Owner *validator.OwnerNodes `json:"owner"`
+}
+
+type ownerNodesResponse struct {
+	Data  ownerNodesResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
 func TestValidatorStatistics_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -113,6 +133,107 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+func TestGetQueue_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetStakingQueueCalled: func() ([]*validator.QueuedNode, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStakingQueue.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetQueue_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedQueue := []*validator.QueuedNode{
+		{Position: 1, BLSKey: "aa", Owner: "erd1owner", RewardAddress: "erd1reward", RegistrationNonce: 37},
+		{Position: 2, BLSKey: "bb", Owner: "erd1owner", RewardAddress: "erd1reward", RegistrationNonce: 38},
+	}
+	facade := mock.Facade{
+		GetStakingQueueCalled: func() ([]*validator.QueuedNode, error) {
+			return expectedQueue, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := queueResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedQueue, response.Data.Queue)
+}
+
+func TestGetOwnerNodes_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetOwnerNodesStatusCalled: func(_ string) (*validator.OwnerNodes, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/owner/erd1owner", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetOwnerNodes.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetOwnerNodes_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedOwnerNodes := &validator.OwnerNodes{
+		Owner:       "erd1owner",
+		TotalStaked: "5000",
+		TopUp:       "2500",
+		Nodes: []*validator.OwnerNode{
+			{BLSKey: "aa", Status: "staked"},
+			{BLSKey: "bb", Status: "jailed", Jailed: true},
+			{BLSKey: "cc", Status: "unStaked", RemainingUnBondPeriod: 10},
+		},
+	}
+	facade := mock.Facade{
+		GetOwnerNodesStatusCalled: func(address string) (*validator.OwnerNodes, error) {
+			assert.Equal(t, "erd1owner", address)
+			return expectedOwnerNodes, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/owner/erd1owner", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := ownerNodesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedOwnerNodes, response.Data.Owner)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -157,6 +278,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				[]config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/queue", Open: true},
+					{Name: "/owner/:address", Open: true},
 				},
 			},
 		},
@@ -29,13 +29,16 @@ type FacadeHandler interface {
 	IsInterfaceNil() bool
 }
 
-// VMValueRequest represents the structure on which user input for generating a new transaction will validate against
+// VMValueRequest represents the structure on which user input for generating a new transaction will validate against.
+// The query is run against the state of the block with the given nonce or against the given state root hash, if any
 type VMValueRequest struct {
 	ScAddress  string   `form:"scAddress" json:"scAddress"`
 	FuncName   string   `form:"funcName" json:"funcName"`
 	CallerAddr string   `form:"caller" json:"caller"`
 	CallValue  string   `form:"value" json:"value"`
 	Args       []string `form:"args"  json:"args"`
+	BlockNonce *uint64  `form:"blockNonce" json:"blockNonce"`
+	RootHash   string   `form:"rootHash" json:"rootHash"`
 }
 
 // Routes defines address related routes
@@ -154,6 +157,24 @@ func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery,
 		scQuery.CallValue = callValue
 	}
 
+	if request.BlockNonce != nil && len(request.RootHash) > 0 {
+		return nil, errors.ErrBlockNonceAndRootHashProvided
+	}
+
+	if request.BlockNonce != nil {
+		scQuery.BlockNonce = *request.BlockNonce
+		scQuery.HasBlockNonce = true
+	}
+
+	if len(request.RootHash) > 0 {
+		rootHash, errDecodeRootHash := hex.DecodeString(request.RootHash)
+		if errDecodeRootHash != nil {
+			return nil, fmt.Errorf("'%s' is not a valid root hash: %s", request.RootHash, errDecodeRootHash.Error())
+		}
+
+		scQuery.RootHash = rootHash
+	}
+
 	return scQuery, nil
 }
 
@@ -150,6 +150,74 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_AtBlockNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := uint64(37)
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			require.True(t, query.HasBlockNonce)
+			require.Equal(t, blockNonce, query.BlockNonce)
+
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		Args:       []string{},
+		BlockNonce: &blockNonce,
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
+}
+
+func TestCreateSCQuery_WithRootHashShouldWork(t *testing.T) {
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		RootHash:  "aabb",
+	}
+
+	query, err := createSCQuery(&mock.Facade{}, &request)
+	require.Nil(t, err)
+	require.False(t, query.HasBlockNonce)
+	require.Equal(t, []byte{0xaa, 0xbb}, query.RootHash)
+}
+
+func TestCreateSCQuery_BlockNonceAndRootHashShouldErr(t *testing.T) {
+	blockNonce := uint64(37)
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+		RootHash:   "aabb",
+	}
+
+	_, err := createSCQuery(&mock.Facade{}, &request)
+	require.Equal(t, apiErrors.ErrBlockNonceAndRootHashProvided, err)
+}
+
+func TestCreateSCQuery_RootHashIsNotHexShouldErr(t *testing.T) {
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		RootHash:  "bad root hash",
+	}
+
+	_, err := createSCQuery(&mock.Facade{}, &request)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "'bad root hash' is not a valid root hash")
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := VMValueRequest{
 		ScAddress: DummyScAddress,
@@ -6,6 +6,7 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForDbTool
 }
 
 generateForNode() {
@@ -78,6 +79,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForDbTool() {
+    HELP="
+# Elrond DbTool CLI
+
+The **Elrond DbTool** exposes the following Command Line Interface:
+$(code)
+\$ dbtool --help
+
+$(./dbtool/dbtool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbtool/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,557 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/storers"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	nodeConfigPackage "github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/stateSnapshot"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+type flags struct {
+	dbPath             string
+	nodeConfigFilePath string
+	storerName         string
+	targetType         string
+	outputPath         string
+	olderThanEpoch     uint
+	dryRun             bool
+	rootHash           string
+	shard              string
+	peerTrie           bool
+	maxChunkSize       uint64
+}
+
+var (
+	nodeHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPathFlag defines a flag for setting the db path where the node's databases are held in
+	dbPathFlag = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "This string flag specifies the path for the database directory, the chain ID directory",
+		Value:       "db",
+		Destination: &flagsValues.dbPath,
+	}
+
+	// nodeConfigFilePathFlag defines a flag which holds the node's configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// storerFlag defines a flag which restricts the command to the databases of one storer
+	storerFlag = cli.StringFlag{
+		Name:        "storer",
+		Usage:       "This string flag restricts the command to one storer, given by its node config name (e.g. TxStorage)",
+		Value:       "",
+		Destination: &flagsValues.storerName,
+	}
+
+	// targetTypeFlag defines a flag which holds the DB type the databases will be migrated to
+	targetTypeFlag = cli.StringFlag{
+		Name:        "target-type",
+		Usage:       "This string flag specifies the DB type the databases will be migrated to (LvlDB, LvlDBSerial or BadgerDB)",
+		Value:       string(storageUnit.LvlDBSerial),
+		Destination: &flagsValues.targetType,
+	}
+
+	// outputPathFlag defines a flag which holds the path where the migrated databases or the state snapshot will be written
+	outputPathFlag = cli.StringFlag{
+		Name:        "output",
+		Usage:       "This string flag specifies the output directory: the new chain ID directory when migrating or the snapshots directory when exporting the state",
+		Value:       "",
+		Destination: &flagsValues.outputPath,
+	}
+
+	// olderThanFlag defines a flag which holds the first epoch to be kept when pruning
+	olderThanFlag = cli.UintFlag{
+		Name:        "older-than",
+		Usage:       "This uint flag specifies the epoch: all the epoch directories before it will be removed",
+		Value:       0,
+		Destination: &flagsValues.olderThanEpoch,
+	}
+
+	// dryRunFlag defines a flag which only prints what the pruning would remove
+	dryRunFlag = cli.BoolFlag{
+		Name:        "dry-run",
+		Usage:       "Boolean option for only printing the directories which would be removed",
+		Destination: &flagsValues.dryRun,
+	}
+
+	// rootHashFlag defines a flag which holds the hex encoded root hash of the state to be exported
+	rootHashFlag = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "This string flag specifies the hex encoded root hash of the state to be exported",
+		Value:       "",
+		Destination: &flagsValues.rootHash,
+	}
+
+	// shardFlag defines a flag which holds the shard whose state will be exported
+	shardFlag = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "This string flag specifies the shard whose state will be exported (e.g. 0 or metachain)",
+		Value:       "0",
+		Destination: &flagsValues.shard,
+	}
+
+	// peerTrieFlag defines a flag which selects the peer accounts trie instead of the user accounts one
+	peerTrieFlag = cli.BoolFlag{
+		Name:        "peer-trie",
+		Usage:       "Boolean option for exporting the peer accounts trie instead of the user accounts trie",
+		Destination: &flagsValues.peerTrie,
+	}
+
+	// maxChunkSizeFlag defines a flag which holds the maximum size of the trie nodes chunks written in the file
+	maxChunkSizeFlag = cli.Uint64Flag{
+		Name:        "max-chunk-size",
+		Usage:       "This uint flag specifies the maximum size in bytes of the trie nodes chunks written in the file",
+		Value:       4 * 1024 * 1024,
+		Destination: &flagsValues.maxChunkSize,
+	}
+
+	flagsValues = &flags{}
+
+	log        = logger.GetOrCreate("dbtool")
+	cliApp     *cli.App
+	nodeConfig nodeConfigPackage.Config
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = nodeHelpTemplate
+	cliApp.Name = "Elrond database tool"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond dbtool is used to inspect and maintain the node's databases while the node is stopped"
+	cliApp.Flags = []cli.Flag{
+		dbPathFlag,
+		nodeConfigFilePathFlag,
+	}
+	cliApp.Commands = []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "lists the storers' databases and their sizes, for each epoch",
+			Action: listDatabases,
+		},
+		{
+			Name:   "compact",
+			Usage:  "compacts the storers' databases",
+			Flags:  []cli.Flag{storerFlag},
+			Action: compactDatabases,
+		},
+		{
+			Name:   "migrate",
+			Usage:  "copies the storers' databases into a new directory, using another DB type",
+			Flags:  []cli.Flag{storerFlag, targetTypeFlag, outputPathFlag},
+			Action: migrateDatabases,
+		},
+		{
+			Name:   "verify",
+			Usage:  "checks that every value of the storers' databases decodes with the configured marshalizer",
+			Flags:  []cli.Flag{storerFlag},
+			Action: verifyDatabases,
+		},
+		{
+			Name:   "prune",
+			Usage:  "removes the epoch directories older than the given epoch",
+			Flags:  []cli.Flag{olderThanFlag, dryRunFlag},
+			Action: pruneEpochs,
+		},
+		{
+			Name:   "export-state",
+			Usage:  "writes all the trie nodes of a state root hash into a snapshot file, in the output directory",
+			Flags:  []cli.Flag{rootHashFlag, shardFlag, peerTrieFlag, outputPathFlag, maxChunkSizeFlag},
+			Action: exportState,
+		},
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func loadNodeConfig() error {
+	if !core.DoesFileExist(flagsValues.dbPath) {
+		return fmt.Errorf("no db directory found. Path: %s", flagsValues.dbPath)
+	}
+
+	return core.LoadTomlFile(&nodeConfig, flagsValues.nodeConfigFilePath)
+}
+
+func listDatabases(_ *cli.Context) error {
+	databases, err := storers.ListDatabases(flagsValues.dbPath)
+	if err != nil {
+		return err
+	}
+
+	totalSize := int64(0)
+	sizePerEpoch := make(map[uint32]int64)
+	for _, db := range databases {
+		epoch := fmt.Sprintf("%d", db.Epoch)
+		if db.IsStatic {
+			epoch = "static"
+		} else {
+			sizePerEpoch[db.Epoch] += db.Size
+		}
+		totalSize += db.Size
+
+		fmt.Printf("%-8s shard %-10s %-50s %s\n", epoch, db.Shard, db.Name, core.ConvertBytes(uint64(db.Size)))
+	}
+
+	epochs, err := storers.ListEpochDirectories(flagsValues.dbPath)
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+	for _, epoch := range epochs {
+		fmt.Printf("epoch %-8d %s\n", epoch.Epoch, core.ConvertBytes(uint64(sizePerEpoch[epoch.Epoch])))
+	}
+	fmt.Printf("total          %s\n", core.ConvertBytes(uint64(totalSize)))
+
+	return nil
+}
+
+// selectedDatabase is a database found on disk together with the storage configuration it was created with
+type selectedDatabase struct {
+	*storers.Database
+	storerConfig *storers.StorerConfig
+}
+
+func selectDatabases() ([]*selectedDatabase, error) {
+	err := loadNodeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	databases, err := storers.ListDatabases(flagsValues.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	storersConfigs := storers.ExtractStorersConfigs(&nodeConfig)
+	selected := make([]*selectedDatabase, 0, len(databases))
+	for _, db := range databases {
+		storerConfig, errFind := storers.FindStorerConfig(storersConfigs, db.Name)
+		if errFind != nil {
+			log.Warn("skipping database", "path", db.Path, "error", errFind.Error())
+			continue
+		}
+		if len(flagsValues.storerName) > 0 && storerConfig.Name != flagsValues.storerName {
+			continue
+		}
+
+		selected = append(selected, &selectedDatabase{
+			Database:     db,
+			storerConfig: storerConfig,
+		})
+	}
+
+	return selected, nil
+}
+
+func openPersister(dbConfig nodeConfigPackage.DBConfig, path string) (storage.Persister, error) {
+	return factory.NewPersisterFactory(dbConfig).Create(path)
+}
+
+func compactDatabases(_ *cli.Context) error {
+	databases, err := selectDatabases()
+	if err != nil {
+		return err
+	}
+
+	for _, db := range databases {
+		persister, errOpen := openPersister(db.storerConfig.Config.DB, db.Path)
+		if errOpen != nil {
+			return fmt.Errorf("%w while opening %s", errOpen, db.Path)
+		}
+
+		errCompact := storers.Compact(persister)
+		errClose := persister.Close()
+		if errCompact != nil {
+			log.Warn("database not compacted", "path", db.Path, "error", errCompact.Error())
+			continue
+		}
+		if errClose != nil {
+			return fmt.Errorf("%w while closing %s", errClose, db.Path)
+		}
+
+		size, errSize := storers.DirectorySize(db.Path)
+		if errSize != nil {
+			return errSize
+		}
+		log.Info("database compacted", "path", db.Path,
+			"size before", core.ConvertBytes(uint64(db.Size)),
+			"size after", core.ConvertBytes(uint64(size)),
+		)
+	}
+
+	return nil
+}
+
+func migrateDatabases(_ *cli.Context) error {
+	if len(flagsValues.outputPath) == 0 {
+		return fmt.Errorf("the %s flag is mandatory for migration", outputPathFlag.Name)
+	}
+
+	databases, err := selectDatabases()
+	if err != nil {
+		return err
+	}
+
+	for _, db := range databases {
+		relativePath, errRel := filepath.Rel(flagsValues.dbPath, db.Path)
+		if errRel != nil {
+			return errRel
+		}
+		destinationPath := filepath.Join(flagsValues.outputPath, relativePath)
+
+		destinationConfig := db.storerConfig.Config.DB
+		destinationConfig.Type = flagsValues.targetType
+		numCopied, errMigrate := migrateDatabase(db.storerConfig.Config.DB, db.Path, destinationConfig, destinationPath)
+		if errMigrate != nil {
+			return fmt.Errorf("%w while migrating %s", errMigrate, db.Path)
+		}
+
+		log.Info("database migrated", "source", db.Path, "destination", destinationPath, "num keys", numCopied)
+	}
+
+	return nil
+}
+
+func migrateDatabase(
+	sourceConfig nodeConfigPackage.DBConfig,
+	sourcePath string,
+	destinationConfig nodeConfigPackage.DBConfig,
+	destinationPath string,
+) (uint64, error) {
+	source, err := openPersister(sourceConfig, sourcePath)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	destination, err := openPersister(destinationConfig, destinationPath)
+	if err != nil {
+		return 0, err
+	}
+
+	numCopied, err := storers.Copy(source, destination)
+	if err != nil {
+		_ = destination.Close()
+		return 0, err
+	}
+
+	return numCopied, destination.Close()
+}
+
+func verifyDatabases(_ *cli.Context) error {
+	databases, err := selectDatabases()
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(nodeConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+
+	numFailedDatabases := 0
+	for _, db := range databases {
+		if !storers.IsVerifiable(db.storerConfig.Name) {
+			log.Debug("database holds raw values, skipping", "path", db.Path)
+			continue
+		}
+
+		persister, errOpen := openPersister(db.storerConfig.Config.DB, db.Path)
+		if errOpen != nil {
+			return fmt.Errorf("%w while opening %s", errOpen, db.Path)
+		}
+
+		result, errVerify := storers.Verify(persister, marshalizer, db.storerConfig.Name)
+		_ = persister.Close()
+		if errVerify != nil {
+			return fmt.Errorf("%w while verifying %s", errVerify, db.Path)
+		}
+
+		if len(result.FailedKeys) > 0 {
+			numFailedDatabases++
+			log.Error("database verification failed", "path", db.Path,
+				"num keys", result.NumKeys,
+				"num failed keys", len(result.FailedKeys),
+				"first failed key", result.FailedKeys[0],
+				"first error", result.FirstErrorMsg,
+			)
+			continue
+		}
+
+		log.Info("database verified", "path", db.Path, "num keys", result.NumKeys)
+	}
+
+	if numFailedDatabases > 0 {
+		return fmt.Errorf("%d database(s) failed the verification", numFailedDatabases)
+	}
+
+	return nil
+}
+
+func pruneEpochs(_ *cli.Context) error {
+	epochs, err := storers.ListEpochDirectories(flagsValues.dbPath)
+	if err != nil {
+		return err
+	}
+
+	toPrune, err := storers.EpochsToPrune(epochs, uint32(flagsValues.olderThanEpoch))
+	if err != nil {
+		return err
+	}
+
+	for _, epoch := range toPrune {
+		if flagsValues.dryRun {
+			log.Info("epoch directory would be removed", "epoch", epoch.Epoch, "path", epoch.Path)
+			continue
+		}
+
+		err = os.RemoveAll(epoch.Path)
+		if err != nil {
+			return err
+		}
+		log.Info("epoch directory removed", "epoch", epoch.Epoch, "path", epoch.Path)
+	}
+
+	return nil
+}
+
+func exportState(_ *cli.Context) error {
+	if len(flagsValues.outputPath) == 0 {
+		return fmt.Errorf("the %s flag is mandatory for exporting the state", outputPathFlag.Name)
+	}
+
+	rootHash, err := hex.DecodeString(flagsValues.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	err = loadNodeConfig()
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(nodeConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(nodeConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	trieConfig := nodeConfig.AccountsTrieStorage
+	if flagsValues.peerTrie {
+		trieConfig = nodeConfig.PeerAccountsTrieStorage
+	}
+	triePath := filepath.Join(
+		flagsValues.dbPath,
+		nodeFactory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, flagsValues.shard),
+		trieConfig.DB.FilePath,
+	)
+	if !core.DoesFileExist(triePath) {
+		return fmt.Errorf("no trie database found. Path: %s", triePath)
+	}
+
+	persister, err := openPersister(trieConfig.DB, triePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	trieStorageManager, err := trie.NewTrieStorageManagerWithoutPruning(persister)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := stateSnapshot.NewStateExporter(stateSnapshot.ArgsStateExporter{
+		Marshalizer:        marshalizer,
+		Hasher:             hasher,
+		TrieStorageManager: trieStorageManager,
+		MaxChunkSize:       flagsValues.maxChunkSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(flagsValues.outputPath, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	filePath := filepath.Join(flagsValues.outputPath, stateSnapshot.FileName(rootHash))
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	writer := bufio.NewWriter(file)
+	numNodes, err := exporter.Export(rootHash, !flagsValues.peerTrie, writer)
+	if err != nil {
+		return err
+	}
+	err = writer.Flush()
+	if err != nil {
+		return err
+	}
+
+	log.Info("state exported", "file", filePath, "num trie nodes", numNodes)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package storers
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+// StorerConfig binds a storage configuration to the name of the node configuration field which holds it
+type StorerConfig struct {
+	Name   string
+	Config config.StorageConfig
+}
+
+var storageConfigType = reflect.TypeOf(config.StorageConfig{})
+
+// ExtractStorersConfigs returns all the storage configurations found in the node configuration, including the
+// nested ones, sorted by their database file path
+func ExtractStorersConfigs(nodeConfig *config.Config) []*StorerConfig {
+	storers := make([]*StorerConfig, 0)
+	if nodeConfig == nil {
+		return storers
+	}
+
+	storers = appendStorersConfigs(storers, reflect.ValueOf(*nodeConfig))
+	sort.Slice(storers, func(i, j int) bool {
+		return storers[i].Config.DB.FilePath < storers[j].Config.DB.FilePath
+	})
+
+	return storers
+}
+
+func appendStorersConfigs(storers []*StorerConfig, value reflect.Value) []*StorerConfig {
+	valueType := value.Type()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Kind() != reflect.Struct {
+			continue
+		}
+
+		if field.Type() == storageConfigType {
+			storageConfig := field.Interface().(config.StorageConfig)
+			if len(storageConfig.DB.FilePath) == 0 {
+				continue
+			}
+
+			storers = append(storers, &StorerConfig{
+				Name:   valueType.Field(i).Name,
+				Config: storageConfig,
+			})
+			continue
+		}
+
+		storers = appendStorersConfigs(storers, field)
+	}
+
+	return storers
+}
+
+// FindStorerConfig returns the storer configuration whose database file path is the longest prefix of the given
+// database directory name. Some storers, as the shard header nonce to hash one, suffix the directory name
+func FindStorerConfig(storers []*StorerConfig, dbDirectoryName string) (*StorerConfig, error) {
+	var found *StorerConfig
+	for _, storer := range storers {
+		if !strings.HasPrefix(dbDirectoryName, storer.Config.DB.FilePath) {
+			continue
+		}
+		if found == nil || len(storer.Config.DB.FilePath) > len(found.Config.DB.FilePath) {
+			found = storer
+		}
+	}
+
+	if found == nil {
+		return nil, ErrUnknownStorer
+	}
+
+	return found, nil
+}
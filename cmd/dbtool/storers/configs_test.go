@@ -0,0 +1,63 @@
+package storers
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStorageConfig(filePath string) config.StorageConfig {
+	return config.StorageConfig{
+		DB: config.DBConfig{
+			FilePath: filePath,
+			Type:     "MemoryDB",
+		},
+	}
+}
+
+func TestExtractStorersConfigs_ShouldIncludeNestedConfigs(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := &config.Config{
+		TxStorage:                createStorageConfig("Transactions"),
+		ShardHdrNonceHashStorage: createStorageConfig("ShardHdrHashNonce"),
+		DbLookupExtensions: config.DbLookupExtensionsConfig{
+			EpochByHashStorageConfig: createStorageConfig("DbLookupExtensions_EpochByHash"),
+		},
+	}
+
+	storersConfigs := ExtractStorersConfigs(nodeConfig)
+	require.Equal(t, 3, len(storersConfigs))
+	assert.Equal(t, "EpochByHashStorageConfig", storersConfigs[0].Name)
+	assert.Equal(t, "ShardHdrNonceHashStorage", storersConfigs[1].Name)
+	assert.Equal(t, "TxStorage", storersConfigs[2].Name)
+	assert.Empty(t, ExtractStorersConfigs(nil))
+}
+
+func TestFindStorerConfig_ShouldMatchTheLongestPrefix(t *testing.T) {
+	t.Parallel()
+
+	storersConfigs := []*StorerConfig{
+		{Name: "A", Config: createStorageConfig("Transactions")},
+		{Name: "B", Config: createStorageConfig("TransactionsLogs")},
+		{Name: "C", Config: createStorageConfig("ShardHdrHashNonce")},
+	}
+
+	found, err := FindStorerConfig(storersConfigs, "TransactionsLogs")
+	require.Nil(t, err)
+	assert.Equal(t, "B", found.Name)
+
+	found, err = FindStorerConfig(storersConfigs, "Transactions")
+	require.Nil(t, err)
+	assert.Equal(t, "A", found.Name)
+
+	found, err = FindStorerConfig(storersConfigs, "ShardHdrHashNonce1")
+	require.Nil(t, err)
+	assert.Equal(t, "C", found.Name)
+
+	found, err = FindStorerConfig(storersConfigs, "Unknown")
+	assert.Nil(t, found)
+	assert.Equal(t, ErrUnknownStorer, err)
+}
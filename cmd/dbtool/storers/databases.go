@@ -0,0 +1,161 @@
+package storers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+)
+
+var log = logger.GetOrCreate("storers")
+
+var epochDirectoryPrefix = factory.DefaultEpochString + "_"
+var shardDirectoryPrefix = factory.DefaultShardString + "_"
+
+// Database holds the location and the size of one storer's database found on disk
+type Database struct {
+	Path     string
+	Name     string
+	Shard    string
+	Epoch    uint32
+	IsStatic bool
+	Size     int64
+}
+
+// EpochDirectory holds the location of the databases of one epoch
+type EpochDirectory struct {
+	Path  string
+	Epoch uint32
+}
+
+// ListEpochDirectories returns the epoch directories found in the chain ID directory, sorted ascending by epoch
+func ListEpochDirectories(dbPathWithChainID string) ([]*EpochDirectory, error) {
+	if len(dbPathWithChainID) == 0 {
+		return nil, ErrEmptyDbPath
+	}
+
+	entries, err := ioutil.ReadDir(dbPathWithChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]*EpochDirectory, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), epochDirectoryPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), epochDirectoryPrefix), 10, 32)
+		if errParse != nil {
+			log.Debug("skipping directory", "name", entry.Name(), "error", errParse.Error())
+			continue
+		}
+
+		epochs = append(epochs, &EpochDirectory{
+			Path:  filepath.Join(dbPathWithChainID, entry.Name()),
+			Epoch: uint32(epoch),
+		})
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i].Epoch < epochs[j].Epoch
+	})
+
+	return epochs, nil
+}
+
+// ListDatabases returns all the storers' databases found in the chain ID directory, the static ones first and
+// then the epoch ones, sorted ascending by epoch
+func ListDatabases(dbPathWithChainID string) ([]*Database, error) {
+	epochs, err := ListEpochDirectories(dbPathWithChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	databases := make([]*Database, 0)
+	staticPath := filepath.Join(dbPathWithChainID, factory.DefaultStaticDbString)
+	if dirExists(staticPath) {
+		databases, err = appendDatabases(databases, staticPath, 0, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, epoch := range epochs {
+		databases, err = appendDatabases(databases, epoch.Path, epoch.Epoch, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return databases, nil
+}
+
+func appendDatabases(databases []*Database, parentPath string, epoch uint32, isStatic bool) ([]*Database, error) {
+	shardEntries, err := ioutil.ReadDir(parentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shardEntry := range shardEntries {
+		if !shardEntry.IsDir() || !strings.HasPrefix(shardEntry.Name(), shardDirectoryPrefix) {
+			continue
+		}
+
+		shardPath := filepath.Join(parentPath, shardEntry.Name())
+		dbEntries, errRead := ioutil.ReadDir(shardPath)
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		for _, dbEntry := range dbEntries {
+			if !dbEntry.IsDir() {
+				continue
+			}
+
+			dbPath := filepath.Join(shardPath, dbEntry.Name())
+			size, errSize := DirectorySize(dbPath)
+			if errSize != nil {
+				return nil, errSize
+			}
+
+			databases = append(databases, &Database{
+				Path:     dbPath,
+				Name:     dbEntry.Name(),
+				Shard:    strings.TrimPrefix(shardEntry.Name(), shardDirectoryPrefix),
+				Epoch:    epoch,
+				IsStatic: isStatic,
+				Size:     size,
+			})
+		}
+	}
+
+	return databases, nil
+}
+
+// DirectorySize returns the total size in bytes of the regular files found in the given directory tree
+func DirectorySize(path string) (int64, error) {
+	size := int64(0)
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,87 @@
+package storers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDatabaseFile(t *testing.T, path string, size int) {
+	err := os.MkdirAll(path, os.ModePerm)
+	require.Nil(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(path, "000001.log"), make([]byte, size), os.ModePerm)
+	require.Nil(t, err)
+}
+
+func createDbTree(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "dbtool")
+	require.Nil(t, err)
+
+	createDatabaseFile(t, filepath.Join(dir, "Static", "Shard_0", "BootstrapData"), 10)
+	createDatabaseFile(t, filepath.Join(dir, "Epoch_0", "Shard_0", "Transactions"), 20)
+	createDatabaseFile(t, filepath.Join(dir, "Epoch_0", "Shard_0", "MiniBlocks"), 30)
+	createDatabaseFile(t, filepath.Join(dir, "Epoch_10", "Shard_0", "Transactions"), 40)
+	createDatabaseFile(t, filepath.Join(dir, "Epoch_2", "Shard_metachain", "MetaBlock"), 50)
+	createDatabaseFile(t, filepath.Join(dir, "Epoch_x", "Shard_0", "Transactions"), 60)
+
+	return dir
+}
+
+func TestListEpochDirectories_EmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epochs, err := ListEpochDirectories("")
+	assert.Nil(t, epochs)
+	assert.Equal(t, ErrEmptyDbPath, err)
+}
+
+func TestListEpochDirectories_ShouldSortAndSkipInvalidNames(t *testing.T) {
+	t.Parallel()
+
+	dir := createDbTree(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	epochs, err := ListEpochDirectories(dir)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(epochs))
+	assert.Equal(t, uint32(0), epochs[0].Epoch)
+	assert.Equal(t, uint32(2), epochs[1].Epoch)
+	assert.Equal(t, uint32(10), epochs[2].Epoch)
+	assert.Equal(t, filepath.Join(dir, "Epoch_10"), epochs[2].Path)
+}
+
+func TestListDatabases_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir := createDbTree(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	databases, err := ListDatabases(dir)
+	require.Nil(t, err)
+	require.Equal(t, 5, len(databases))
+
+	assert.True(t, databases[0].IsStatic)
+	assert.Equal(t, "BootstrapData", databases[0].Name)
+	assert.Equal(t, int64(10), databases[0].Size)
+
+	assert.Equal(t, "MiniBlocks", databases[1].Name)
+	assert.Equal(t, "Transactions", databases[2].Name)
+	assert.Equal(t, uint32(0), databases[2].Epoch)
+	assert.False(t, databases[2].IsStatic)
+
+	assert.Equal(t, "MetaBlock", databases[3].Name)
+	assert.Equal(t, "metachain", databases[3].Shard)
+	assert.Equal(t, uint32(2), databases[3].Epoch)
+
+	assert.Equal(t, filepath.Join(dir, "Epoch_10", "Shard_0", "Transactions"), databases[4].Path)
+	assert.Equal(t, int64(40), databases[4].Size)
+}
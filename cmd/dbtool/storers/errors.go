@@ -0,0 +1,21 @@
+package storers
+
+import "errors"
+
+// ErrEmptyDbPath signals that an empty database path has been provided
+var ErrEmptyDbPath = errors.New("empty database path")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrCompactionNotSupported signals that the persister can not be compacted
+var ErrCompactionNotSupported = errors.New("compaction not supported by the persister")
+
+// ErrUnknownStorer signals that no storage configuration matches the database directory
+var ErrUnknownStorer = errors.New("unknown storer")
+
+// ErrNoEpochToKeep signals that pruning would remove all the epoch directories
+var ErrNoEpochToKeep = errors.New("pruning would not keep any epoch")
@@ -0,0 +1,118 @@
+package storers
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// compactor defines the persisters which can compact their files on request
+type compactor interface {
+	Compact() error
+}
+
+// valueCreators holds, for each storer whose values are all marshalized objects, the function creating an empty
+// object of the stored type. The other storers hold raw values or mixed types and can not be verified
+var valueCreators = map[string]func() interface{}{
+	"MiniBlocksStorage":                  func() interface{} { return &block.MiniBlock{} },
+	"PeerBlockBodyStorage":               func() interface{} { return &block.MiniBlock{} },
+	"BlockHeaderStorage":                 func() interface{} { return &block.Header{} },
+	"MetaBlockStorage":                   func() interface{} { return &block.MetaBlock{} },
+	"TxStorage":                          func() interface{} { return &transaction.Transaction{} },
+	"UnsignedTransactionStorage":         func() interface{} { return &smartContractResult.SmartContractResult{} },
+	"RewardTxStorage":                    func() interface{} { return &rewardTx.RewardTx{} },
+	"ReceiptsStorage":                    func() interface{} { return &batch.Batch{} },
+	"TxLogsStorage":                      func() interface{} { return &transaction.Log{} },
+	"MiniblocksMetadataStorageConfig":    func() interface{} { return &dblookupext.MiniblockMetadata{} },
+	"EpochByHashStorageConfig":           func() interface{} { return &dblookupext.EpochByHash{} },
+	"ResultsHashesByTxHashStorageConfig": func() interface{} { return &dblookupext.ResultsHashesByTxHash{} },
+}
+
+// VerifyResult holds the outcome of a database verification
+type VerifyResult struct {
+	NumKeys       uint64
+	FailedKeys    [][]byte
+	FirstErrorMsg string
+}
+
+// IsVerifiable returns true if the values of the named storer can be decoded with the marshalizer
+func IsVerifiable(storerName string) bool {
+	_, ok := valueCreators[storerName]
+	return ok
+}
+
+// Compact will compact the persister's files if the persister supports it
+func Compact(persister storage.Persister) error {
+	if check.IfNil(persister) {
+		return ErrNilPersister
+	}
+
+	c, ok := persister.(compactor)
+	if !ok {
+		return ErrCompactionNotSupported
+	}
+
+	return c.Compact()
+}
+
+// Copy puts all the (key, value) pairs of the source persister into the destination persister and returns the
+// number of copied pairs
+func Copy(source storage.Persister, destination storage.Persister) (uint64, error) {
+	if check.IfNil(source) || check.IfNil(destination) {
+		return 0, ErrNilPersister
+	}
+
+	var err error
+	numCopied := uint64(0)
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		err = destination.Put(key, value)
+		if err != nil {
+			return false
+		}
+
+		numCopied++
+		return true
+	})
+
+	return numCopied, err
+}
+
+// Verify tries to unmarshal each value of the persister into an object of the type held by the named storer
+func Verify(persister storage.Persister, marshalizer marshal.Marshalizer, storerName string) (*VerifyResult, error) {
+	if check.IfNil(persister) {
+		return nil, ErrNilPersister
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	createValue, ok := valueCreators[storerName]
+	if !ok {
+		return nil, ErrUnknownStorer
+	}
+
+	result := &VerifyResult{
+		FailedKeys: make([][]byte, 0),
+	}
+	persister.RangeKeys(func(key []byte, value []byte) bool {
+		result.NumKeys++
+
+		err := marshalizer.Unmarshal(createValue(), value)
+		if err != nil {
+			if len(result.FailedKeys) == 0 {
+				result.FirstErrorMsg = err.Error()
+			}
+			result.FailedKeys = append(result.FailedKeys, key)
+		}
+
+		return true
+	})
+
+	return result, nil
+}
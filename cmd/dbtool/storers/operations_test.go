@@ -0,0 +1,59 @@
+package storers
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompact_MemoryDBShouldErr(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ErrNilPersister, Compact(nil))
+	assert.Equal(t, ErrCompactionNotSupported, Compact(memorydb.New()))
+}
+
+func TestCopy_ShouldCopyAllPairs(t *testing.T) {
+	t.Parallel()
+
+	source := memorydb.New()
+	_ = source.Put([]byte("key1"), []byte("value1"))
+	_ = source.Put([]byte("key2"), []byte("value2"))
+	destination := memorydb.New()
+
+	numCopied, err := Copy(source, destination)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), numCopied)
+
+	value, err := destination.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	_, err = Copy(source, nil)
+	assert.Equal(t, ErrNilPersister, err)
+}
+
+func TestVerify_ShouldReportUndecodableValues(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	persister := memorydb.New()
+	txBytes, _ := marshalizer.Marshal(&transaction.Transaction{Nonce: 7})
+	_ = persister.Put([]byte("good"), txBytes)
+	_ = persister.Put([]byte("bad"), []byte{0xff, 0xff, 0xff})
+
+	result, err := Verify(persister, marshalizer, "TxStorage")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), result.NumKeys)
+	assert.Equal(t, [][]byte{[]byte("bad")}, result.FailedKeys)
+	assert.NotEmpty(t, result.FirstErrorMsg)
+
+	result, err = Verify(persister, marshalizer, "BootstrapStorage")
+	assert.Nil(t, result)
+	assert.Equal(t, ErrUnknownStorer, err)
+	assert.False(t, IsVerifiable("BootstrapStorage"))
+}
@@ -0,0 +1,28 @@
+package storers
+
+// EpochsToPrune returns the epoch directories older than the given epoch. The latest epoch directory is never
+// returned, as it is the one the node will resume from
+func EpochsToPrune(epochs []*EpochDirectory, olderThan uint32) ([]*EpochDirectory, error) {
+	if len(epochs) == 0 {
+		return make([]*EpochDirectory, 0), nil
+	}
+
+	latestEpoch := epochs[0].Epoch
+	for _, epoch := range epochs {
+		if epoch.Epoch > latestEpoch {
+			latestEpoch = epoch.Epoch
+		}
+	}
+	if olderThan > latestEpoch {
+		return nil, ErrNoEpochToKeep
+	}
+
+	toPrune := make([]*EpochDirectory, 0)
+	for _, epoch := range epochs {
+		if epoch.Epoch < olderThan {
+			toPrune = append(toPrune, epoch)
+		}
+	}
+
+	return toPrune, nil
+}
@@ -0,0 +1,30 @@
+package storers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochsToPrune(t *testing.T) {
+	t.Parallel()
+
+	epochs := []*EpochDirectory{{Epoch: 0}, {Epoch: 1}, {Epoch: 5}, {Epoch: 6}}
+
+	toPrune, err := EpochsToPrune(epochs, 5)
+	require.Nil(t, err)
+	assert.Equal(t, []*EpochDirectory{epochs[0], epochs[1]}, toPrune)
+
+	toPrune, err = EpochsToPrune(epochs, 6)
+	require.Nil(t, err)
+	assert.Equal(t, 3, len(toPrune))
+
+	toPrune, err = EpochsToPrune(epochs, 7)
+	assert.Nil(t, toPrune)
+	assert.Equal(t, ErrNoEpochToKeep, err)
+
+	toPrune, err = EpochsToPrune(nil, 7)
+	assert.Nil(t, err)
+	assert.Empty(t, toPrune)
+}
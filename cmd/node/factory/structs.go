@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion"
 	factorySoftwareVersion "github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion/factory"
+	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -54,6 +55,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
@@ -497,6 +499,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.whiteListHandler,
 		args.whiteListerVerifiedTxs,
 		args.mainConfig.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
+		args.mainConfig.GeneralSettings.GuardianEnableEpoch,
 		args.epochNotifier,
 	)
 	if err != nil {
@@ -715,7 +718,7 @@ func indexGenesisBlocks(args *processComponentsFactoryArgs, genesisBlocks map[ui
 		}
 
 		log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", shardID, "hash", genesisBlockHash)
-		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil)
+		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -844,6 +847,7 @@ func newInterceptorContainerFactory(
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	transactionSignedWithTxHashEnableEpoch uint32,
+	guardianEnableEpoch uint32,
 	epochNotifier process.EpochNotifier,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
@@ -864,6 +868,7 @@ func newInterceptorContainerFactory(
 			whiteListHandler,
 			whiteListerVerifiedTxs,
 			transactionSignedWithTxHashEnableEpoch,
+			guardianEnableEpoch,
 			epochNotifier,
 		)
 	}
@@ -885,6 +890,7 @@ func newInterceptorContainerFactory(
 			whiteListHandler,
 			whiteListerVerifiedTxs,
 			transactionSignedWithTxHashEnableEpoch,
+			guardianEnableEpoch,
 			epochNotifier,
 		)
 	}
@@ -1108,6 +1114,7 @@ func newShardInterceptorContainerFactory(
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	signedTransactionWithTxHashEnableEpoch uint32,
+	guardianEnableEpoch uint32,
 	epochNotifier process.EpochNotifier,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
@@ -1142,6 +1149,7 @@ func newShardInterceptorContainerFactory(
 		ChainID:                   dataCore.ChainID,
 		MinTransactionVersion:     dataCore.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: signedTransactionWithTxHashEnableEpoch,
+		GuardianEnableEpoch:       guardianEnableEpoch,
 		TxSignHasher:              dataCore.TxSignHasher,
 		EpochNotifier:             epochNotifier,
 	}
@@ -1170,6 +1178,7 @@ func newMetaInterceptorContainerFactory(
 	whiteListHandler process.WhiteListHandler,
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	signedTransactionWithTxHashEnableEpoch uint32,
+	guardianEnableEpoch uint32,
 	epochNotifier process.EpochNotifier,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
@@ -1204,6 +1213,7 @@ func newMetaInterceptorContainerFactory(
 		ChainID:                   dataCore.ChainID,
 		MinTransactionVersion:     dataCore.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: signedTransactionWithTxHashEnableEpoch,
+		GuardianEnableEpoch:       guardianEnableEpoch,
 		TxSignHasher:              dataCore.TxSignHasher,
 		EpochNotifier:             epochNotifier,
 	}
@@ -1523,11 +1533,28 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	guardedAccounts, err := guardian.NewGuardedAccount(guardian.ArgsGuardedAccount{
+		Marshalizer:                   core.InternalMarshalizer,
+		EpochNotifier:                 epochNotifier,
+		GuardianActivationEpochsDelay: config.GeneralSettings.GuardianActivationEpochsDelay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                    gasSchedule,
+		MapDNSAddresses:                mapDNSAddresses,
+		Marshalizer:                    core.InternalMarshalizer,
+		Accounts:                       stateComponents.AccountsAdapter,
+		ShardCoordinator:               shardCoordinator,
+		GuardedAccounts:                guardedAccounts,
+		GuardianEnableEpoch:            config.GeneralSettings.GuardianEnableEpoch,
+		ESDTNFTEnableEpoch:             config.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTRolesEnableEpoch:           config.GeneralSettings.ESDTRolesEnableEpoch,
+		ESDTMultiTransferEnableEpoch:   config.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTLimitedTransferEnableEpoch: config.GeneralSettings.ESDTLimitedTransferEnableEpoch,
+		EpochNotifier:                  epochNotifier,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1683,7 +1710,10 @@ func newShardBlockProcessor(
 		RelayedTxEnableEpoch:           config.GeneralSettings.RelayedTransactionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      config.GeneralSettings.MetaProtectionEnableEpoch,
+		GuardianEnableEpoch:            config.GeneralSettings.GuardianEnableEpoch,
 		EpochNotifier:                  epochNotifier,
+		GuardedAccounts:                guardedAccounts,
+		TxVersionChecker:               versioning.NewTxVersionChecker(core.MinTransactionVersion),
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
@@ -1840,11 +1870,28 @@ func newMetaBlockProcessor(
 	rater sharding.PeerAccountListAndRatingHandler,
 ) (process.BlockProcessor, error) {
 
+	guardedAccounts, err := guardian.NewGuardedAccount(guardian.ArgsGuardedAccount{
+		Marshalizer:                   core.InternalMarshalizer,
+		EpochNotifier:                 epochNotifier,
+		GuardianActivationEpochsDelay: generalConfig.GeneralSettings.GuardianActivationEpochsDelay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}), // no dns for meta
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                    gasSchedule,
+		MapDNSAddresses:                make(map[string]struct{}), // no dns for meta
+		Marshalizer:                    core.InternalMarshalizer,
+		Accounts:                       stateComponents.AccountsAdapter,
+		ShardCoordinator:               shardCoordinator,
+		GuardedAccounts:                guardedAccounts,
+		GuardianEnableEpoch:            generalConfig.GeneralSettings.GuardianEnableEpoch,
+		ESDTNFTEnableEpoch:             generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTRolesEnableEpoch:           generalConfig.GeneralSettings.ESDTRolesEnableEpoch,
+		ESDTMultiTransferEnableEpoch:   generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTLimitedTransferEnableEpoch: generalConfig.GeneralSettings.ESDTLimitedTransferEnableEpoch,
+		EpochNotifier:                  epochNotifier,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -58,6 +58,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
+	"github.com/ElrondNetwork/elrond-go/node/systemSCQueries"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -65,6 +66,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
@@ -74,6 +76,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
@@ -1423,6 +1426,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		rater,
 		epochNotifier,
 		workingDir,
+		historyRepository,
 	)
 	if err != nil {
 		return err
@@ -2134,6 +2138,7 @@ func createHardForkTrigger(
 		InterceptorDebugConfig:    config.Debug.InterceptorResolver,
 		MinTxVersion:              coreData.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
+		GuardianEnableEpoch:       config.GeneralSettings.GuardianEnableEpoch,
 		TxSignHasher:              coreData.TxSignHasher,
 		EpochNotifier:             epochNotifier,
 	}
@@ -2320,6 +2325,7 @@ func createNode(
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
 		node.WithHistoryRepository(historyRepository),
 		node.WithEnableSignTxWithHashEpoch(config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch),
+		node.WithGuardianEnableEpoch(config.GeneralSettings.GuardianEnableEpoch),
 		node.WithTxSignHasher(coreData.TxSignHasher),
 		node.WithTxVersionChecker(txVersionCheckerHandler),
 		node.WithImportMode(isInImportDbMode),
@@ -2437,15 +2443,46 @@ func createApiResolver(
 	rater sharding.PeerAccountListAndRatingHandler,
 	epochNotifier process.EpochNotifier,
 	workingDir string,
+	historyRepository dblookupext.HistoryRepository,
 ) (facade.ApiResolver, error) {
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
+	argsHistoricalAccounts := smartContract.ArgsHistoricalAccountsDB{
+		Accounts:         accnts,
+		Hasher:           hasher,
+		Marshalizer:      marshalizer,
+		StorageService:   storageService,
+		Uint64Converter:  uint64Converter,
+		ShardCoordinator: shardCoordinator,
+	}
+	queryAccounts, err := smartContract.NewHistoricalAccountsDB(argsHistoricalAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	guardedAccounts, err := guardian.NewGuardedAccount(guardian.ArgsGuardedAccount{
+		Marshalizer:                   marshalizer,
+		EpochNotifier:                 epochNotifier,
+		GuardianActivationEpochsDelay: generalConfig.GeneralSettings.GuardianActivationEpochsDelay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasScheduleNotifier,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
-		Accounts:        accnts,
+		GasSchedule:                    gasScheduleNotifier,
+		MapDNSAddresses:                make(map[string]struct{}),
+		Marshalizer:                    marshalizer,
+		Accounts:                       queryAccounts,
+		ShardCoordinator:               shardCoordinator,
+		GuardedAccounts:                guardedAccounts,
+		GuardianEnableEpoch:            generalConfig.GeneralSettings.GuardianEnableEpoch,
+		ESDTNFTEnableEpoch:             generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTRolesEnableEpoch:           generalConfig.GeneralSettings.ESDTRolesEnableEpoch,
+		ESDTMultiTransferEnableEpoch:   generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
+		ESDTLimitedTransferEnableEpoch: generalConfig.GeneralSettings.ESDTLimitedTransferEnableEpoch,
+		EpochNotifier:                  epochNotifier,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -2463,7 +2500,7 @@ func createApiResolver(
 	}
 
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:           accnts,
+		Accounts:           queryAccounts,
 		PubkeyConv:         pubkeyConv,
 		StorageService:     storageService,
 		BlockChain:         blockChain,
@@ -2520,7 +2557,7 @@ func createApiResolver(
 		return nil, err
 	}
 
-	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), blockChain)
+	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), blockChain, queryAccounts)
 	if err != nil {
 		return nil, err
 	}
@@ -2541,7 +2578,23 @@ func createApiResolver(
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler)
+	txLogsHandler, err := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:      storageService.GetStorer(dataRetriever.TxLogsUnit),
+		Marshalizer: marshalizer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	systemSCQueriesHandler, err := systemSCQueries.NewSystemSCQueries(systemSCQueries.ArgsSystemSCQueries{
+		SCQueryService:         scQueryService,
+		AddressPubkeyConverter: pubkeyConv,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, txLogsHandler, historyRepository, systemSCQueriesHandler)
 }
 
 func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteListHandler, error) {
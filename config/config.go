@@ -119,6 +119,7 @@ type Config struct {
 	EvictionWaitingList      EvictionWaitingListConfig
 	StateTriesConfig         StateTriesConfig
 	TrieStorageManagerConfig TrieStorageManagerConfig
+	StateSnapshotImport      StateSnapshotImportConfig
 	BadBlocksCache           CacheConfig
 
 	TxBlockBodyDataPool         CacheConfig
@@ -228,6 +229,12 @@ type GeneralSettingsConfig struct {
 	MetaProtectionEnableEpoch              uint32
 	AheadOfTimeGasUsageEnableEpoch         uint32
 	GasPriceModifierEnableEpoch            uint32
+	GuardianEnableEpoch                    uint32
+	GuardianActivationEpochsDelay          uint32
+	ESDTNFTEnableEpoch                     uint32
+	ESDTRolesEnableEpoch                   uint32
+	ESDTMultiTransferEnableEpoch           uint32
+	ESDTLimitedTransferEnableEpoch         uint32
 	MaxNodesChangeEnableEpoch              []MaxNodesChangeConfig
 	GenesisString                          string
 	GenesisMaxNumberOfShards               uint32
@@ -248,6 +255,13 @@ type StateTriesConfig struct {
 	MaxPeerTrieLevelInMemory    uint
 }
 
+// StateSnapshotImportConfig will hold the settings for seeding the state tries from snapshot files when starting
+// in epoch. The files are looked up in the directory by the state root hash
+type StateSnapshotImportConfig struct {
+	Enabled   bool
+	Directory string
+}
+
 // TrieStorageManagerConfig will hold config information about trie storage manager
 type TrieStorageManagerConfig struct {
 	PruningBufferLen   uint32
@@ -379,6 +393,10 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	AddressIndexEnabled                bool
+	AddressTransactionsStorageConfig   StorageConfig
+	LogsIdentifierIndexEnabled         bool
+	LogsIdentifierStorageConfig        StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
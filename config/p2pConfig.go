@@ -5,6 +5,8 @@ type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
 	Sharding            ShardingConfig
+	PayloadCompression  PayloadCompressionConfig
+	MessageCapture      MessageCaptureConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -13,11 +15,16 @@ type NodeConfig struct {
 	Seed                       string
 	MaximumExpectedPeerCount   uint64
 	ThresholdMinConnectedPeers uint32
+	StaticPeers                []string
+	TrustedPeers               []string
+	SentryMode                 bool
+	ProtectedPeers             []string
 }
 
 // KadDhtPeerDiscoveryConfig will hold the kad-dht discovery config settings
 type KadDhtPeerDiscoveryConfig struct {
 	Enabled                          bool
+	Type                             string
 	RefreshIntervalInSec             uint32
 	ProtocolID                       string
 	InitialPeerList                  []string
@@ -34,3 +41,26 @@ type ShardingConfig struct {
 	MaxCrossShardObservers  uint32
 	Type                    string
 }
+
+// PayloadCompressionConfig will hold the settings used when compressing the payloads of the sent topic messages
+type PayloadCompressionConfig struct {
+	Enabled                 bool
+	CompressBroadcasts      bool
+	Codec                   string
+	DefaultThresholdInBytes uint32
+	TopicThresholds         []TopicCompressionThresholdConfig
+}
+
+// TopicCompressionThresholdConfig will hold the minimum payload size that triggers compression on a topic
+type TopicCompressionThresholdConfig struct {
+	Topic            string
+	ThresholdInBytes uint32
+}
+
+// MessageCaptureConfig will hold the settings used when recording the sent and received p2p messages on disk
+type MessageCaptureConfig struct {
+	Enabled         bool
+	FolderPath      string
+	MaxFileSizeInMB uint32
+	MaxNumFiles     uint32
+}
@@ -29,19 +29,23 @@ type StakingSystemSCConfig struct {
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
 type ESDTSystemSCConfig struct {
-	BaseIssuingCost string
-	OwnerAddress    string
-	EnabledEpoch    uint32
+	BaseIssuingCost            string
+	OwnerAddress               string
+	EnabledEpoch               uint32
+	NFTEnableEpoch             uint32
+	SpecialRolesEnableEpoch    uint32
+	LimitedTransferEnableEpoch uint32
 }
 
 // GovernanceSystemSCConfig defines the set of constants to initialize the governance system smart contract
 type GovernanceSystemSCConfig struct {
-	ProposalCost     string
-	NumNodes         int64
-	MinQuorum        int32
-	MinPassThreshold int32
-	MinVetoThreshold int32
-	EnabledEpoch     uint32
+	ProposalCost             string
+	NumNodes                 int64
+	MinQuorum                int32
+	MinPassThreshold         int32
+	MinVetoThreshold         int32
+	EnabledEpoch             uint32
+	ViewFunctionsEnableEpoch uint32
 }
 
 // DelegationManagerSystemSCConfig defines a set of constants to initialize the delegation manager system smart contract
@@ -53,8 +57,11 @@ type DelegationManagerSystemSCConfig struct {
 
 // DelegationSystemSCConfig defines a set of constants to initialize the delegation system smart contract
 type DelegationSystemSCConfig struct {
-	MinStakeAmount string
-	EnabledEpoch   uint32
-	MinServiceFee  uint64
-	MaxServiceFee  uint64
+	MinStakeAmount               string
+	EnabledEpoch                 uint32
+	MinServiceFee                uint64
+	MaxServiceFee                uint64
+	ReDelegateRewardsEnableEpoch uint32
+	WhitelistEnableEpoch         uint32
+	MetaDataEnableEpoch          uint32
 }
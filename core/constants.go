@@ -472,12 +472,102 @@ const BuiltInFunctionESDTPause = "ESDTPause"
 // BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
 const BuiltInFunctionESDTUnPause = "ESDTUnPause"
 
+// BuiltInFunctionSetESDTRole is the key for the elrond standard digital token set role built-in function
+const BuiltInFunctionSetESDTRole = "ESDTSetRole"
+
+// BuiltInFunctionUnSetESDTRole is the key for the elrond standard digital token unset role built-in function
+const BuiltInFunctionUnSetESDTRole = "ESDTUnSetRole"
+
+// BuiltInFunctionESDTNFTTransfer is the key for the elrond standard digital token NFT transfer built-in function
+const BuiltInFunctionESDTNFTTransfer = "ESDTNFTTransfer"
+
+// BuiltInFunctionESDTNFTCreate is the key for the elrond standard digital token NFT create built-in function
+const BuiltInFunctionESDTNFTCreate = "ESDTNFTCreate"
+
+// BuiltInFunctionESDTNFTAddQuantity is the key for the elrond standard digital token NFT add quantity built-in function
+const BuiltInFunctionESDTNFTAddQuantity = "ESDTNFTAddQuantity"
+
+// BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
+const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
+
+// BuiltInFunctionESDTMultiTransfer is the key for the elrond standard digital token multi transfer built-in function
+const BuiltInFunctionESDTMultiTransfer = "ESDTMultiTransfer"
+
+// BuiltInFunctionESDTLocalMint is the key for the elrond standard digital token local mint built-in function
+const BuiltInFunctionESDTLocalMint = "ESDTLocalMint"
+
+// BuiltInFunctionESDTLocalBurn is the key for the elrond standard digital token local burn built-in function
+const BuiltInFunctionESDTLocalBurn = "ESDTLocalBurn"
+
+// BuiltInFunctionSetGuardian is the key for the set guardian built-in function
+const BuiltInFunctionSetGuardian = "SetGuardian"
+
+// BuiltInFunctionRemoveGuardian is the key for the remove guardian built-in function
+const BuiltInFunctionRemoveGuardian = "RemoveGuardian"
+
+// BuiltInFunctionESDTSetLimitedTransfer is the key for the elrond standard digital token built-in function which sets the transfer restriction
+const BuiltInFunctionESDTSetLimitedTransfer = "ESDTSetLimitedTransfer"
+
+// BuiltInFunctionESDTUnSetLimitedTransfer is the key for the elrond standard digital token built-in function which removes the transfer restriction
+const BuiltInFunctionESDTUnSetLimitedTransfer = "ESDTUnSetLimitedTransfer"
+
+// BuiltInFunctionESDTSetTokenType is the key for the elrond standard digital token built-in function which saves the token type
+const BuiltInFunctionESDTSetTokenType = "ESDTSetTokenType"
+
+// ESDTRoleLocalMint is the constant string for the local role of mint for ESDT tokens
+const ESDTRoleLocalMint = "ESDTRoleLocalMint"
+
+// ESDTRoleLocalBurn is the constant string for the local role of burn for ESDT tokens
+const ESDTRoleLocalBurn = "ESDTRoleLocalBurn"
+
+// ESDTRoleNFTCreate is the constant string for the local role of create for ESDT tokens
+const ESDTRoleNFTCreate = "ESDTRoleNFTCreate"
+
+// ESDTRoleNFTAddQuantity is the constant string for the local role of adding quantity for existing ESDT tokens
+const ESDTRoleNFTAddQuantity = "ESDTRoleNFTAddQuantity"
+
+// ESDTRoleNFTBurn is the constant string for the local role of burn for ESDT tokens
+const ESDTRoleNFTBurn = "ESDTRoleNFTBurn"
+
+// ESDTRoleTransfer is the constant string for the role of sending and receiving transfer restricted ESDT tokens
+const ESDTRoleTransfer = "ESDTTransferRole"
+
+// FungibleESDT defines the string for the token type of fungible ESDT
+const FungibleESDT = "FungibleESDT"
+
+// NonFungibleESDT defines the string for the token type of non fungible ESDT
+const NonFungibleESDT = "NonFungibleESDT"
+
+// SemiFungibleESDT defines the string for the token type of semi fungible ESDT
+const SemiFungibleESDT = "SemiFungibleESDT"
+
+// MaxRoyalty defines 100% as uint32
+const MaxRoyalty = uint32(10000)
+
+// ESDTType defines the possible types in case of ESDT tokens
+type ESDTType uint32
+
+const (
+	// Fungible defines the token type for ESDT fungible tokens
+	Fungible ESDTType = iota
+	// NonFungible defines the token type for ESDT non fungible token instances
+	NonFungible
+	// SemiFungible defines the token type for ESDT semi fungible token instances
+	SemiFungible
+)
+
 // RelayedTransaction is the key for the elrond meta/gassless/relayed transaction standard
 const RelayedTransaction = "relayedTx"
 
 // SCDeployInitFunctionName is the key for the function which is called at smart contract deploy time
 const SCDeployInitFunctionName = "_init"
 
+// SCDeployIdentifier is the identifier of the log event generated when a smart contract is deployed
+const SCDeployIdentifier = "SCDeploy"
+
+// SCUpgradeIdentifier is the identifier of the log event generated when a smart contract is upgraded
+const SCUpgradeIdentifier = "SCUpgrade"
+
 // ShuffledOut signals that a restart is pending because the node was shuffled out
 const ShuffledOut = "shuffledOut"
 
@@ -499,6 +589,15 @@ const DelegationSystemSCKey = "delegation"
 // ESDTKeyIdentifier is the key prefix for esdt tokens
 const ESDTKeyIdentifier = "esdt"
 
+// ESDTRoleIdentifier is the key prefix for esdt role identifier
+const ESDTRoleIdentifier = "role"
+
+// ESDTNFTLatestNonceIdentifier is the key prefix for esdt latest nonce identifier
+const ESDTNFTLatestNonceIdentifier = "nonce"
+
+// GuardiansKeyIdentifier is the key identifier under which the guardians of an account are saved
+const GuardiansKeyIdentifier = "guardians"
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -0,0 +1,388 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. addressTransactions.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// The address transactions index shares a single storer between three kinds of records, distinguished by a key prefix:
+// - the number of indexed entries of an address
+// - the entries of an address, keyed by their (zero based) position in the index. The entries are appended in the
+//   order of the blocks so they are sorted by the block nonce, which is used as the pagination cursor
+// - the addresses touched by a block, keyed by the block nonce, needed when reverting blocks
+const (
+	addressCountKeyPrefix = byte('c')
+	addressEntryKeyPrefix = byte('e')
+	blockAddressKeyPrefix = byte('b')
+)
+
+// originalSenderHandler is implemented by the smart contract results that keep the address of the account which
+// triggered the execution
+type originalSenderHandler interface {
+	GetOriginalSender() []byte
+}
+
+// relayerAddressHandler is implemented by the smart contract results produced by relayed transactions
+type relayerAddressHandler interface {
+	GetRelayerAddr() []byte
+}
+
+type addressTransactionsIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+}
+
+func newAddressTransactionsIndex(storer storage.Storer, marshalizer marshal.Marshalizer) *addressTransactionsIndex {
+	return &addressTransactionsIndex{
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+// recordBlock indexes all the transactions, smart contract results and receipts of a block, for both their
+// senders and their receivers. Smart contract results are also indexed for their original sender and relayer, so
+// an address finds the refunds and results of its own calls. The entries are saved in the order of the block's
+// miniblocks
+func (ati *addressTransactionsIndex) recordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	txsFromPool map[string]data.TransactionHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+	receiptsFromPool map[string]data.TransactionHandler,
+) error {
+	existingRecord, err := ati.getAddressesByBlock(blockHeader.GetNonce())
+	if err == nil {
+		if string(existingRecord.HeaderHash) == string(blockHeaderHash) {
+			return nil
+		}
+
+		// a different block with the same nonce was recorded without being reverted
+		err = ati.revertBlock(blockHeader.GetNonce())
+		if err != nil {
+			return err
+		}
+	}
+
+	entriesByAddress := make(map[string][]*AddressTransaction)
+	addresses := make([]string, 0)
+	addEntry := func(address []byte, entry *AddressTransaction) {
+		if len(address) == 0 {
+			return
+		}
+
+		entries, found := entriesByAddress[string(address)]
+		if !found {
+			addresses = append(addresses, string(address))
+		}
+		if len(entries) > 0 && string(entries[len(entries)-1].Hash) == string(entry.Hash) {
+			return
+		}
+
+		entriesByAddress[string(address)] = append(entries, entry)
+	}
+
+	for _, item := range collectBlockTransactions(body, txsFromPool, scrResultsFromPool, receiptsFromPool) {
+		entry := &AddressTransaction{
+			Hash:        item.hash,
+			Type:        int32(item.blockType),
+			HeaderHash:  blockHeaderHash,
+			HeaderNonce: blockHeader.GetNonce(),
+			Round:       blockHeader.GetRound(),
+			Epoch:       blockHeader.GetEpoch(),
+			Timestamp:   blockHeader.GetTimeStamp(),
+		}
+
+		addEntry(item.tx.GetSndAddr(), entry)
+		addEntry(item.tx.GetRcvAddr(), entry)
+		if result, ok := item.tx.(originalSenderHandler); ok {
+			addEntry(result.GetOriginalSender(), entry)
+		}
+		if result, ok := item.tx.(relayerAddressHandler); ok {
+			addEntry(result.GetRelayerAddr(), entry)
+		}
+	}
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	record := &AddressesByBlock{
+		HeaderHash: blockHeaderHash,
+		Addresses:  make([][]byte, 0, len(addresses)),
+	}
+	for _, address := range addresses {
+		record.Addresses = append(record.Addresses, []byte(address))
+	}
+
+	// the block record is saved first so that a partially recorded block can still be reverted
+	err = ati.putRecord(ati.blockAddressesKey(blockHeader.GetNonce()), record)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		err = ati.appendEntries([]byte(address), entriesByAddress[address])
+		if err != nil {
+			log.Warn("addressTransactionsIndex.appendEntries()", "address", []byte(address), "error", err)
+		}
+	}
+
+	return nil
+}
+
+type blockTransaction struct {
+	hash      []byte
+	blockType block.Type
+	tx        data.TransactionHandler
+}
+
+func collectBlockTransactions(
+	body *block.Body,
+	txsFromPool map[string]data.TransactionHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+	receiptsFromPool map[string]data.TransactionHandler,
+) []*blockTransaction {
+	poolsByType := map[block.Type]map[string]data.TransactionHandler{
+		block.TxBlock:                  txsFromPool,
+		block.RewardsBlock:             txsFromPool,
+		block.InvalidBlock:             txsFromPool,
+		block.SmartContractResultBlock: scrResultsFromPool,
+		block.ReceiptBlock:             receiptsFromPool,
+	}
+
+	result := make([]*blockTransaction, 0)
+	seen := make(map[string]struct{})
+	for _, miniBlock := range body.MiniBlocks {
+		pool, found := poolsByType[miniBlock.Type]
+		if !found {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, ok := pool[string(txHash)]
+			if !ok {
+				continue
+			}
+
+			seen[string(txHash)] = struct{}{}
+			result = append(result, &blockTransaction{hash: txHash, blockType: miniBlock.Type, tx: tx})
+		}
+	}
+
+	// results which are not part of any miniblock of the body (e.g. intra shard results) are appended at the end
+	result = append(result, collectUnseen(scrResultsFromPool, block.SmartContractResultBlock, seen)...)
+	result = append(result, collectUnseen(receiptsFromPool, block.ReceiptBlock, seen)...)
+
+	return result
+}
+
+func collectUnseen(pool map[string]data.TransactionHandler, blockType block.Type, seen map[string]struct{}) []*blockTransaction {
+	hashes := make([]string, 0)
+	for txHash := range pool {
+		if _, found := seen[txHash]; found {
+			continue
+		}
+		hashes = append(hashes, txHash)
+	}
+	sort.Strings(hashes)
+
+	result := make([]*blockTransaction, 0, len(hashes))
+	for _, txHash := range hashes {
+		result = append(result, &blockTransaction{hash: []byte(txHash), blockType: blockType, tx: pool[txHash]})
+	}
+
+	return result
+}
+
+func (ati *addressTransactionsIndex) appendEntries(address []byte, entries []*AddressTransaction) error {
+	count := ati.getCount(address)
+	for _, entry := range entries {
+		err := ati.putRecord(ati.entryKey(address, count), entry)
+		if err != nil {
+			return err
+		}
+		count++
+	}
+
+	return ati.putRecord(ati.countKey(address), &AddressTransactionsCount{Count: count})
+}
+
+// revertBlock removes the entries added by the block with the provided nonce (and by any block with a higher nonce)
+// for all the addresses touched by that block
+func (ati *addressTransactionsIndex) revertBlock(nonce uint64) error {
+	record, err := ati.getAddressesByBlock(nonce)
+	if err != nil {
+		// nothing was indexed for this block
+		return nil
+	}
+
+	for _, address := range record.Addresses {
+		ati.revertAddress(address, nonce)
+	}
+
+	return ati.storer.Remove(ati.blockAddressesKey(nonce))
+}
+
+func (ati *addressTransactionsIndex) revertAddress(address []byte, nonce uint64) {
+	count := ati.getCount(address)
+	for count > 0 {
+		entry, err := ati.getEntry(address, count-1)
+		if err != nil {
+			log.Warn("addressTransactionsIndex.revertAddress()", "address", address, "error", err)
+			break
+		}
+		if entry.HeaderNonce < nonce {
+			break
+		}
+
+		err = ati.storer.Remove(ati.entryKey(address, count-1))
+		if err != nil {
+			log.Warn("addressTransactionsIndex.revertAddress()", "address", address, "error", err)
+			break
+		}
+		count--
+	}
+
+	err := ati.putRecord(ati.countKey(address), &AddressTransactionsCount{Count: count})
+	if err != nil {
+		log.Warn("addressTransactionsIndex.revertAddress()", "address", address, "error", err)
+	}
+}
+
+// getTransactions returns, newest first, the entries of the given address which were recorded in blocks with a nonce
+// lower than beforeNonce, together with the total number of indexed entries of the address. At least size entries
+// are returned, if available, and the entries of the last returned block are always returned entirely, so that the
+// nonce of the last entry can be used as the cursor of the next page
+func (ati *addressTransactionsIndex) getTransactions(address []byte, beforeNonce uint64, size uint64) ([]*AddressTransaction, uint64, error) {
+	count := ati.getCount(address)
+	end, err := ati.searchPosition(address, count, beforeNonce)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*AddressTransaction, 0)
+	for index := end; index > 0; index-- {
+		entry, errGet := ati.getEntry(address, index-1)
+		if errGet != nil {
+			return nil, 0, errGet
+		}
+
+		isPageFull := uint64(len(result)) >= size
+		if isPageFull && entry.HeaderNonce != result[len(result)-1].HeaderNonce {
+			break
+		}
+
+		result = append(result, entry)
+	}
+
+	return result, count, nil
+}
+
+// searchPosition returns the number of entries of the given address recorded in blocks with a nonce lower than
+// beforeNonce, relying on the entries being sorted by the block nonce
+func (ati *addressTransactionsIndex) searchPosition(address []byte, count uint64, beforeNonce uint64) (uint64, error) {
+	if beforeNonce == math.MaxUint64 {
+		return count, nil
+	}
+
+	low, high := uint64(0), count
+	for low < high {
+		middle := low + (high-low)/2
+		entry, err := ati.getEntry(address, middle)
+		if err != nil {
+			return 0, err
+		}
+
+		if entry.HeaderNonce < beforeNonce {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+
+	return low, nil
+}
+
+func (ati *addressTransactionsIndex) getCount(address []byte) uint64 {
+	countBytes, err := ati.storer.Get(ati.countKey(address))
+	if err != nil {
+		return 0
+	}
+
+	record := &AddressTransactionsCount{}
+	err = ati.marshalizer.Unmarshal(record, countBytes)
+	if err != nil {
+		return 0
+	}
+
+	return record.Count
+}
+
+func (ati *addressTransactionsIndex) getEntry(address []byte, index uint64) (*AddressTransaction, error) {
+	entryBytes, err := ati.storer.Get(ati.entryKey(address, index))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &AddressTransaction{}
+	err = ati.marshalizer.Unmarshal(entry, entryBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (ati *addressTransactionsIndex) getAddressesByBlock(nonce uint64) (*AddressesByBlock, error) {
+	recordBytes, err := ati.storer.Get(ati.blockAddressesKey(nonce))
+	if err != nil {
+		return nil, err
+	}
+
+	record := &AddressesByBlock{}
+	err = ati.marshalizer.Unmarshal(record, recordBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (ati *addressTransactionsIndex) putRecord(key []byte, record interface{}) error {
+	recordBytes, err := ati.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.Put(key, recordBytes)
+}
+
+func (ati *addressTransactionsIndex) countKey(address []byte) []byte {
+	return append([]byte{addressCountKeyPrefix}, address...)
+}
+
+func (ati *addressTransactionsIndex) entryKey(address []byte, index uint64) []byte {
+	key := make([]byte, 1+len(address)+8)
+	key[0] = addressEntryKeyPrefix
+	copy(key[1:], address)
+	binary.BigEndian.PutUint64(key[1+len(address):], index)
+
+	return key
+}
+
+func (ati *addressTransactionsIndex) blockAddressesKey(nonce uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = blockAddressKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], nonce)
+
+	return key
+}
@@ -0,0 +1,282 @@
+package dblookupext
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/require"
+)
+
+const latestNonce = uint64(math.MaxUint64)
+
+func createMemoryStorer() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 1000, Shards: 1})
+	storer, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+
+	return storer
+}
+
+func createAddressIndexRepo(t *testing.T) *historyRepository {
+	args := createMockHistoryRepoArgs(0)
+	args.AddressIndexEnabled = true
+	args.AddressTransactionsStorer = createMemoryStorer()
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	return repo
+}
+
+func recordTransfer(t *testing.T, repo *historyRepository, nonce uint64, txHash string, sender string, receiver string) {
+	headerHash := []byte("header" + txHash)
+	header := &block.Header{Nonce: nonce, Round: nonce}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte(txHash)}, Type: block.TxBlock},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		txHash: &transaction.Transaction{SndAddr: []byte(sender), RcvAddr: []byte(receiver)},
+	}
+
+	err := repo.RecordBlock(headerHash, header, body, txs, nil, nil)
+	require.Nil(t, err)
+}
+
+func getHashes(t *testing.T, repo *historyRepository, address string, beforeNonce uint64, size uint64) ([]string, uint64) {
+	entries, total, err := repo.GetAddressTransactions([]byte(address), beforeNonce, size)
+	require.Nil(t, err)
+
+	hashes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		hashes = append(hashes, string(entry.Hash))
+	}
+
+	return hashes, total
+}
+
+func TestNewHistoryRepository_AddressIndexEnabledWithNilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	args.AddressIndexEnabled = true
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+}
+
+func TestHistoryRepository_GetAddressTransactionsIndexNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	repo, _ := NewHistoryRepository(createMockHistoryRepoArgs(0))
+	entries, total, err := repo.GetAddressTransactions([]byte("alice"), latestNonce, 10)
+	require.Nil(t, entries)
+	require.Equal(t, uint64(0), total)
+	require.Equal(t, ErrAddressIndexNotEnabled, err)
+}
+
+func TestHistoryRepository_GetAddressTransactionsShouldPaginateNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	repo := createAddressIndexRepo(t)
+	recordTransfer(t, repo, 1, "tx1", "alice", "bob")
+	recordTransfer(t, repo, 2, "tx2", "bob", "carol")
+	recordTransfer(t, repo, 3, "tx3", "alice", "carol")
+
+	hashes, total := getHashes(t, repo, "alice", latestNonce, 10)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []string{"tx3", "tx1"}, hashes)
+
+	hashes, total = getHashes(t, repo, "carol", latestNonce, 1)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []string{"tx3"}, hashes)
+
+	hashes, _ = getHashes(t, repo, "carol", 3, 1)
+	require.Equal(t, []string{"tx2"}, hashes)
+
+	hashes, _ = getHashes(t, repo, "carol", 2, 1)
+	require.Empty(t, hashes)
+
+	hashes, total = getHashes(t, repo, "dave", latestNonce, 10)
+	require.Equal(t, uint64(0), total)
+	require.Empty(t, hashes)
+}
+
+func TestHistoryRepository_GetAddressTransactionsPagesShouldNotShiftWhenBlocksAreIndexed(t *testing.T) {
+	t.Parallel()
+
+	repo := createAddressIndexRepo(t)
+	recordTransfer(t, repo, 1, "tx1", "alice", "bob")
+	recordTransfer(t, repo, 2, "tx2", "alice", "bob")
+	recordTransfer(t, repo, 3, "tx3", "alice", "bob")
+
+	hashes, _ := getHashes(t, repo, "alice", latestNonce, 1)
+	require.Equal(t, []string{"tx3"}, hashes)
+
+	recordTransfer(t, repo, 4, "tx4", "alice", "bob")
+	hashes, _ = getHashes(t, repo, "alice", 3, 1)
+	require.Equal(t, []string{"tx2"}, hashes)
+
+	err := repo.RevertBlock(&block.Header{Nonce: 4}, &block.Body{})
+	require.Nil(t, err)
+	hashes, _ = getHashes(t, repo, "alice", 2, 1)
+	require.Equal(t, []string{"tx1"}, hashes)
+}
+
+func TestHistoryRepository_GetAddressTransactionsShouldNotSplitBlocksBetweenPages(t *testing.T) {
+	t.Parallel()
+
+	repo := createAddressIndexRepo(t)
+	recordTransfer(t, repo, 1, "tx1", "alice", "bob")
+	header := &block.Header{Nonce: 2, Round: 2}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx2a"), []byte("tx2b")}, Type: block.TxBlock},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		"tx2a": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"tx2b": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")},
+	}
+	err := repo.RecordBlock([]byte("header2"), header, body, txs, nil, nil)
+	require.Nil(t, err)
+
+	hashes, total := getHashes(t, repo, "alice", latestNonce, 1)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, []string{"tx2b", "tx2a"}, hashes)
+
+	hashes, _ = getHashes(t, repo, "alice", 2, 1)
+	require.Equal(t, []string{"tx1"}, hashes)
+}
+
+func TestHistoryRepository_RecordBlockShouldIndexResultsAndDeduplicateSelfTransfers(t *testing.T) {
+	t.Parallel()
+
+	repo := createAddressIndexRepo(t)
+	header := &block.Header{Nonce: 5, Round: 7, Epoch: 1, TimeStamp: 100}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx")}, Type: block.TxBlock},
+			{TxHashes: [][]byte{[]byte("scr")}, Type: block.SmartContractResultBlock},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		"tx": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scr":      &smartContractResult.SmartContractResult{SndAddr: []byte("contract"), RcvAddr: []byte("alice")},
+		"intraScr": &smartContractResult.SmartContractResult{SndAddr: []byte("alice"), RcvAddr: []byte("contract")},
+	}
+	receipts := map[string]data.TransactionHandler{
+		"receipt": &receipt.Receipt{SndAddr: []byte("alice")},
+	}
+
+	err := repo.RecordBlock([]byte("header"), header, body, txs, scrs, receipts)
+	require.Nil(t, err)
+
+	entries, total, err := repo.GetAddressTransactions([]byte("alice"), latestNonce, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), total)
+	require.Equal(t, "receipt", string(entries[0].Hash))
+	require.Equal(t, int32(block.ReceiptBlock), entries[0].Type)
+	require.Equal(t, "intraScr", string(entries[1].Hash))
+	require.Equal(t, "scr", string(entries[2].Hash))
+	require.Equal(t, int32(block.SmartContractResultBlock), entries[2].Type)
+	require.Equal(t, "tx", string(entries[3].Hash))
+	require.Equal(t, int32(block.TxBlock), entries[3].Type)
+	require.Equal(t, []byte("header"), entries[3].HeaderHash)
+	require.Equal(t, uint64(5), entries[3].HeaderNonce)
+	require.Equal(t, uint64(7), entries[3].Round)
+	require.Equal(t, uint32(1), entries[3].Epoch)
+	require.Equal(t, uint64(100), entries[3].Timestamp)
+
+	// recording the same block again should not duplicate the entries
+	err = repo.RecordBlock([]byte("header"), header, body, txs, scrs, receipts)
+	require.Nil(t, err)
+	_, total = getHashes(t, repo, "alice", latestNonce, 10)
+	require.Equal(t, uint64(4), total)
+}
+
+func TestHistoryRepository_RecordBlockShouldIndexResultsForOriginalSenderAndRelayer(t *testing.T) {
+	t.Parallel()
+
+	repo := createAddressIndexRepo(t)
+	header := &block.Header{Nonce: 5, Round: 5}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("refund"), []byte("relayedScr")}, Type: block.SmartContractResultBlock},
+		},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"refund": &smartContractResult.SmartContractResult{
+			SndAddr:        []byte("contract"),
+			RcvAddr:        []byte("bob"),
+			OriginalSender: []byte("alice"),
+		},
+		"relayedScr": &smartContractResult.SmartContractResult{
+			SndAddr:        []byte("alice"),
+			RcvAddr:        []byte("contract"),
+			OriginalSender: []byte("alice"),
+			RelayerAddr:    []byte("relayer"),
+		},
+	}
+
+	err := repo.RecordBlock([]byte("header"), header, body, nil, scrs, nil)
+	require.Nil(t, err)
+
+	hashes, total := getHashes(t, repo, "alice", latestNonce, 10)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []string{"relayedScr", "refund"}, hashes)
+
+	hashes, total = getHashes(t, repo, "relayer", latestNonce, 10)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []string{"relayedScr"}, hashes)
+
+	hashes, total = getHashes(t, repo, "contract", latestNonce, 10)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []string{"relayedScr", "refund"}, hashes)
+}
+
+func TestHistoryRepository_RevertBlockShouldRemoveEntries(t *testing.T) {
+	t.Parallel()
+
+	repo := createAddressIndexRepo(t)
+	recordTransfer(t, repo, 1, "tx1", "alice", "bob")
+	recordTransfer(t, repo, 2, "tx2", "alice", "bob")
+
+	err := repo.RevertBlock(&block.Header{Nonce: 2}, &block.Body{})
+	require.Nil(t, err)
+
+	hashes, total := getHashes(t, repo, "alice", latestNonce, 10)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []string{"tx1"}, hashes)
+	hashes, _ = getHashes(t, repo, "bob", latestNonce, 10)
+	require.Equal(t, []string{"tx1"}, hashes)
+
+	// the nonce can be reused by the block on the new fork
+	recordTransfer(t, repo, 2, "tx2b", "bob", "alice")
+	hashes, _ = getHashes(t, repo, "alice", latestNonce, 10)
+	require.Equal(t, []string{"tx2b", "tx1"}, hashes)
+}
+
+func TestHistoryRepository_RecordDifferentBlockWithSameNonceShouldReplaceEntries(t *testing.T) {
+	t.Parallel()
+
+	repo := createAddressIndexRepo(t)
+	recordTransfer(t, repo, 1, "tx1", "alice", "bob")
+	recordTransfer(t, repo, 1, "tx1b", "alice", "carol")
+
+	hashes, _ := getHashes(t, repo, "alice", latestNonce, 10)
+	require.Equal(t, []string{"tx1b"}, hashes)
+	hashes, total := getHashes(t, repo, "bob", latestNonce, 10)
+	require.Equal(t, uint64(0), total)
+	require.Empty(t, hashes)
+}
@@ -15,3 +15,12 @@ func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) er
 func newErrCannotSaveMiniblockMetadata(hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save miniblock metadata, hash [%s]: %w", hex.EncodeToString(hash), originalErr)
 }
+
+// ErrAddressIndexNotEnabled signals that the address transactions index is not enabled
+var ErrAddressIndexNotEnabled = errors.New("address transactions index is not enabled")
+
+// ErrEmptyLogIdentifier signals that an empty log identifier was provided
+var ErrEmptyLogIdentifier = errors.New("empty log identifier")
+
+// ErrLogIdentifierIndexNotEnabled signals that the log identifiers index is not enabled
+var ErrLogIdentifierIndexNotEnabled = errors.New("log identifiers index is not enabled")
@@ -63,7 +63,17 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           hpf.store.GetStorer(dataRetriever.EpochByHashUnit),
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
+		AddressIndexEnabled:         hpf.dbLookupExtensionsConfig.AddressIndexEnabled,
+		LogsIdentifierIndexEnabled:  hpf.dbLookupExtensionsConfig.LogsIdentifierIndexEnabled,
 	}
+	if historyRepArgs.AddressIndexEnabled {
+		historyRepArgs.AddressTransactionsStorer = hpf.store.GetStorer(dataRetriever.AddressTransactionsUnit)
+	}
+	if historyRepArgs.LogsIdentifierIndexEnabled {
+		historyRepArgs.LogsIdentifierStorer = hpf.store.GetStorer(dataRetriever.LogsIdentifierUnit)
+		historyRepArgs.TxLogsStorer = hpf.store.GetStorer(dataRetriever.TxLogsUnit)
+	}
+
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
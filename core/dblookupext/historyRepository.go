@@ -29,6 +29,11 @@ type HistoryRepositoryArguments struct {
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	AddressTransactionsStorer   storage.Storer
+	TxLogsStorer                storage.Storer
+	LogsIdentifierStorer        storage.Storer
+	AddressIndexEnabled         bool
+	LogsIdentifierIndexEnabled  bool
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 }
@@ -39,6 +44,8 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	addressTransactionsIndex   *addressTransactionsIndex
+	logsIdentifierIndex        *logsIdentifierIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -81,12 +88,28 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if arguments.AddressIndexEnabled && check.IfNil(arguments.AddressTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if arguments.LogsIdentifierIndexEnabled && (check.IfNil(arguments.LogsIdentifierStorer) || check.IfNil(arguments.TxLogsStorer)) {
+		return nil, core.ErrNilStore
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
 
+	var addressTxsIndex *addressTransactionsIndex
+	if arguments.AddressIndexEnabled {
+		addressTxsIndex = newAddressTransactionsIndex(arguments.AddressTransactionsStorer, arguments.Marshalizer)
+	}
+
+	var logsIdIndex *logsIdentifierIndex
+	if arguments.LogsIdentifierIndexEnabled {
+		logsIdIndex = newLogsIdentifierIndex(arguments.LogsIdentifierStorer, arguments.TxLogsStorer, arguments.Marshalizer)
+	}
+
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
 		miniblocksMetadataStorer:              arguments.MiniblocksMetadataStorer,
@@ -99,6 +122,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		addressTransactionsIndex:                     addressTxsIndex,
+		logsIdentifierIndex:                          logsIdIndex,
 	}, nil
 }
 
@@ -108,6 +133,7 @@ func (hr *historyRepository) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 ) error {
@@ -144,9 +170,75 @@ func (hr *historyRepository) RecordBlock(
 		return err
 	}
 
+	if hr.addressTransactionsIndex != nil {
+		err = hr.addressTransactionsIndex.recordBlock(blockHeaderHash, blockHeader, body, txsFromPool, scrResultsFromPool, receiptsFromPool)
+		if err != nil {
+			return err
+		}
+	}
+
+	if hr.logsIdentifierIndex != nil {
+		err = hr.logsIdentifierIndex.recordBlock(blockHeaderHash, blockHeader, body, txsFromPool, scrResultsFromPool)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// RevertBlock reverts the effects of a previously recorded block on the address transactions and log identifiers indexes
+// This function is called synchronously, when the block is rolled back
+func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, _ data.BodyHandler) error {
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	log.Debug("RevertBlock()", "nonce", blockHeader.GetNonce(), "header type", fmt.Sprintf("%T", blockHeader))
+
+	if hr.addressTransactionsIndex != nil {
+		err := hr.addressTransactionsIndex.revertBlock(blockHeader.GetNonce())
+		if err != nil {
+			return err
+		}
+	}
+
+	if hr.logsIdentifierIndex != nil {
+		err := hr.logsIdentifierIndex.revertBlock(blockHeader.GetNonce())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTxHashesByLogIdentifier returns the hashes of the most recent transactions which generated log events with the
+// provided identifier, the most recent one being the last
+func (hr *historyRepository) GetTxHashesByLogIdentifier(identifier []byte) ([][]byte, error) {
+	if hr.logsIdentifierIndex == nil {
+		return nil, ErrLogIdentifierIndexNotEnabled
+	}
+	if len(identifier) == 0 {
+		return nil, ErrEmptyLogIdentifier
+	}
+
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	return hr.logsIdentifierIndex.getTxHashes(identifier), nil
+}
+
+// GetAddressTransactions returns a page of the indexed transactions touching the given address, newest first,
+// starting with the ones recorded in the block preceding beforeNonce, together with the total number of indexed
+// transactions of the address. Providing math.MaxUint64 as beforeNonce returns the most recent transactions
+func (hr *historyRepository) GetAddressTransactions(address []byte, beforeNonce uint64, size uint64) ([]*AddressTransaction, uint64, error) {
+	if hr.addressTransactionsIndex == nil {
+		return nil, 0, ErrAddressIndexNotEnabled
+	}
+
+	return hr.addressTransactionsIndex.getTransactions(address, beforeNonce, size)
+}
+
 func (hr *historyRepository) recordMiniblock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniblock *block.MiniBlock, epoch uint32) error {
 	miniblockHash, err := hr.computeMiniblockHash(miniblock)
 	if err != nil {
@@ -15,14 +15,18 @@ type HistoryRepository interface {
 	RecordBlock(blockHeaderHash []byte,
 		blockHeader data.HeaderHandler,
 		blockBody data.BodyHandler,
+		txsFromPool map[string]data.TransactionHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
 	) error
+	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 
 	OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetAddressTransactions(address []byte, beforeNonce uint64, size uint64) ([]*AddressTransaction, uint64, error)
+	GetTxHashesByLogIdentifier(identifier []byte) ([][]byte, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,256 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. logsIdentifierIndex.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// The log identifiers index shares a single storer between two kinds of records, distinguished by a key prefix:
+// - the most recent transactions which generated log events with an identifier
+// - the identifiers indexed for a block, keyed by the block nonce, needed when reverting blocks
+// The indexed logs are only read from the transaction logs storer
+const (
+	logIdentifierKeyPrefix         = "logIdentifier_"
+	logIdentifiersByBlockKeyPrefix = "logIdentifiersByBlock_"
+)
+
+// maxTxHashesPerLogIdentifier limits the number of the most recent transaction hashes kept for each log identifier
+const maxTxHashesPerLogIdentifier = 1000
+
+// maxLogIdentifiersPerTx limits the number of distinct identifiers indexed for the log of a single transaction, as
+// smart contracts are free to emit events with any identifier
+const maxLogIdentifiersPerTx = 10
+
+type logsIdentifierIndex struct {
+	marshalizer  marshal.Marshalizer
+	storer       storage.Storer
+	txLogsStorer storage.Storer
+}
+
+func newLogsIdentifierIndex(storer storage.Storer, txLogsStorer storage.Storer, marshalizer marshal.Marshalizer) *logsIdentifierIndex {
+	return &logsIdentifierIndex{
+		marshalizer:  marshalizer,
+		storer:       storer,
+		txLogsStorer: txLogsStorer,
+	}
+}
+
+// recordBlock indexes the logs saved while processing the transactions and smart contract results of a block by
+// the identifiers of their events
+func (lii *logsIdentifierIndex) recordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	txsFromPool map[string]data.TransactionHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+) error {
+	existingRecord, err := lii.getIdentifiersByBlock(blockHeader.GetNonce())
+	if err == nil {
+		if string(existingRecord.HeaderHash) == string(blockHeaderHash) {
+			return nil
+		}
+
+		// a different block with the same nonce was recorded without being reverted
+		err = lii.revertBlock(blockHeader.GetNonce())
+		if err != nil {
+			return err
+		}
+	}
+
+	txHashesByIdentifier := make(map[string][][]byte)
+	identifiers := make([]string, 0)
+	for _, item := range collectBlockTransactions(body, txsFromPool, scrResultsFromPool, nil) {
+		txLog, errGet := lii.getLog(item.hash)
+		if errGet != nil {
+			continue
+		}
+
+		for _, identifier := range logIdentifiersToIndex(txLog) {
+			txHashes, found := txHashesByIdentifier[identifier]
+			if !found {
+				identifiers = append(identifiers, identifier)
+			}
+
+			txHashesByIdentifier[identifier] = append(txHashes, item.hash)
+		}
+	}
+
+	if len(identifiers) == 0 {
+		return nil
+	}
+
+	record := &LogIdentifiersByBlock{
+		HeaderHash:  blockHeaderHash,
+		Identifiers: make([][]byte, 0, len(identifiers)),
+	}
+	for _, identifier := range identifiers {
+		record.Identifiers = append(record.Identifiers, []byte(identifier))
+	}
+
+	// the block record is saved first so that a partially recorded block can still be reverted
+	err = lii.putRecord(lii.identifiersByBlockKey(blockHeader.GetNonce()), record)
+	if err != nil {
+		return err
+	}
+
+	for _, identifier := range identifiers {
+		err = lii.appendTxHashes([]byte(identifier), txHashesByIdentifier[identifier], blockHeader.GetNonce())
+		if err != nil {
+			log.Warn("logsIdentifierIndex.appendTxHashes()", "identifier", identifier, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func logIdentifiersToIndex(txLog *transaction.Log) []string {
+	identifiers := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, event := range txLog.Events {
+		if len(identifiers) == maxLogIdentifiersPerTx {
+			break
+		}
+		if event == nil || len(event.Identifier) == 0 {
+			continue
+		}
+		if _, found := seen[string(event.Identifier)]; found {
+			continue
+		}
+
+		seen[string(event.Identifier)] = struct{}{}
+		identifiers = append(identifiers, string(event.Identifier))
+	}
+
+	return identifiers
+}
+
+func (lii *logsIdentifierIndex) appendTxHashes(identifier []byte, txHashes [][]byte, nonce uint64) error {
+	record := lii.getTransactions(identifier)
+	for _, txHash := range txHashes {
+		record.Transactions = append(record.Transactions, &LogIdentifierTransaction{
+			TxHash:      txHash,
+			HeaderNonce: nonce,
+		})
+	}
+	if len(record.Transactions) > maxTxHashesPerLogIdentifier {
+		record.Transactions = record.Transactions[len(record.Transactions)-maxTxHashesPerLogIdentifier:]
+	}
+
+	return lii.putRecord(lii.identifierKey(identifier), record)
+}
+
+// revertBlock removes the transactions added by the block with the provided nonce (and by any block with a higher
+// nonce) for all the identifiers indexed for that block
+func (lii *logsIdentifierIndex) revertBlock(nonce uint64) error {
+	record, err := lii.getIdentifiersByBlock(nonce)
+	if err != nil {
+		// nothing was indexed for this block
+		return nil
+	}
+
+	for _, identifier := range record.Identifiers {
+		lii.revertIdentifier(identifier, nonce)
+	}
+
+	return lii.storer.Remove(lii.identifiersByBlockKey(nonce))
+}
+
+func (lii *logsIdentifierIndex) revertIdentifier(identifier []byte, nonce uint64) {
+	record := lii.getTransactions(identifier)
+	numKept := len(record.Transactions)
+	for numKept > 0 && record.Transactions[numKept-1].HeaderNonce >= nonce {
+		numKept--
+	}
+	record.Transactions = record.Transactions[:numKept]
+
+	err := lii.putRecord(lii.identifierKey(identifier), record)
+	if err != nil {
+		log.Warn("logsIdentifierIndex.revertIdentifier()", "identifier", identifier, "error", err)
+	}
+}
+
+// getTxHashes returns the hashes of the most recent transactions which generated log events with the provided
+// identifier, the most recent one being the last
+func (lii *logsIdentifierIndex) getTxHashes(identifier []byte) [][]byte {
+	record := lii.getTransactions(identifier)
+	txHashes := make([][]byte, 0, len(record.Transactions))
+	for _, tx := range record.Transactions {
+		txHashes = append(txHashes, tx.TxHash)
+	}
+
+	return txHashes
+}
+
+func (lii *logsIdentifierIndex) getTransactions(identifier []byte) *LogIdentifierTransactions {
+	record := &LogIdentifierTransactions{}
+	recordBytes, err := lii.storer.Get(lii.identifierKey(identifier))
+	if err != nil {
+		return record
+	}
+
+	err = lii.marshalizer.Unmarshal(record, recordBytes)
+	if err != nil {
+		log.Warn("logsIdentifierIndex.getTransactions()", "identifier", identifier, "error", err)
+		return &LogIdentifierTransactions{}
+	}
+
+	return record
+}
+
+func (lii *logsIdentifierIndex) getLog(txHash []byte) (*transaction.Log, error) {
+	logBytes, err := lii.txLogsStorer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	txLog := &transaction.Log{}
+	err = lii.marshalizer.Unmarshal(txLog, logBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return txLog, nil
+}
+
+func (lii *logsIdentifierIndex) getIdentifiersByBlock(nonce uint64) (*LogIdentifiersByBlock, error) {
+	recordBytes, err := lii.storer.Get(lii.identifiersByBlockKey(nonce))
+	if err != nil {
+		return nil, err
+	}
+
+	record := &LogIdentifiersByBlock{}
+	err = lii.marshalizer.Unmarshal(record, recordBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (lii *logsIdentifierIndex) putRecord(key []byte, record interface{}) error {
+	recordBytes, err := lii.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return lii.storer.Put(key, recordBytes)
+}
+
+func (lii *logsIdentifierIndex) identifierKey(identifier []byte) []byte {
+	return append([]byte(logIdentifierKeyPrefix), identifier...)
+}
+
+func (lii *logsIdentifierIndex) identifiersByBlockKey(nonce uint64) []byte {
+	key := make([]byte, len(logIdentifiersByBlockKeyPrefix)+8)
+	copy(key, logIdentifiersByBlockKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(logIdentifiersByBlockKeyPrefix):], nonce)
+
+	return key
+}
@@ -0,0 +1,207 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogsIdentifierIndexRepo(t *testing.T) *historyRepository {
+	args := createMockHistoryRepoArgs(0)
+	args.LogsIdentifierIndexEnabled = true
+	args.LogsIdentifierStorer = createMemoryStorer()
+	args.TxLogsStorer = createMemoryStorer()
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	return repo
+}
+
+func saveTxLog(t *testing.T, repo *historyRepository, txHash string, identifiers ...string) {
+	txLog := &transaction.Log{}
+	for _, identifier := range identifiers {
+		txLog.Events = append(txLog.Events, &transaction.Event{Identifier: []byte(identifier)})
+	}
+
+	buff, err := (&mock.MarshalizerMock{}).Marshal(txLog)
+	require.Nil(t, err)
+	err = repo.logsIdentifierIndex.txLogsStorer.Put([]byte(txHash), buff)
+	require.Nil(t, err)
+}
+
+func recordBlockWithTxs(t *testing.T, repo *historyRepository, nonce uint64, headerHash string, txHashes ...string) {
+	header := &block.Header{Nonce: nonce, Round: nonce}
+	miniBlock := &block.MiniBlock{Type: block.TxBlock}
+	txs := make(map[string]data.TransactionHandler)
+	for _, txHash := range txHashes {
+		miniBlock.TxHashes = append(miniBlock.TxHashes, []byte(txHash))
+		txs[txHash] = &transaction.Transaction{}
+	}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{miniBlock}}
+
+	err := repo.RecordBlock([]byte(headerHash), header, body, txs, nil, nil)
+	require.Nil(t, err)
+}
+
+func getTxHashesByLogIdentifier(t *testing.T, repo *historyRepository, identifier string) []string {
+	txHashes, err := repo.GetTxHashesByLogIdentifier([]byte(identifier))
+	require.Nil(t, err)
+
+	result := make([]string, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		result = append(result, string(txHash))
+	}
+
+	return result
+}
+
+func TestNewHistoryRepository_LogsIdentifierIndexEnabledWithNilStorersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	args.LogsIdentifierIndexEnabled = true
+	args.TxLogsStorer = createMemoryStorer()
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.LogsIdentifierIndexEnabled = true
+	args.LogsIdentifierStorer = createMemoryStorer()
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+}
+
+func TestHistoryRepository_GetTxHashesByLogIdentifierIndexNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	repo, _ := NewHistoryRepository(createMockHistoryRepoArgs(0))
+	txHashes, err := repo.GetTxHashesByLogIdentifier([]byte("issue"))
+	require.Nil(t, txHashes)
+	require.Equal(t, ErrLogIdentifierIndexNotEnabled, err)
+}
+
+func TestHistoryRepository_GetTxHashesByLogIdentifierEmptyIdentifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	repo := createLogsIdentifierIndexRepo(t)
+	txHashes, err := repo.GetTxHashesByLogIdentifier(nil)
+	require.Nil(t, txHashes)
+	require.Equal(t, ErrEmptyLogIdentifier, err)
+}
+
+func TestNilHistoryRepository_GetTxHashesByLogIdentifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	repo, _ := NewNilHistoryRepository()
+	txHashes, err := repo.GetTxHashesByLogIdentifier([]byte("issue"))
+	require.Nil(t, txHashes)
+	require.Equal(t, ErrLogIdentifierIndexNotEnabled, err)
+}
+
+func TestHistoryRepository_RecordBlockShouldIndexLogsByIdentifier(t *testing.T) {
+	t.Parallel()
+
+	repo := createLogsIdentifierIndexRepo(t)
+	saveTxLog(t, repo, "tx1", "issue", "stake", "issue")
+	saveTxLog(t, repo, "tx2", "issue")
+	saveTxLog(t, repo, "scr", "stake")
+
+	require.Equal(t, 0, len(getTxHashesByLogIdentifier(t, repo, "issue")))
+
+	header := &block.Header{Nonce: 1}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("tx1"), []byte("tx2"), []byte("txWithoutLog")}, Type: block.TxBlock},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		"tx1":          &transaction.Transaction{},
+		"tx2":          &transaction.Transaction{},
+		"txWithoutLog": &transaction.Transaction{},
+	}
+	scrs := map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{},
+	}
+	err := repo.RecordBlock([]byte("header1"), header, body, txs, scrs, nil)
+	require.Nil(t, err)
+
+	require.Equal(t, []string{"tx1", "tx2"}, getTxHashesByLogIdentifier(t, repo, "issue"))
+	require.Equal(t, []string{"tx1", "scr"}, getTxHashesByLogIdentifier(t, repo, "stake"))
+	require.Equal(t, 0, len(getTxHashesByLogIdentifier(t, repo, "unknown")))
+}
+
+func TestHistoryRepository_RecordBlockShouldCapIdentifiersPerTx(t *testing.T) {
+	t.Parallel()
+
+	repo := createLogsIdentifierIndexRepo(t)
+	identifiers := make([]string, 0, maxLogIdentifiersPerTx+5)
+	for i := 0; i < maxLogIdentifiersPerTx+5; i++ {
+		identifiers = append(identifiers, fmt.Sprintf("identifier%d", i))
+	}
+	saveTxLog(t, repo, "tx", identifiers...)
+
+	recordBlockWithTxs(t, repo, 1, "header1", "tx")
+
+	for i, identifier := range identifiers {
+		txHashes := getTxHashesByLogIdentifier(t, repo, identifier)
+		if i < maxLogIdentifiersPerTx {
+			require.Equal(t, []string{"tx"}, txHashes)
+		} else {
+			require.Equal(t, 0, len(txHashes))
+		}
+	}
+}
+
+func TestHistoryRepository_RevertBlockShouldRemoveIndexedLogs(t *testing.T) {
+	t.Parallel()
+
+	repo := createLogsIdentifierIndexRepo(t)
+	saveTxLog(t, repo, "tx1", "issue")
+	saveTxLog(t, repo, "tx2", "issue")
+	saveTxLog(t, repo, "tx3", "issue")
+
+	recordBlockWithTxs(t, repo, 1, "header1", "tx1")
+	recordBlockWithTxs(t, repo, 2, "header2", "tx2")
+	require.Equal(t, []string{"tx1", "tx2"}, getTxHashesByLogIdentifier(t, repo, "issue"))
+
+	err := repo.RevertBlock(&block.Header{Nonce: 2}, &block.Body{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1"}, getTxHashesByLogIdentifier(t, repo, "issue"))
+
+	// recording the same block twice should not duplicate the entries
+	recordBlockWithTxs(t, repo, 2, "header2", "tx2")
+	recordBlockWithTxs(t, repo, 2, "header2", "tx2")
+	require.Equal(t, []string{"tx1", "tx2"}, getTxHashesByLogIdentifier(t, repo, "issue"))
+
+	// a different block with the same nonce replaces the entries of the previous one
+	recordBlockWithTxs(t, repo, 2, "header2 on other fork", "tx3")
+	require.Equal(t, []string{"tx1", "tx3"}, getTxHashesByLogIdentifier(t, repo, "issue"))
+}
+
+func TestHistoryRepository_RecordBlockShouldKeepTheMostRecentTxHashes(t *testing.T) {
+	t.Parallel()
+
+	repo := createLogsIdentifierIndexRepo(t)
+	numTxs := maxTxHashesPerLogIdentifier + 2
+	txHashes := make([]string, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		txHash := fmt.Sprintf("tx%d", i)
+		saveTxLog(t, repo, txHash, "issue")
+		txHashes = append(txHashes, txHash)
+	}
+
+	recordBlockWithTxs(t, repo, 1, "header1", txHashes...)
+
+	indexedTxHashes := getTxHashesByLogIdentifier(t, repo, "issue")
+	require.Equal(t, maxTxHashesPerLogIdentifier, len(indexedTxHashes))
+	require.Equal(t, txHashes[2:], indexedTxHashes)
+}
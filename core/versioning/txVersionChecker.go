@@ -9,6 +9,9 @@ const (
 	// MaskSignedWithHash this mask used to verify if LSB from last byte from field options from transaction is set
 	MaskSignedWithHash = uint32(1)
 
+	// MaskGuardedTransaction this mask used to verify if the second LSB from field options from transaction is set
+	MaskGuardedTransaction = uint32(2)
+
 	initialVersionOfTransaction = uint32(1)
 )
 
@@ -34,6 +37,15 @@ func (tvc *txVersionChecker) IsSignedWithHash(tx *transaction.Transaction) bool
 	return false
 }
 
+// IsGuardedTransaction will return true if transaction is co-signed by a guardian
+func (tvc *txVersionChecker) IsGuardedTransaction(tx *transaction.Transaction) bool {
+	if tx.Version > initialVersionOfTransaction {
+		return tx.Options&MaskGuardedTransaction > 0
+	}
+
+	return false
+}
+
 // CheckTxVersion will check transaction version
 func (tvc *txVersionChecker) CheckTxVersion(tx *transaction.Transaction) error {
 	if (tx.Version == initialVersionOfTransaction && tx.Options != 0) || tx.Version < tvc.minTxVersion {
@@ -82,6 +82,22 @@ type VMInput struct {
 
 	// ESDTTokenName is the name of the token which was transferred by the transaction to the SC
 	ESDTTokenName []byte
+
+	// ESDTTokenNonce is the nonce of the non fungible or semi fungible token which was transferred by the transaction to the SC
+	ESDTTokenNonce uint64
+
+	// ESDTTransfers holds the tokens transferred to the SC by an ESDT multi transfer, in the order of the transfers.
+	// When a single token is transferred, ESDTValue and ESDTTokenName are also set.
+	ESDTTransfers []*ESDTTransfer
+}
+
+// ESDTTransfer defines one of the token transfers of an ESDT multi transfer
+type ESDTTransfer struct {
+	// ESDTValue is the value (amount of tokens) transferred
+	ESDTValue *big.Int
+
+	// ESDTTokenName is the name of the transferred token
+	ESDTTokenName []byte
 }
 
 // ContractCreateInput VM input when creating a new contract.
@@ -148,6 +148,7 @@ type EventHandler interface {
 //Trie is an interface for Merkle Trees implementations
 type Trie interface {
 	Get(key []byte) ([]byte, error)
+	GetProof(key []byte) ([][]byte, error)
 	Update(key, value []byte) error
 	Delete(key []byte) error
 	Root() ([]byte, error)
@@ -165,6 +166,7 @@ type Trie interface {
 	Database() DBWriteCacher
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetAllLeavesOnChannel(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error)
+	GetDiff(fromRootHash []byte, toRootHash []byte, handler func(entry *TrieDiffEntry) bool) error
 	GetAllHashes() ([][]byte, error)
 	IsPruningEnabled() bool
 	EnterPruningBufferingMode()
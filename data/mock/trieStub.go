@@ -13,6 +13,7 @@ var errNotImplemented = errors.New("not implemented")
 // TrieStub -
 type TrieStub struct {
 	GetCalled                   func(key []byte) ([]byte, error)
+	GetProofCalled              func(key []byte) ([][]byte, error)
 	UpdateCalled                func(key, value []byte) error
 	DeleteCalled                func(key []byte) error
 	RootCalled                  func() ([]byte, error)
@@ -27,6 +28,7 @@ type TrieStub struct {
 	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
+	GetDiffCalled               func(fromRootHash []byte, toRootHash []byte, handler func(entry *data.TrieDiffEntry) bool) error
 	GetAllHashesCalled          func() ([][]byte, error)
 	IsPruningEnabledCalled      func() bool
 	ClosePersisterCalled        func() error
@@ -58,6 +60,15 @@ func (ts *TrieStub) Get(key []byte) ([]byte, error) {
 	return nil, errNotImplemented
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, errNotImplemented
+}
+
 // Update -
 func (ts *TrieStub) Update(key, value []byte) error {
 	if ts.UpdateCalled != nil {
@@ -120,6 +131,15 @@ func (ts *TrieStub) GetAllLeavesOnChannel(rootHash []byte, _ context.Context) (c
 	return ch, nil
 }
 
+// GetDiff -
+func (ts *TrieStub) GetDiff(fromRootHash []byte, toRootHash []byte, handler func(entry *data.TrieDiffEntry) bool) error {
+	if ts.GetDiffCalled != nil {
+		return ts.GetDiffCalled(fromRootHash, toRootHash, handler)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ts *TrieStub) IsInterfaceNil() bool {
 	return ts == nil
@@ -803,6 +803,14 @@ func (adb *AccountsDB) RecreateAllTries(rootHash []byte, ctx context.Context) (m
 	return allTries, nil
 }
 
+// GetTrie returns the trie that has the given root hash
+func (adb *AccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	return adb.mainTrie.Recreate(rootHash)
+}
+
 // Journalize adds a new object to entries list.
 func (adb *AccountsDB) journalize(entry JournalEntry) {
 	if check.IfNil(entry) {
@@ -915,6 +923,12 @@ func (adb *AccountsDB) GetAllLeaves(rootHash []byte, ctx context.Context) (chan
 	return adb.mainTrie.GetAllLeavesOnChannel(rootHash, ctx)
 }
 
+// GetStateDiff calls the handler for each main trie leaf which was inserted, updated or deleted between the two root
+// hashes. The data trie changes are attached to the changed user accounts
+func (adb *AccountsDB) GetStateDiff(fromRootHash []byte, toRootHash []byte, handler func(entry *StateDiffEntry) bool) error {
+	return adb.getStateDiff(fromRootHash, toRootHash, handler, true)
+}
+
 // GetNumCheckpoints returns the total number of state checkpoints
 func (adb *AccountsDB) GetNumCheckpoints() uint32 {
 	return atomic.LoadUint32(&adb.numCheckpoints)
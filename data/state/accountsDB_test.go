@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -764,6 +765,24 @@ func TestAccountsDB_RecreateTrieOutputsNilTrieShouldErr(t *testing.T) {
 
 }
 
+func TestAccountsDB_GetTrieShouldRecreateFromMainTrie(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	recreatedTrie := &mock.TrieStub{}
+	trieStub := mock.TrieStub{}
+	trieStub.RecreateCalled = func(root []byte) (tree data.Trie, e error) {
+		assert.Equal(t, rootHash, root)
+		return recreatedTrie, nil
+	}
+
+	adb := generateAccountDBFromTrie(&trieStub)
+	tr, err := adb.GetTrie(rootHash)
+
+	assert.Nil(t, err)
+	assert.True(t, tr == recreatedTrie)
+}
+
 func TestAccountsDB_RecreateTrieOkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1394,3 +1413,63 @@ func TestAccountsDB_RemoveAccountMarksObsoleteHashesForEviction(t *testing.T) {
 	oldHashes := ewl.Cache[string(rootHash)]
 	assert.Equal(t, 5, len(oldHashes))
 }
+
+func TestAccountsDB_GetStateDiffNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+	err := adb.GetStateDiff(nil, nil, nil)
+	assert.Equal(t, state.ErrNilStateDiffHandler, err)
+}
+
+func TestAccountsDB_GetStateDiffShouldReturnAccountsAndDataTrieChanges(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+	addr1 := []byte("12345678901234567890123456789012")
+	addr2 := []byte("12345678901234567890123456789013")
+
+	acc, _ := adb.LoadAccount(addr1)
+	_ = acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("dog"), []byte("puppy"))
+	_ = adb.SaveAccount(acc)
+	oldRootHash, err := adb.Commit()
+	assert.Nil(t, err)
+
+	acc, _ = adb.LoadAccount(addr1)
+	_ = acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("dog"), []byte("doggy"))
+	_ = acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("cat"), []byte("kitten"))
+	_ = adb.SaveAccount(acc)
+	acc, _ = adb.LoadAccount(addr2)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(acc)
+	newRootHash, err := adb.Commit()
+	assert.Nil(t, err)
+
+	entries := make(map[string]*state.StateDiffEntry)
+	err = adb.GetStateDiff(oldRootHash, newRootHash, func(entry *state.StateDiffEntry) bool {
+		entries[string(entry.Key)] = entry
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(entries))
+
+	assert.Equal(t, data.TrieDiffUpdated, entries[string(addr1)].Type)
+	dataTrieChanges := make(map[string]*data.TrieDiffEntry)
+	for _, change := range entries[string(addr1)].DataTrieChanges {
+		dataTrieChanges[string(change.Key)] = change
+	}
+	assert.Equal(t, &data.TrieDiffEntry{
+		Type:     data.TrieDiffUpdated,
+		Key:      []byte("dog"),
+		OldValue: []byte("puppy"),
+		NewValue: []byte("doggy"),
+	}, dataTrieChanges["dog"])
+	assert.Equal(t, &data.TrieDiffEntry{
+		Type:     data.TrieDiffInserted,
+		Key:      []byte("cat"),
+		NewValue: []byte("kitten"),
+	}, dataTrieChanges["cat"])
+
+	assert.Equal(t, data.TrieDiffInserted, entries[string(addr2)].Type)
+	assert.Empty(t, entries[string(addr2)].DataTrieChanges)
+}
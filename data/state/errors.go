@@ -115,3 +115,6 @@ var ErrNilMapOfHashes = errors.New("nil map of hashes")
 
 // ErrInvalidRootHash signals that the provided root hash is invalid
 var ErrInvalidRootHash = errors.New("invalid root hash")
+
+// ErrNilStateDiffHandler signals that a nil state diff handler has been provided
+var ErrNilStateDiffHandler = errors.New("nil state diff handler")
@@ -83,6 +83,11 @@ func (adb *PeerAccountsDB) RecreateAllTries(rootHash []byte, _ context.Context)
 	return allTries, nil
 }
 
+// GetStateDiff calls the handler for each peer account which was inserted, updated or deleted between the two root hashes
+func (adb *PeerAccountsDB) GetStateDiff(fromRootHash []byte, toRootHash []byte, handler func(entry *StateDiffEntry) bool) error {
+	return adb.getStateDiff(fromRootHash, toRootHash, handler, false)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (adb *PeerAccountsDB) IsInterfaceNil() bool {
 	return adb == nil
@@ -0,0 +1,104 @@
+package state
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// StateDiffEntry holds the change of one main trie leaf between two root hashes. For user accounts, the changes of the
+// account's data trie are also provided
+type StateDiffEntry struct {
+	*data.TrieDiffEntry
+	DataTrieChanges []*data.TrieDiffEntry
+}
+
+func (adb *AccountsDB) getStateDiff(
+	fromRootHash []byte,
+	toRootHash []byte,
+	handler func(entry *StateDiffEntry) bool,
+	withDataTries bool,
+) error {
+	if handler == nil {
+		return ErrNilStateDiffHandler
+	}
+
+	adb.mutOp.Lock()
+	mainTrie := adb.mainTrie
+	adb.mutOp.Unlock()
+
+	var errDataTrie error
+	err := mainTrie.GetDiff(fromRootHash, toRootHash, func(entry *data.TrieDiffEntry) bool {
+		stateDiffEntry := &StateDiffEntry{
+			TrieDiffEntry: entry,
+		}
+
+		if withDataTries {
+			stateDiffEntry.DataTrieChanges, errDataTrie = adb.getDataTrieDiff(mainTrie, entry)
+			if errDataTrie != nil {
+				return false
+			}
+		}
+
+		return handler(stateDiffEntry)
+	})
+	if err != nil {
+		return err
+	}
+
+	return errDataTrie
+}
+
+func (adb *AccountsDB) getDataTrieDiff(mainTrie data.Trie, entry *data.TrieDiffEntry) ([]*data.TrieDiffEntry, error) {
+	oldRootHash := adb.getDataTrieRootHash(entry.OldValue)
+	newRootHash := adb.getDataTrieRootHash(entry.NewValue)
+	if len(oldRootHash) == 0 && len(newRootHash) == 0 {
+		return nil, nil
+	}
+
+	var errTrim error
+	changes := make([]*data.TrieDiffEntry, 0)
+	err := mainTrie.GetDiff(oldRootHash, newRootHash, func(change *data.TrieDiffEntry) bool {
+		tailLength := len(change.Key) + len(entry.Key)
+		change.OldValue, errTrim = trimDataTrieValue(change.OldValue, tailLength)
+		if errTrim != nil {
+			return false
+		}
+		change.NewValue, errTrim = trimDataTrieValue(change.NewValue, tailLength)
+		if errTrim != nil {
+			return false
+		}
+
+		changes = append(changes, change)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if errTrim != nil {
+		return nil, errTrim
+	}
+
+	return changes, nil
+}
+
+func (adb *AccountsDB) getDataTrieRootHash(accountValue []byte) []byte {
+	if len(accountValue) == 0 {
+		return nil
+	}
+
+	account := &userAccount{}
+	err := adb.marshalizer.Unmarshal(account, accountValue)
+	if err != nil {
+		log.Trace("this must be a leaf with code", "err", err)
+		return nil
+	}
+
+	return account.RootHash
+}
+
+func trimDataTrieValue(value []byte, tailLength int) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+
+	return trimValue(value, tailLength)
+}
@@ -0,0 +1,39 @@
+package stateSnapshot
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilTrieStorageManager signals that a nil trie storage manager has been provided
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
+// ErrNilDatabase signals that a nil database has been provided
+var ErrNilDatabase = errors.New("nil database")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size has been provided
+var ErrInvalidMaxChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrInvalidFrameType signals that a frame of an unexpected type has been read
+var ErrInvalidFrameType = errors.New("invalid frame type")
+
+// ErrFrameTooLarge signals that the frame length exceeds the maximum allowed one
+var ErrFrameTooLarge = errors.New("frame too large")
+
+// ErrFrameHashMismatch signals that the hash of a frame's payload does not match the stored one
+var ErrFrameHashMismatch = errors.New("frame hash mismatch")
+
+// ErrUnsupportedVersion signals that the snapshot file version is not supported
+var ErrUnsupportedVersion = errors.New("unsupported snapshot file version")
+
+// ErrRootHashMismatch signals that the snapshot file holds another root hash than the requested one
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrInvalidChunkIndex signals that the chunks are not in order
+var ErrInvalidChunkIndex = errors.New("invalid chunk index")
+
+// ErrCountersMismatch signals that the footer counters do not match the number of chunks and nodes read
+var ErrCountersMismatch = errors.New("footer counters mismatch")
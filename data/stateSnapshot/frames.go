@@ -0,0 +1,85 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. stateSnapshot.proto
+package stateSnapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+// Version is the current version of the state snapshot file format
+const Version = uint32(1)
+
+// FileExtension is the extension of the state snapshot files
+const FileExtension = "state"
+
+// A state snapshot file is a sequence of frames: one header frame, zero or more chunk frames and one footer frame.
+// Each frame is written as: frame type (1 byte) | payload length (4 bytes, big endian) | payload | hash of payload.
+// The payload is the marshalized SnapshotHeader, SnapshotChunk or SnapshotFooter
+const (
+	frameHeader byte = 1
+	frameChunk  byte = 2
+	frameFooter byte = 3
+)
+
+const frameLengthSize = 4
+
+// maxFrameSize bounds the memory allocated while reading a (possibly corrupted) file
+const maxFrameSize = 256 << 20
+
+// FileName returns the name of the snapshot file holding the state for the given root hash
+func FileName(rootHash []byte) string {
+	return fmt.Sprintf("%s.%s", hex.EncodeToString(rootHash), FileExtension)
+}
+
+func writeFrame(writer io.Writer, hasher hashing.Hasher, frameType byte, payload []byte) error {
+	prefix := make([]byte, 1+frameLengthSize)
+	prefix[0] = frameType
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(payload)))
+
+	_, err := writer.Write(prefix)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(payload)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(hasher.Compute(string(payload)))
+
+	return err
+}
+
+func readFrame(reader io.Reader, hasher hashing.Hasher) (byte, []byte, error) {
+	prefix := make([]byte, 1+frameLengthSize)
+	_, err := io.ReadFull(reader, prefix)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(prefix[1:])
+	if length > maxFrameSize {
+		return 0, nil, fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, length)
+	}
+
+	payload := make([]byte, length)
+	_, err = io.ReadFull(reader, payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	hash := make([]byte, hasher.Size())
+	_, err = io.ReadFull(reader, hash)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !bytes.Equal(hash, hasher.Compute(string(payload))) {
+		return 0, nil, ErrFrameHashMismatch
+	}
+
+	return prefix[0], payload, nil
+}
@@ -0,0 +1,266 @@
+package stateSnapshot
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("data/stateSnapshot")
+
+// the exported tries are only read, so keeping one level in memory is enough
+const maxTrieLevelInMemory = 1
+
+// ArgsStateExporter holds the arguments needed for creating a new state exporter
+type ArgsStateExporter struct {
+	Marshalizer        marshal.Marshalizer
+	Hasher             hashing.Hasher
+	TrieStorageManager data.StorageManager
+	MaxChunkSize       uint64
+}
+
+type stateExporter struct {
+	marshalizer  marshal.Marshalizer
+	hasher       hashing.Hasher
+	trie         data.Trie
+	maxChunkSize uint64
+}
+
+type chunkWriter struct {
+	writer       io.Writer
+	marshalizer  marshal.Marshalizer
+	hasher       hashing.Hasher
+	maxChunkSize uint64
+	chunk        *SnapshotChunk
+	chunkSize    uint64
+	numChunks    uint64
+	numNodes     uint64
+}
+
+// NewStateExporter creates a new instance able to write all the trie nodes of a state into a snapshot file
+func NewStateExporter(args ArgsStateExporter) (*stateExporter, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.TrieStorageManager) {
+		return nil, ErrNilTrieStorageManager
+	}
+	if args.MaxChunkSize == 0 {
+		return nil, ErrInvalidMaxChunkSize
+	}
+
+	tr, err := trie.NewTrie(args.TrieStorageManager, args.Marshalizer, args.Hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateExporter{
+		marshalizer:  args.Marshalizer,
+		hasher:       args.Hasher,
+		trie:         tr,
+		maxChunkSize: args.MaxChunkSize,
+	}, nil
+}
+
+// Export writes all the trie nodes reachable from the given root hash into the writer and returns the number of
+// written nodes. If withDataTries is set, the main trie leaves are decoded as user accounts and the nodes of all
+// their data tries are written as well
+func (se *stateExporter) Export(rootHash []byte, withDataTries bool, writer io.Writer) (uint64, error) {
+	header := &SnapshotHeader{
+		Version:  Version,
+		RootHash: rootHash,
+	}
+	headerBytes, err := se.marshalizer.Marshal(header)
+	if err != nil {
+		return 0, err
+	}
+	err = writeFrame(writer, se.hasher, frameHeader, headerBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	cw := &chunkWriter{
+		writer:       writer,
+		marshalizer:  se.marshalizer,
+		hasher:       se.hasher,
+		maxChunkSize: se.maxChunkSize,
+		chunk:        &SnapshotChunk{},
+	}
+
+	err = se.exportTrie(rootHash, cw)
+	if err != nil {
+		return 0, err
+	}
+
+	if withDataTries {
+		err = se.exportDataTries(rootHash, cw)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = cw.flush()
+	if err != nil {
+		return 0, err
+	}
+
+	footer := &SnapshotFooter{
+		NumChunks: cw.numChunks,
+		NumNodes:  cw.numNodes,
+	}
+	footerBytes, err := se.marshalizer.Marshal(footer)
+	if err != nil {
+		return 0, err
+	}
+	err = writeFrame(writer, se.hasher, frameFooter, footerBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Debug("state snapshot exported", "root hash", rootHash, "num chunks", cw.numChunks, "num nodes", cw.numNodes)
+
+	return cw.numNodes, nil
+}
+
+func (se *stateExporter) exportTrie(rootHash []byte, cw *chunkWriter) error {
+	if isEmptyRootHash(rootHash) {
+		return nil
+	}
+
+	tr, err := se.trie.Recreate(rootHash)
+	if err != nil {
+		return err
+	}
+
+	it, err := trie.NewIterator(tr)
+	if err != nil {
+		return err
+	}
+
+	encNode, err := it.MarshalizedNode()
+	if err != nil {
+		return err
+	}
+	err = cw.add(encNode)
+	if err != nil {
+		return err
+	}
+
+	for it.HasNext() {
+		err = it.Next()
+		if err != nil {
+			return err
+		}
+
+		encNode, err = it.MarshalizedNode()
+		if err != nil {
+			return err
+		}
+		err = cw.add(encNode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (se *stateExporter) exportDataTries(rootHash []byte, cw *chunkWriter) error {
+	if isEmptyRootHash(rootHash) {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannel, err := se.trie.GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return err
+	}
+
+	dataTriesRootHashes := make([][]byte, 0)
+	exported := make(map[string]struct{})
+	for leaf := range leavesChannel {
+		account := state.NewEmptyUserAccount()
+		err = se.marshalizer.Unmarshal(account, leaf.Value())
+		if err != nil {
+			log.Trace("this must be a leaf with code", "err", err)
+			continue
+		}
+
+		_, found := exported[string(account.RootHash)]
+		if isEmptyRootHash(account.RootHash) || found {
+			continue
+		}
+
+		exported[string(account.RootHash)] = struct{}{}
+		dataTriesRootHashes = append(dataTriesRootHashes, account.RootHash)
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		err = se.exportTrie(dataTrieRootHash, cw)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *stateExporter) IsInterfaceNil() bool {
+	return se == nil
+}
+
+func (cw *chunkWriter) add(encNode []byte) error {
+	if len(cw.chunk.Nodes) > 0 && cw.chunkSize+uint64(len(encNode)) > cw.maxChunkSize {
+		err := cw.flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.chunk.Nodes = append(cw.chunk.Nodes, encNode)
+	cw.chunkSize += uint64(len(encNode))
+	cw.numNodes++
+
+	return nil
+}
+
+func (cw *chunkWriter) flush() error {
+	if len(cw.chunk.Nodes) == 0 {
+		return nil
+	}
+
+	cw.chunk.Index = cw.numChunks
+	chunkBytes, err := cw.marshalizer.Marshal(cw.chunk)
+	if err != nil {
+		return err
+	}
+
+	err = writeFrame(cw.writer, cw.hasher, frameChunk, chunkBytes)
+	if err != nil {
+		return err
+	}
+
+	cw.numChunks++
+	cw.chunk = &SnapshotChunk{}
+	cw.chunkSize = 0
+
+	return nil
+}
+
+func isEmptyRootHash(rootHash []byte) bool {
+	return len(rootHash) == 0 || bytes.Equal(rootHash, trie.EmptyTrieHash)
+}
@@ -0,0 +1,119 @@
+package stateSnapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// ArgsStateImporter holds the arguments needed for creating a new state importer
+type ArgsStateImporter struct {
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+type stateImporter struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewStateImporter creates a new instance able to seed a trie database from a snapshot file
+func NewStateImporter(args ArgsStateImporter) (*stateImporter, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &stateImporter{
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// Import reads the snapshot file for the given root hash and puts all its trie nodes in the database, keyed by their
+// hash. Each frame is checked against its hash, so a corrupted file is rejected. The nodes written before an error
+// are valid trie nodes and can be kept, the trie syncer will request only the missing ones
+func (si *stateImporter) Import(reader io.Reader, rootHash []byte, db data.DBWriteCacher) (uint64, error) {
+	if check.IfNil(db) {
+		return 0, ErrNilDatabase
+	}
+
+	header := &SnapshotHeader{}
+	err := si.readFrameInto(reader, frameHeader, header)
+	if err != nil {
+		return 0, err
+	}
+	if header.Version != Version {
+		return 0, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header.Version)
+	}
+	if !bytes.Equal(header.RootHash, rootHash) {
+		return 0, ErrRootHashMismatch
+	}
+
+	numChunks := uint64(0)
+	numNodes := uint64(0)
+	for {
+		frameType, payload, errRead := readFrame(reader, si.hasher)
+		if errRead != nil {
+			return numNodes, errRead
+		}
+
+		switch frameType {
+		case frameChunk:
+			chunk := &SnapshotChunk{}
+			err = si.marshalizer.Unmarshal(chunk, payload)
+			if err != nil {
+				return numNodes, err
+			}
+			if chunk.Index != numChunks {
+				return numNodes, fmt.Errorf("%w: expected %d, got %d", ErrInvalidChunkIndex, numChunks, chunk.Index)
+			}
+
+			for _, encNode := range chunk.Nodes {
+				err = db.Put(si.hasher.Compute(string(encNode)), encNode)
+				if err != nil {
+					return numNodes, err
+				}
+				numNodes++
+			}
+			numChunks++
+		case frameFooter:
+			footer := &SnapshotFooter{}
+			err = si.marshalizer.Unmarshal(footer, payload)
+			if err != nil {
+				return numNodes, err
+			}
+			if footer.NumChunks != numChunks || footer.NumNodes != numNodes {
+				return numNodes, ErrCountersMismatch
+			}
+
+			return numNodes, nil
+		default:
+			return numNodes, fmt.Errorf("%w: %d", ErrInvalidFrameType, frameType)
+		}
+	}
+}
+
+func (si *stateImporter) readFrameInto(reader io.Reader, expectedType byte, destination interface{}) error {
+	frameType, payload, err := readFrame(reader, si.hasher)
+	if err != nil {
+		return err
+	}
+	if frameType != expectedType {
+		return fmt.Errorf("%w: expected %d, got %d", ErrInvalidFrameType, expectedType, frameType)
+	}
+
+	return si.marshalizer.Unmarshal(destination, payload)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *stateImporter) IsInterfaceNil() bool {
+	return si == nil
+}
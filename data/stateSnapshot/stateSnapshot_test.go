@@ -0,0 +1,223 @@
+package stateSnapshot
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = &blake2b.Blake2b{}
+
+type dbStub struct {
+	putErr error
+}
+
+func (db *dbStub) Put(_, _ []byte) error {
+	return db.putErr
+}
+
+func (db *dbStub) Get(_ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (db *dbStub) Remove(_ []byte) error {
+	return nil
+}
+
+func (db *dbStub) Close() error {
+	return nil
+}
+
+func (db *dbStub) IsInterfaceNil() bool {
+	return db == nil
+}
+
+func createTrieStorageManager(t *testing.T) data.StorageManager {
+	tsm, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	require.Nil(t, err)
+
+	return tsm
+}
+
+func createTrie(t *testing.T, tsm data.StorageManager, numLeaves int, prefix string) (data.Trie, []byte) {
+	tr, err := trie.NewTrie(tsm, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+
+	for i := 0; i < numLeaves; i++ {
+		err = tr.Update([]byte(fmt.Sprintf("%skey%d", prefix, i)), []byte(fmt.Sprintf("%svalue%d", prefix, i)))
+		require.Nil(t, err)
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.Root()
+	require.Nil(t, err)
+
+	return tr, rootHash
+}
+
+// createAccountsState creates a main trie holding 3 accounts, two of them sharing the same data trie
+func createAccountsState(t *testing.T, tsm data.StorageManager) []byte {
+	_, dataTrieRootHash := createTrie(t, tsm, 20, "data")
+	mainTrie, err := trie.NewTrie(tsm, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		account, errNew := state.NewUserAccount([]byte(fmt.Sprintf("address%d", i)))
+		require.Nil(t, errNew)
+		if i > 0 {
+			account.SetRootHash(dataTrieRootHash)
+		}
+
+		accountBytes, errMarshal := testMarshalizer.Marshal(account)
+		require.Nil(t, errMarshal)
+		require.Nil(t, mainTrie.Update(account.AddressBytes(), accountBytes))
+	}
+	require.Nil(t, mainTrie.Commit())
+
+	rootHash, err := mainTrie.Root()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func exportState(t *testing.T, tsm data.StorageManager, rootHash []byte, withDataTries bool, maxChunkSize uint64) ([]byte, uint64) {
+	exporter, err := NewStateExporter(ArgsStateExporter{
+		Marshalizer:        testMarshalizer,
+		Hasher:             testHasher,
+		TrieStorageManager: tsm,
+		MaxChunkSize:       maxChunkSize,
+	})
+	require.Nil(t, err)
+
+	buff := &bytes.Buffer{}
+	numNodes, err := exporter.Export(rootHash, withDataTries, buff)
+	require.Nil(t, err)
+
+	return buff.Bytes(), numNodes
+}
+
+func createImporter(t *testing.T) *stateImporter {
+	importer, err := NewStateImporter(ArgsStateImporter{
+		Marshalizer: testMarshalizer,
+		Hasher:      testHasher,
+	})
+	require.Nil(t, err)
+
+	return importer
+}
+
+func TestNewStateExporter_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsStateExporter{
+		Marshalizer:        testMarshalizer,
+		Hasher:             testHasher,
+		TrieStorageManager: createTrieStorageManager(t),
+		MaxChunkSize:       0,
+	}
+	exporter, err := NewStateExporter(args)
+	assert.Nil(t, exporter)
+	assert.Equal(t, ErrInvalidMaxChunkSize, err)
+
+	args.MaxChunkSize = 10
+	args.TrieStorageManager = nil
+	exporter, err = NewStateExporter(args)
+	assert.Nil(t, exporter)
+	assert.Equal(t, ErrNilTrieStorageManager, err)
+}
+
+func TestStateExportImport_ShouldCopyTheMainTrieAndTheDataTries(t *testing.T) {
+	t.Parallel()
+
+	tsm := createTrieStorageManager(t)
+	rootHash := createAccountsState(t, tsm)
+
+	// small chunks so that the nodes are spread over more chunks
+	fileBytes, numExported := exportState(t, tsm, rootHash, true, 100)
+	_, numExportedWithoutDataTries := exportState(t, tsm, rootHash, false, 100)
+	assert.True(t, numExported > numExportedWithoutDataTries)
+
+	destinationTsm := createTrieStorageManager(t)
+	numImported, err := createImporter(t).Import(bytes.NewReader(fileBytes), rootHash, destinationTsm.Database())
+	require.Nil(t, err)
+	assert.Equal(t, numExported, numImported)
+
+	destinationTrie, err := trie.NewTrie(destinationTsm, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+	destinationAdb, err := state.NewAccountsDB(
+		destinationTrie,
+		testHasher,
+		testMarshalizer,
+		factory.NewAccountCreator(),
+	)
+	require.Nil(t, err)
+	require.Nil(t, destinationAdb.RecreateTrie(rootHash))
+
+	account, err := destinationAdb.LoadAccount([]byte("address2"))
+	require.Nil(t, err)
+	dataTrie, err := destinationTrie.Recreate(account.(state.UserAccountHandler).GetRootHash())
+	require.Nil(t, err)
+	value, err := dataTrie.Get([]byte("datakey7"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("datavalue7"), value)
+}
+
+func TestStateExportImport_EmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	fileBytes, numExported := exportState(t, createTrieStorageManager(t), trie.EmptyTrieHash, true, 100)
+	assert.Equal(t, uint64(0), numExported)
+
+	numImported, err := createImporter(t).Import(bytes.NewReader(fileBytes), trie.EmptyTrieHash, memorydb.New())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), numImported)
+}
+
+func TestStateImporter_Import(t *testing.T) {
+	t.Parallel()
+
+	tsm := createTrieStorageManager(t)
+	_, rootHash := createTrie(t, tsm, 50, "")
+	fileBytes, _ := exportState(t, tsm, rootHash, false, 200)
+
+	t.Run("root hash mismatch should err", func(t *testing.T) {
+		_, err := createImporter(t).Import(bytes.NewReader(fileBytes), []byte("other root hash"), memorydb.New())
+		assert.Equal(t, ErrRootHashMismatch, err)
+	})
+	t.Run("corrupted chunk should err", func(t *testing.T) {
+		corrupted := append([]byte{}, fileBytes...)
+		corrupted[len(corrupted)/2]++
+
+		_, err := createImporter(t).Import(bytes.NewReader(corrupted), rootHash, memorydb.New())
+		assert.Equal(t, ErrFrameHashMismatch, err)
+	})
+	t.Run("truncated file should err", func(t *testing.T) {
+		_, err := createImporter(t).Import(bytes.NewReader(fileBytes[:len(fileBytes)-10]), rootHash, memorydb.New())
+		assert.NotNil(t, err)
+	})
+	t.Run("database error should err", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		db := &dbStub{putErr: expectedErr}
+
+		_, err := createImporter(t).Import(bytes.NewReader(fileBytes), rootHash, db)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestFileName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0a0b.state", FileName([]byte{10, 11}))
+}
@@ -15,4 +15,7 @@ const (
 
 	// TxTypeInvalid represents the identifier for an invalid transaction
 	TxTypeInvalid TxType = "invalid"
+
+	// TxTypeReceipt represents the identifier for a receipt
+	TxTypeReceipt TxType = "receipt"
 )
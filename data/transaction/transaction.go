@@ -55,7 +55,8 @@ func TrimSliceHandler(in []data.TransactionHandler) []data.TransactionHandler {
 	return ret
 }
 
-// GetDataForSigning returns the serialized transaction having an empty signature field
+// GetDataForSigning returns the serialized transaction having empty signature fields. The guardian address,
+// when set, is part of the signed data so both the sender and the guardian sign the same payload
 func (tx *Transaction) GetDataForSigning(encoder Encoder, marshalizer Marshalizer) ([]byte, error) {
 	if check.IfNil(encoder) {
 		return nil, ErrNilEncoder
@@ -79,5 +80,9 @@ func (tx *Transaction) GetDataForSigning(encoder Encoder, marshalizer Marshalize
 		Options:          tx.Options,
 	}
 
+	if len(tx.GuardianAddr) > 0 {
+		ftx.GuardianAddr = encoder.Encode(tx.GuardianAddr)
+	}
+
 	return marshalizer.Marshal(ftx)
 }
@@ -30,19 +30,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Transaction holds all the data needed for a value transfer or SC call
 type Transaction struct {
-	Nonce       uint64        `protobuf:"varint,1,opt,name=Nonce,proto3" json:"nonce"`
-	Value       *math_big.Int `protobuf:"bytes,2,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
-	RcvAddr     []byte        `protobuf:"bytes,3,opt,name=RcvAddr,proto3" json:"receiver"`
-	RcvUserName []byte        `protobuf:"bytes,4,opt,name=RcvUserName,proto3" json:"rcvUserName,omitempty"`
-	SndAddr     []byte        `protobuf:"bytes,5,opt,name=SndAddr,proto3" json:"sender"`
-	SndUserName []byte        `protobuf:"bytes,6,opt,name=SndUserName,proto3" json:"sndUserName,omitempty"`
-	GasPrice    uint64        `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"gasPrice,omitempty"`
-	GasLimit    uint64        `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"gasLimit,omitempty"`
-	Data        []byte        `protobuf:"bytes,9,opt,name=Data,proto3" json:"data,omitempty"`
-	ChainID     []byte        `protobuf:"bytes,10,opt,name=ChainID,proto3" json:"chainID"`
-	Version     uint32        `protobuf:"varint,11,opt,name=Version,proto3" json:"version"`
-	Signature   []byte        `protobuf:"bytes,12,opt,name=Signature,proto3" json:"signature,omitempty"`
-	Options     uint32        `protobuf:"varint,13,opt,name=Options,proto3" json:"options,omitempty"`
+	Nonce             uint64        `protobuf:"varint,1,opt,name=Nonce,proto3" json:"nonce"`
+	Value             *math_big.Int `protobuf:"bytes,2,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	RcvAddr           []byte        `protobuf:"bytes,3,opt,name=RcvAddr,proto3" json:"receiver"`
+	RcvUserName       []byte        `protobuf:"bytes,4,opt,name=RcvUserName,proto3" json:"rcvUserName,omitempty"`
+	SndAddr           []byte        `protobuf:"bytes,5,opt,name=SndAddr,proto3" json:"sender"`
+	SndUserName       []byte        `protobuf:"bytes,6,opt,name=SndUserName,proto3" json:"sndUserName,omitempty"`
+	GasPrice          uint64        `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"gasPrice,omitempty"`
+	GasLimit          uint64        `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"gasLimit,omitempty"`
+	Data              []byte        `protobuf:"bytes,9,opt,name=Data,proto3" json:"data,omitempty"`
+	ChainID           []byte        `protobuf:"bytes,10,opt,name=ChainID,proto3" json:"chainID"`
+	Version           uint32        `protobuf:"varint,11,opt,name=Version,proto3" json:"version"`
+	Signature         []byte        `protobuf:"bytes,12,opt,name=Signature,proto3" json:"signature,omitempty"`
+	Options           uint32        `protobuf:"varint,13,opt,name=Options,proto3" json:"options,omitempty"`
+	GuardianAddr      []byte        `protobuf:"bytes,14,opt,name=GuardianAddr,proto3" json:"guardian,omitempty"`
+	GuardianSignature []byte        `protobuf:"bytes,15,opt,name=GuardianSignature,proto3" json:"guardianSignature,omitempty"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -164,6 +166,20 @@ func (m *Transaction) GetOptions() uint32 {
 	return 0
 }
 
+func (m *Transaction) GetGuardianAddr() []byte {
+	if m != nil {
+		return m.GuardianAddr
+	}
+	return nil
+}
+
+func (m *Transaction) GetGuardianSignature() []byte {
+	if m != nil {
+		return m.GuardianSignature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Transaction)(nil), "proto.Transaction")
 }
@@ -171,40 +187,42 @@ func init() {
 func init() { proto.RegisterFile("transaction.proto", fileDescriptor_2cc4e03d2c28c490) }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x58, 0x96, 0xd5, 0xed, 0x90, 0x66, 0x34, 0x30, 0x1c, 0xec, 0x0a, 0xc1, 0xd4,
-	0x03, 0x6b, 0x24, 0x10, 0xa7, 0x9d, 0xd6, 0x6d, 0x42, 0x95, 0x50, 0x41, 0x29, 0xec, 0xc0, 0xcd,
-	0x4d, 0x4c, 0x6a, 0xb1, 0xd8, 0x95, 0xe3, 0x16, 0x71, 0xe3, 0x11, 0x78, 0x06, 0x4e, 0x88, 0x27,
-	0xe1, 0xd8, 0x63, 0x4f, 0x81, 0xa6, 0x17, 0x94, 0xd3, 0x1e, 0x01, 0xc5, 0x69, 0xd7, 0xc0, 0xa9,
-	0xfd, 0xff, 0xbe, 0xef, 0xfb, 0x7f, 0x96, 0xe5, 0xc0, 0x03, 0xa3, 0x99, 0x4c, 0x59, 0x68, 0x84,
-	0x92, 0xdd, 0x89, 0x56, 0x46, 0x21, 0xd7, 0xfe, 0x3c, 0x3c, 0x8e, 0x85, 0x19, 0x4f, 0x47, 0xdd,
-	0x50, 0x25, 0x7e, 0xac, 0x62, 0xe5, 0x5b, 0x3c, 0x9a, 0x7e, 0xb0, 0x93, 0x1d, 0xec, 0xbf, 0x2a,
-	0xf5, 0xe8, 0x9b, 0x0b, 0x9b, 0x6f, 0xb7, 0xbb, 0x10, 0x85, 0xee, 0x40, 0xc9, 0x90, 0x63, 0xd0,
-	0x06, 0x9d, 0x9d, 0x5e, 0xa3, 0xc8, 0xa8, 0x2b, 0x4b, 0x10, 0x54, 0x1c, 0x45, 0xd0, 0xbd, 0x64,
-	0x57, 0x53, 0x8e, 0x6f, 0xb5, 0x41, 0xa7, 0xd5, 0x1b, 0x94, 0x86, 0x59, 0x09, 0x7e, 0xfc, 0xa2,
-	0xa7, 0x09, 0x33, 0x63, 0x7f, 0x24, 0xe2, 0x6e, 0x5f, 0x9a, 0x93, 0xda, 0x41, 0x2e, 0xae, 0xb4,
-	0x92, 0xd1, 0x80, 0x9b, 0x4f, 0x4a, 0x7f, 0xf4, 0xb9, 0x9d, 0x8e, 0x63, 0xe5, 0x47, 0xcc, 0xb0,
-	0x6e, 0x4f, 0xc4, 0x7d, 0x69, 0xce, 0x58, 0x6a, 0xb8, 0x0e, 0xaa, 0xe5, 0xe8, 0x08, 0x7a, 0x41,
-	0x38, 0x3b, 0x8d, 0x22, 0x8d, 0x6f, 0xdb, 0x9e, 0x56, 0x91, 0xd1, 0x3d, 0xcd, 0x43, 0x2e, 0x66,
-	0x5c, 0x07, 0x1b, 0x11, 0x9d, 0xc0, 0x66, 0x10, 0xce, 0xde, 0xa5, 0x5c, 0x0f, 0x58, 0xc2, 0xf1,
-	0x8e, 0xf5, 0x3e, 0x28, 0x32, 0x7a, 0xa8, 0xb7, 0xf8, 0xa9, 0x4a, 0x84, 0xe1, 0xc9, 0xc4, 0x7c,
-	0x0e, 0xea, 0x6e, 0xf4, 0x18, 0x7a, 0x43, 0x19, 0xd9, 0x12, 0xd7, 0x06, 0x61, 0x91, 0xd1, 0xdd,
-	0x94, 0xcb, 0xa8, 0xac, 0x58, 0x4b, 0x65, 0xc5, 0x50, 0x46, 0x37, 0x15, 0xbb, 0xdb, 0x8a, 0x74,
-	0x8b, 0xeb, 0x15, 0x35, 0x37, 0x7a, 0x06, 0xf7, 0x5e, 0xb2, 0xf4, 0x8d, 0x16, 0x21, 0xc7, 0x9e,
-	0xbd, 0xd1, 0x7b, 0x45, 0x46, 0x51, 0xbc, 0x66, 0xb5, 0xd8, 0x8d, 0x6f, 0x9d, 0x79, 0x25, 0x12,
-	0x61, 0xf0, 0xde, 0x3f, 0x19, 0xcb, 0xfe, 0xcb, 0x58, 0x86, 0x8e, 0xe0, 0xce, 0x39, 0x33, 0x0c,
-	0x37, 0xec, 0xe9, 0x50, 0x91, 0xd1, 0x3b, 0xe5, 0xdd, 0xd6, 0xbc, 0x56, 0x47, 0x4f, 0xa0, 0x77,
-	0x36, 0x66, 0x42, 0xf6, 0xcf, 0x31, 0xb4, 0xd6, 0x66, 0x91, 0x51, 0x2f, 0xac, 0x50, 0xb0, 0xd1,
-	0x4a, 0xdb, 0x25, 0xd7, 0xa9, 0x50, 0x12, 0x37, 0xdb, 0xa0, 0xb3, 0x5f, 0xd9, 0x66, 0x15, 0x0a,
-	0x36, 0x1a, 0x7a, 0x01, 0x1b, 0x43, 0x11, 0x4b, 0x66, 0xa6, 0x9a, 0xe3, 0x96, 0xdd, 0x77, 0xbf,
-	0xc8, 0xe8, 0xdd, 0x74, 0x03, 0x6b, 0xfd, 0x5b, 0x27, 0xf2, 0xa1, 0xf7, 0x7a, 0x52, 0xbe, 0xb6,
-	0x14, 0xef, 0xdb, 0xed, 0x87, 0x45, 0x46, 0x0f, 0x54, 0x85, 0x6a, 0x91, 0x8d, 0xab, 0x77, 0x31,
-	0x5f, 0x12, 0x67, 0xb1, 0x24, 0xce, 0xf5, 0x92, 0x80, 0x2f, 0x39, 0x01, 0xdf, 0x73, 0x02, 0x7e,
-	0xe6, 0x04, 0xcc, 0x73, 0x02, 0x16, 0x39, 0x01, 0xbf, 0x73, 0x02, 0xfe, 0xe4, 0xc4, 0xb9, 0xce,
-	0x09, 0xf8, 0xba, 0x22, 0xce, 0x7c, 0x45, 0x9c, 0xc5, 0x8a, 0x38, 0xef, 0x9b, 0xb5, 0xef, 0x64,
-	0xb4, 0x6b, 0x9f, 0xfc, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0xda, 0x4b, 0xdc, 0x3d,
-	0x03, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x63, 0x58, 0x9b, 0xcd, 0xed, 0x86, 0x66, 0x34, 0x08, 0x20, 0xd9, 0x13, 0x82, 0xa9,
+	0x07, 0xd6, 0x48, 0x20, 0x2e, 0xec, 0xb4, 0x6e, 0xd3, 0x54, 0x09, 0x0a, 0x4a, 0x61, 0x07, 0x6e,
+	0x6e, 0x62, 0x52, 0x8b, 0xc5, 0xae, 0x1c, 0xb7, 0x88, 0x1b, 0x8f, 0xc0, 0x63, 0x20, 0x24, 0xde,
+	0x83, 0x63, 0x8f, 0x3d, 0x05, 0x9a, 0x5e, 0x50, 0x4e, 0x7b, 0x04, 0x14, 0xa7, 0x59, 0xb3, 0xc1,
+	0x29, 0xf9, 0x7e, 0xdf, 0xff, 0xff, 0xfd, 0xad, 0x2f, 0x31, 0xdc, 0xd6, 0x8a, 0x8a, 0x98, 0xfa,
+	0x9a, 0x4b, 0xd1, 0x1e, 0x29, 0xa9, 0x25, 0xaa, 0x99, 0xc7, 0xfd, 0xfd, 0x90, 0xeb, 0xe1, 0x78,
+	0xd0, 0xf6, 0x65, 0xe4, 0x86, 0x32, 0x94, 0xae, 0xc1, 0x83, 0xf1, 0x07, 0x53, 0x99, 0xc2, 0xbc,
+	0x15, 0xae, 0x87, 0x3f, 0xea, 0xb0, 0xf1, 0x76, 0x35, 0x0b, 0x11, 0x58, 0xeb, 0x49, 0xe1, 0x33,
+	0x07, 0xec, 0x82, 0xd6, 0x5a, 0x67, 0x23, 0x4b, 0x48, 0x4d, 0xe4, 0xc0, 0x2b, 0x38, 0x0a, 0x60,
+	0xed, 0x8c, 0x9e, 0x8f, 0x99, 0x73, 0x63, 0x17, 0xb4, 0x9a, 0x9d, 0x5e, 0x2e, 0x98, 0xe4, 0xe0,
+	0xfb, 0x2f, 0x72, 0x18, 0x51, 0x3d, 0x74, 0x07, 0x3c, 0x6c, 0x77, 0x85, 0x3e, 0xa8, 0x1c, 0xe4,
+	0xe4, 0x5c, 0x49, 0x11, 0xf4, 0x98, 0xfe, 0x24, 0xd5, 0x47, 0x97, 0x99, 0x6a, 0x3f, 0x94, 0x6e,
+	0x40, 0x35, 0x6d, 0x77, 0x78, 0xd8, 0x15, 0xfa, 0x88, 0xc6, 0x9a, 0x29, 0xaf, 0x18, 0x8e, 0xf6,
+	0xa0, 0xed, 0xf9, 0x93, 0xc3, 0x20, 0x50, 0xce, 0x4d, 0x93, 0xd3, 0xcc, 0x12, 0xb2, 0xae, 0x98,
+	0xcf, 0xf8, 0x84, 0x29, 0xaf, 0x6c, 0xa2, 0x03, 0xd8, 0xf0, 0xfc, 0xc9, 0xbb, 0x98, 0xa9, 0x1e,
+	0x8d, 0x98, 0xb3, 0x66, 0xb4, 0xf7, 0xb2, 0x84, 0xec, 0xa8, 0x15, 0x7e, 0x22, 0x23, 0xae, 0x59,
+	0x34, 0xd2, 0x9f, 0xbd, 0xaa, 0x1a, 0x3d, 0x82, 0x76, 0x5f, 0x04, 0x26, 0xa4, 0x66, 0x8c, 0x30,
+	0x4b, 0x48, 0x3d, 0x66, 0x22, 0xc8, 0x23, 0x96, 0xad, 0x3c, 0xa2, 0x2f, 0x82, 0xcb, 0x88, 0xfa,
+	0x2a, 0x22, 0x16, 0xc1, 0xff, 0x22, 0x2a, 0x6a, 0xf4, 0x14, 0xae, 0x9f, 0xd2, 0xf8, 0x8d, 0xe2,
+	0x3e, 0x73, 0x6c, 0xb3, 0xd1, 0x3b, 0x59, 0x42, 0x50, 0xb8, 0x64, 0x15, 0xdb, 0xa5, 0x6e, 0xe9,
+	0x79, 0xc9, 0x23, 0xae, 0x9d, 0xf5, 0x2b, 0x1e, 0xc3, 0xae, 0x79, 0x0c, 0x43, 0x7b, 0x70, 0xed,
+	0x98, 0x6a, 0xea, 0x6c, 0x98, 0xd3, 0xa1, 0x2c, 0x21, 0x5b, 0xf9, 0x6e, 0x2b, 0x5a, 0xd3, 0x47,
+	0x8f, 0xa1, 0x7d, 0x34, 0xa4, 0x5c, 0x74, 0x8f, 0x1d, 0x68, 0xa4, 0x8d, 0x2c, 0x21, 0xb6, 0x5f,
+	0x20, 0xaf, 0xec, 0xe5, 0xb2, 0x33, 0xa6, 0x62, 0x2e, 0x85, 0xd3, 0xd8, 0x05, 0xad, 0xcd, 0x42,
+	0x36, 0x29, 0x90, 0x57, 0xf6, 0xd0, 0x73, 0xb8, 0xd1, 0xe7, 0xa1, 0xa0, 0x7a, 0xac, 0x98, 0xd3,
+	0x34, 0xf3, 0xee, 0x66, 0x09, 0xb9, 0x1d, 0x97, 0xb0, 0x92, 0xbf, 0x52, 0x22, 0x17, 0xda, 0xaf,
+	0x47, 0xf9, 0xdf, 0x16, 0x3b, 0x9b, 0x66, 0xfa, 0x4e, 0x96, 0x90, 0x6d, 0x59, 0xa0, 0x8a, 0xa5,
+	0x54, 0xa1, 0x17, 0xb0, 0x79, 0x3a, 0xa6, 0x2a, 0xe0, 0x54, 0x98, 0xaf, 0xb5, 0x65, 0xa2, 0x8a,
+	0xad, 0x2c, 0x79, 0xc5, 0x76, 0x45, 0x8b, 0x5e, 0xc1, 0xed, 0xb2, 0x5e, 0x9d, 0xf5, 0x96, 0x19,
+	0x40, 0xb2, 0x84, 0x3c, 0x08, 0xaf, 0x37, 0x2b, 0x93, 0xfe, 0x75, 0x76, 0x4e, 0xa6, 0x73, 0x6c,
+	0xcd, 0xe6, 0xd8, 0xba, 0x98, 0x63, 0xf0, 0x25, 0xc5, 0xe0, 0x5b, 0x8a, 0xc1, 0xcf, 0x14, 0x83,
+	0x69, 0x8a, 0xc1, 0x2c, 0xc5, 0xe0, 0x77, 0x8a, 0xc1, 0x9f, 0x14, 0x5b, 0x17, 0x29, 0x06, 0x5f,
+	0x17, 0xd8, 0x9a, 0x2e, 0xb0, 0x35, 0x5b, 0x60, 0xeb, 0x7d, 0xa3, 0x72, 0x65, 0x07, 0x75, 0x73,
+	0xfb, 0x9e, 0xfd, 0x1d, 0x00, 0x87, 0x84, 0x6b, 0xb9, 0xc8, 0x03, 0x00, 0x00,
 }
 
 func (this *Transaction) Equal(that interface{}) bool {
@@ -268,13 +286,19 @@ func (this *Transaction) Equal(that interface{}) bool {
 	if this.Options != that1.Options {
 		return false
 	}
+	if !bytes.Equal(this.GuardianAddr, that1.GuardianAddr) {
+		return false
+	}
+	if !bytes.Equal(this.GuardianSignature, that1.GuardianSignature) {
+		return false
+	}
 	return true
 }
 func (this *Transaction) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 19)
 	s = append(s, "&transaction.Transaction{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
@@ -289,6 +313,8 @@ func (this *Transaction) GoString() string {
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
 	s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	s = append(s, "GuardianAddr: "+fmt.Sprintf("%#v", this.GuardianAddr)+",\n")
+	s = append(s, "GuardianSignature: "+fmt.Sprintf("%#v", this.GuardianSignature)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -320,6 +346,20 @@ func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GuardianSignature) > 0 {
+		i -= len(m.GuardianSignature)
+		copy(dAtA[i:], m.GuardianSignature)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.GuardianSignature)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.GuardianAddr) > 0 {
+		i -= len(m.GuardianAddr)
+		copy(dAtA[i:], m.GuardianAddr)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.GuardianAddr)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Options != 0 {
 		i = encodeVarintTransaction(dAtA, i, uint64(m.Options))
 		i--
@@ -473,6 +513,14 @@ func (m *Transaction) Size() (n int) {
 	if m.Options != 0 {
 		n += 1 + sovTransaction(uint64(m.Options))
 	}
+	l = len(m.GuardianAddr)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.GuardianSignature)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	return n
 }
 
@@ -500,6 +548,8 @@ func (this *Transaction) String() string {
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
 		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`GuardianAddr:` + fmt.Sprintf("%v", this.GuardianAddr) + `,`,
+		`GuardianSignature:` + fmt.Sprintf("%v", this.GuardianSignature) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -912,6 +962,74 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianAddr = append(m.GuardianAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianAddr == nil {
+				m.GuardianAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianSignature = append(m.GuardianSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.GuardianSignature == nil {
+				m.GuardianSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -207,3 +207,32 @@ func TestTransaction_GetDataForSigningShouldWork(t *testing.T) {
 	assert.True(t, marshalizerWasCalled)
 	assert.Equal(t, 2, numEncodeCalled)
 }
+
+func TestTransaction_GetDataForSigningWithGuardianShouldIncludeGuardianAddress(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		GuardianAddr:      []byte("guardian"),
+		GuardianSignature: []byte("guardian signature"),
+	}
+
+	var signedTx *transaction.FrontendTransaction
+	_, err := tx.GetDataForSigning(
+		&mock.PubkeyConverterStub{
+			EncodeCalled: func(pkBytes []byte) string {
+				return string(pkBytes)
+			},
+		},
+		&mock.MarshalizerStub{
+			MarshalCalled: func(obj interface{}) (bytes []byte, err error) {
+				signedTx = obj.(*transaction.FrontendTransaction)
+
+				return make([]byte, 0), nil
+			},
+		},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "guardian", signedTx.GuardianAddr)
+	assert.Empty(t, signedTx.GuardianSignature)
+}
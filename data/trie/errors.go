@@ -96,3 +96,9 @@ var ErrContextClosing = errors.New("context closing")
 
 // ErrInvalidTimeout signals that an invalid timeout period has been provided
 var ErrInvalidTimeout = errors.New("invalid timeout value")
+
+// ErrInvalidProof is raised when a Merkle proof can not be verified against the given root hash
+var ErrInvalidProof = errors.New("invalid proof")
+
+// ErrNilDiffHandler signals that a nil trie diff handler has been provided
+var ErrNilDiffHandler = errors.New("nil trie diff handler")
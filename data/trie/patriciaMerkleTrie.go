@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -96,6 +97,54 @@ func (tr *patriciaMerkleTrie) Get(key []byte) ([]byte, error) {
 	return val, nil
 }
 
+// GetProof computes a Merkle proof for the given key. The proof contains the encoded nodes found on the key path,
+// starting from the root node. If the key is not present in the trie, the returned nodes prove its absence
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	proof := make([][]byte, 0)
+	if tr.root == nil {
+		return proof, nil
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	for {
+		encNode, errEncode := getEncodedCollapsedNode(currentNode)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		proof = append(proof, encNode)
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage.Database())
+		if errors.Is(err, ErrNodeNotFound) {
+			return proof, nil
+		}
+		if err != nil {
+			err = fmt.Errorf("trie get proof error: %w, for key %v", err, hex.EncodeToString(key))
+			return nil, err
+		}
+		if currentNode == nil {
+			return proof, nil
+		}
+	}
+}
+
+func getEncodedCollapsedNode(n node) ([]byte, error) {
+	collapsedNode, err := n.getCollapsed()
+	if err != nil {
+		return nil, err
+	}
+
+	return collapsedNode.getEncodedNode()
+}
+
 // Update updates the value at the given key.
 // If the key is not in the trie, it will be added.
 // If the value is empty, the key will be removed from the trie
@@ -659,6 +659,69 @@ func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	assert.Equal(t, leaves, recovered)
 }
 
+func TestPatriciaMerkleTrie_GetProofEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(proof))
+}
+
+func TestPatriciaMerkleTrie_GetProofExistingKey(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, err := tr.GetProof([]byte("doe"))
+	assert.Nil(t, err)
+	assert.True(t, len(proof) > 1)
+
+	value, err := trie.VerifyProof(rootHash, []byte("doe"), proof, hasher, marshalizer)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("reindeer"), value)
+}
+
+func TestPatriciaMerkleTrie_GetProofMissingKey(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	proof, err := tr.GetProof([]byte("dogs"))
+	assert.Nil(t, err)
+	assert.True(t, len(proof) > 0)
+
+	value, err := trie.VerifyProof(rootHash, []byte("dogs"), proof, hasher, marshalizer)
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+}
+
+func TestPatriciaMerkleTrie_GetProofFromCollapsedTrie(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(1000)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	collapsedTrie, _ := tr.Recreate(rootHash)
+	for i := range values {
+		proof, err := collapsedTrie.GetProof(values[i])
+		assert.Nil(t, err)
+
+		value, err := trie.VerifyProof(rootHash, values[i], proof, hasher, marshalizer)
+		assert.Nil(t, err)
+		assert.Equal(t, values[i], value)
+	}
+}
+
 func BenchmarkPatriciaMerkleTree_Insert(b *testing.B) {
 	tr := emptyTrie()
 	hsh := keccak.Keccak{}
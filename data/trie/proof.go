@@ -0,0 +1,94 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// VerifyProof checks the given Merkle proof against the root hash. If the proof is valid and the key is present
+// in the trie, the value found at the given key is returned. If the proof is valid and it demonstrates that the key
+// is not present in the trie, a nil value is returned. An invalid proof results in an error.
+func VerifyProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	hasher hashing.Hasher,
+	marshalizer marshal.Marshalizer,
+) ([]byte, error) {
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if len(proof) == 0 {
+		if emptyTrie(rootHash) {
+			return nil, nil
+		}
+
+		return nil, ErrInvalidProof
+	}
+
+	hexKey := keyBytesToHex(key)
+	expectedHash := rootHash
+	for i, encNode := range proof {
+		nodeHash := hasher.Compute(string(encNode))
+		if !bytes.Equal(nodeHash, expectedHash) {
+			return nil, fmt.Errorf("%w: hash mismatch for proof node at index %d", ErrInvalidProof, i)
+		}
+
+		n, err := decodeNode(encNode, marshalizer, hasher)
+		if err != nil {
+			return nil, err
+		}
+
+		isLastNode := i == len(proof)-1
+		var value []byte
+		var pathEnded bool
+		expectedHash, hexKey, value, pathEnded = followProofPath(n, hexKey)
+		if pathEnded != isLastNode {
+			return nil, fmt.Errorf("%w: proof path ended at index %d out of %d nodes", ErrInvalidProof, i, len(proof))
+		}
+		if pathEnded {
+			return value, nil
+		}
+	}
+
+	return nil, ErrInvalidProof
+}
+
+// followProofPath returns the hash of the next node on the given key path and the remaining key. If the path
+// ends in this node, pathEnded is set and the returned value is the value found at the given key, if any
+func followProofPath(n node, key []byte) (nextHash []byte, remainingKey []byte, value []byte, pathEnded bool) {
+	switch currentNode := n.(type) {
+	case *leafNode:
+		if bytes.Equal(key, currentNode.Key) {
+			return nil, nil, currentNode.Value, true
+		}
+
+		return nil, nil, nil, true
+	case *extensionNode:
+		keyTooShort := len(key) < len(currentNode.Key)
+		if keyTooShort || !bytes.Equal(currentNode.Key, key[:len(currentNode.Key)]) {
+			return nil, nil, nil, true
+		}
+
+		return currentNode.EncodedChild, key[len(currentNode.Key):], nil, false
+	case *branchNode:
+		if len(key) == 0 {
+			return nil, nil, nil, true
+		}
+		childPos := key[firstByte]
+		if int(childPos) >= len(currentNode.EncodedChildren) || len(currentNode.EncodedChildren[childPos]) == 0 {
+			return nil, nil, nil, true
+		}
+
+		return currentNode.EncodedChildren[childPos], key[1:], nil, false
+	default:
+		return nil, nil, nil, true
+	}
+}
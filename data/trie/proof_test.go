@@ -0,0 +1,112 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyProof_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, marshalizer, _, _ := getDefaultTrieParameters()
+
+	value, err := trie.VerifyProof(emptyTrieHash, []byte("dog"), nil, nil, marshalizer)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrNilHasher, err)
+}
+
+func TestVerifyProof_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, _, hasher, _ := getDefaultTrieParameters()
+
+	value, err := trie.VerifyProof(emptyTrieHash, []byte("dog"), nil, hasher, nil)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+}
+
+func TestVerifyProof_EmptyProofForEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	value, err := trie.VerifyProof(emptyTrieHash, []byte("dog"), [][]byte{}, hasher, marshalizer)
+	assert.Nil(t, value)
+	assert.Nil(t, err)
+}
+
+func TestVerifyProof_EmptyProofForNonEmptyTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), [][]byte{}, hasher, marshalizer)
+	assert.Nil(t, value)
+	assert.Equal(t, trie.ErrInvalidProof, err)
+}
+
+func TestVerifyProof_WrongRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProof([]byte("wrong root hash"), []byte("dog"), proof, hasher, marshalizer)
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
+
+func TestVerifyProof_TamperedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	lastNode := proof[len(proof)-1]
+	tamperedNode := make([]byte, len(lastNode))
+	copy(tamperedNode, lastNode)
+	tamperedNode[0]++
+	proof[len(proof)-1] = tamperedNode
+
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), proof, hasher, marshalizer)
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
+
+func TestVerifyProof_TruncatedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), proof[:len(proof)-1], hasher, marshalizer)
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+}
+
+func TestVerifyProof_ProofForOtherKeyShouldNotReturnValue(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	_, marshalizer, hasher, _ := getDefaultTrieParameters()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	value, err := trie.VerifyProof(rootHash, []byte("doe"), proof, hasher, marshalizer)
+	assert.Nil(t, value)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,276 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+var errDiffInterrupted = errors.New("trie diff interrupted by the handler")
+
+// trieDiffer walks two tries with the same database at the same time, descending only in the subtrees whose
+// hashes differ
+type trieDiffer struct {
+	db      data.DBWriteCacher
+	handler func(entry *data.TrieDiffEntry) bool
+}
+
+// GetDiff calls the handler for each key which was inserted, updated or deleted between the two root hashes, in
+// trie traversal order. Only the subtrees whose hashes differ are loaded. The walk stops when the handler returns false
+func (tr *patriciaMerkleTrie) GetDiff(
+	fromRootHash []byte,
+	toRootHash []byte,
+	handler func(entry *data.TrieDiffEntry) bool,
+) error {
+	if handler == nil {
+		return ErrNilDiffHandler
+	}
+
+	tr.mutOperation.Lock()
+	oldTrie, err := tr.recreate(fromRootHash)
+	if err != nil {
+		tr.mutOperation.Unlock()
+		return err
+	}
+	newTrie, err := tr.recreate(toRootHash)
+	if err != nil {
+		tr.mutOperation.Unlock()
+		return err
+	}
+
+	tr.EnterPruningBufferingMode()
+	tr.mutOperation.Unlock()
+
+	defer func() {
+		tr.mutOperation.Lock()
+		tr.ExitPruningBufferingMode()
+		tr.mutOperation.Unlock()
+	}()
+
+	if bytes.Equal(fromRootHash, toRootHash) {
+		return nil
+	}
+
+	td := &trieDiffer{
+		db:      tr.Database(),
+		handler: handler,
+	}
+	err = td.diff(oldTrie.root, newTrie.root, []byte{})
+	if err == errDiffInterrupted {
+		return nil
+	}
+
+	return err
+}
+
+func (td *trieDiffer) diff(oldNode node, newNode node, key []byte) error {
+	if isNilNode(oldNode) && isNilNode(newNode) {
+		return nil
+	}
+
+	switch oldN := oldNode.(type) {
+	case *branchNode:
+		newN, ok := newNode.(*branchNode)
+		if ok && oldN != nil && newN != nil {
+			return td.diffBranches(oldN, newN, key)
+		}
+	case *extensionNode:
+		newN, ok := newNode.(*extensionNode)
+		if ok && oldN != nil && newN != nil && bytes.Equal(oldN.Key, newN.Key) {
+			return td.diffExtensions(oldN, newN, key)
+		}
+	case *leafNode:
+		newN, ok := newNode.(*leafNode)
+		if ok && oldN != nil && newN != nil && bytes.Equal(oldN.Key, newN.Key) {
+			return td.diffLeaves(oldN, newN, key)
+		}
+	}
+
+	// the subtrees have different shapes, so their leaves are compared
+	return td.diffLeavesSets(oldNode, newNode, key)
+}
+
+func (td *trieDiffer) diffBranches(oldNode *branchNode, newNode *branchNode, key []byte) error {
+	for i := 0; i < nrOfChildren; i++ {
+		if bytes.Equal(encodedChild(oldNode, i), encodedChild(newNode, i)) {
+			continue
+		}
+
+		err := td.resolveChild(oldNode, i)
+		if err != nil {
+			return err
+		}
+		err = td.resolveChild(newNode, i)
+		if err != nil {
+			return err
+		}
+
+		err = td.diff(oldNode.children[i], newNode.children[i], concat(key, byte(i)))
+		if err != nil {
+			return err
+		}
+
+		oldNode.children[i] = nil
+		newNode.children[i] = nil
+	}
+
+	return nil
+}
+
+func (td *trieDiffer) diffExtensions(oldNode *extensionNode, newNode *extensionNode, key []byte) error {
+	if bytes.Equal(oldNode.EncodedChild, newNode.EncodedChild) {
+		return nil
+	}
+
+	err := resolveIfCollapsed(oldNode, 0, td.db)
+	if err != nil {
+		return err
+	}
+	err = resolveIfCollapsed(newNode, 0, td.db)
+	if err != nil {
+		return err
+	}
+
+	return td.diff(oldNode.child, newNode.child, concat(key, oldNode.Key...))
+}
+
+func (td *trieDiffer) diffLeaves(oldNode *leafNode, newNode *leafNode, key []byte) error {
+	if bytes.Equal(oldNode.Value, newNode.Value) {
+		return nil
+	}
+
+	leafKey, err := hexToKeyBytes(concat(key, oldNode.Key...))
+	if err != nil {
+		return err
+	}
+
+	return td.emit(&data.TrieDiffEntry{
+		Type:     data.TrieDiffUpdated,
+		Key:      leafKey,
+		OldValue: oldNode.Value,
+		NewValue: newNode.Value,
+	})
+}
+
+func (td *trieDiffer) diffLeavesSets(oldNode node, newNode node, key []byte) error {
+	oldLeaves := make(map[string][]byte)
+	err := td.collectLeaves(oldNode, key, oldLeaves)
+	if err != nil {
+		return err
+	}
+
+	newLeaves := make(map[string][]byte)
+	err = td.collectLeaves(newNode, key, newLeaves)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(oldLeaves)+len(newLeaves))
+	for leafKey := range oldLeaves {
+		keys = append(keys, leafKey)
+	}
+	for leafKey := range newLeaves {
+		_, found := oldLeaves[leafKey]
+		if !found {
+			keys = append(keys, leafKey)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, leafKey := range keys {
+		oldValue, isOld := oldLeaves[leafKey]
+		newValue, isNew := newLeaves[leafKey]
+
+		entry := &data.TrieDiffEntry{
+			Key:      []byte(leafKey),
+			OldValue: oldValue,
+			NewValue: newValue,
+		}
+		switch {
+		case isOld && isNew:
+			if bytes.Equal(oldValue, newValue) {
+				continue
+			}
+			entry.Type = data.TrieDiffUpdated
+		case isNew:
+			entry.Type = data.TrieDiffInserted
+		default:
+			entry.Type = data.TrieDiffDeleted
+		}
+
+		err = td.emit(entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (td *trieDiffer) collectLeaves(n node, key []byte, leaves map[string][]byte) error {
+	if isNilNode(n) {
+		return nil
+	}
+
+	switch nd := n.(type) {
+	case *branchNode:
+		for i := 0; i < nrOfChildren; i++ {
+			err := td.resolveChild(nd, i)
+			if err != nil {
+				return err
+			}
+
+			err = td.collectLeaves(nd.children[i], concat(key, byte(i)), leaves)
+			if err != nil {
+				return err
+			}
+			nd.children[i] = nil
+		}
+	case *extensionNode:
+		err := resolveIfCollapsed(nd, 0, td.db)
+		if err != nil {
+			return err
+		}
+
+		return td.collectLeaves(nd.child, concat(key, nd.Key...), leaves)
+	case *leafNode:
+		leafKey, err := hexToKeyBytes(concat(key, nd.Key...))
+		if err != nil {
+			return err
+		}
+
+		leaves[string(leafKey)] = nd.Value
+	}
+
+	return nil
+}
+
+func (td *trieDiffer) resolveChild(bn *branchNode, pos int) error {
+	if len(encodedChild(bn, pos)) == 0 {
+		return nil
+	}
+
+	return resolveIfCollapsed(bn, byte(pos), td.db)
+}
+
+func (td *trieDiffer) emit(entry *data.TrieDiffEntry) error {
+	if !td.handler(entry) {
+		return errDiffInterrupted
+	}
+
+	return nil
+}
+
+func encodedChild(bn *branchNode, pos int) []byte {
+	if pos >= len(bn.EncodedChildren) {
+		return nil
+	}
+
+	return bn.EncodedChildren[pos]
+}
+
+func isNilNode(n node) bool {
+	return n == nil || n.isEmptyOrNil() != nil
+}
@@ -0,0 +1,158 @@
+package trie_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commitAndGetRoot(t *testing.T, tr data.Trie) []byte {
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.Root()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func getDiff(t *testing.T, tr data.Trie, fromRootHash []byte, toRootHash []byte) []*data.TrieDiffEntry {
+	entries := make([]*data.TrieDiffEntry, 0)
+	err := tr.GetDiff(fromRootHash, toRootHash, func(entry *data.TrieDiffEntry) bool {
+		entries = append(entries, entry)
+		return true
+	})
+	require.Nil(t, err)
+
+	return entries
+}
+
+func getLeaves(t *testing.T, tr data.Trie, rootHash []byte) map[string]string {
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash, context.Background())
+	require.Nil(t, err)
+
+	leaves := make(map[string]string)
+	for leaf := range leavesChannel {
+		leaves[string(leaf.Key())] = string(leaf.Value())
+	}
+
+	return leaves
+}
+
+// computeExpectedDiff compares all the leaves of the two tries
+func computeExpectedDiff(oldLeaves map[string]string, newLeaves map[string]string) []string {
+	expected := make([]string, 0)
+	for key, oldValue := range oldLeaves {
+		newValue, found := newLeaves[key]
+		if !found {
+			expected = append(expected, fmt.Sprintf("%s %s", data.TrieDiffDeleted, key))
+			continue
+		}
+		if newValue != oldValue {
+			expected = append(expected, fmt.Sprintf("%s %s", data.TrieDiffUpdated, key))
+		}
+	}
+	for key := range newLeaves {
+		_, found := oldLeaves[key]
+		if !found {
+			expected = append(expected, fmt.Sprintf("%s %s", data.TrieDiffInserted, key))
+		}
+	}
+	sort.Strings(expected)
+
+	return expected
+}
+
+func TestPatriciaMerkleTrie_GetDiffNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash := commitAndGetRoot(t, tr)
+
+	err := tr.GetDiff(emptyTrieHash, rootHash, nil)
+	assert.Equal(t, trie.ErrNilDiffHandler, err)
+}
+
+func TestPatriciaMerkleTrie_GetDiff(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	oldRootHash := commitAndGetRoot(t, tr)
+
+	_ = tr.Update([]byte("dog"), []byte("doggy"))
+	_ = tr.Delete([]byte("ddog"))
+	_ = tr.Update([]byte("horse"), []byte("stallion"))
+	newRootHash := commitAndGetRoot(t, tr)
+
+	entries := make(map[string]*data.TrieDiffEntry)
+	for _, entry := range getDiff(t, tr, oldRootHash, newRootHash) {
+		entries[string(entry.Key)] = entry
+	}
+	require.Equal(t, 3, len(entries))
+	assert.Equal(t, &data.TrieDiffEntry{Type: data.TrieDiffDeleted, Key: []byte("ddog"), OldValue: []byte("cat")}, entries["ddog"])
+	assert.Equal(t, &data.TrieDiffEntry{
+		Type:     data.TrieDiffUpdated,
+		Key:      []byte("dog"),
+		OldValue: []byte("puppy"),
+		NewValue: []byte("doggy"),
+	}, entries["dog"])
+	assert.Equal(t, &data.TrieDiffEntry{Type: data.TrieDiffInserted, Key: []byte("horse"), NewValue: []byte("stallion")}, entries["horse"])
+
+	assert.Empty(t, getDiff(t, tr, newRootHash, newRootHash))
+	assert.Equal(t, 3, len(getDiff(t, tr, emptyTrieHash, newRootHash)))
+	assert.Equal(t, 3, len(getDiff(t, tr, oldRootHash, emptyTrieHash)))
+}
+
+func TestPatriciaMerkleTrie_GetDiffShouldStopWhenTheHandlerReturnsFalse(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash := commitAndGetRoot(t, tr)
+
+	numCalls := 0
+	err := tr.GetDiff(emptyTrieHash, rootHash, func(_ *data.TrieDiffEntry) bool {
+		numCalls++
+		return false
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestPatriciaMerkleTrie_GetDiffShouldMatchTheFullLeavesComparison(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	random := rand.New(rand.NewSource(7))
+	for i := 0; i < 500; i++ {
+		_ = tr.Update([]byte(fmt.Sprintf("key%d", random.Intn(1000))), []byte(fmt.Sprintf("value%d", i)))
+	}
+	oldRootHash := commitAndGetRoot(t, tr)
+
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key%d", random.Intn(1200)))
+		if i%3 == 0 {
+			_ = tr.Delete(key)
+			continue
+		}
+		_ = tr.Update(key, []byte(fmt.Sprintf("new value%d", i)))
+	}
+	newRootHash := commitAndGetRoot(t, tr)
+
+	expected := computeExpectedDiff(getLeaves(t, tr, oldRootHash), getLeaves(t, tr, newRootHash))
+	entries := getDiff(t, tr, oldRootHash, newRootHash)
+	actual := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		actual = append(actual, fmt.Sprintf("%s %s", entry.Type, entry.Key))
+	}
+	sort.Strings(actual)
+
+	assert.Equal(t, expected, actual)
+}
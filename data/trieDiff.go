@@ -0,0 +1,21 @@
+package data
+
+// TrieDiffType defines how a key changed between two trie root hashes
+type TrieDiffType string
+
+const (
+	// TrieDiffInserted marks a key which exists only in the newer trie
+	TrieDiffInserted TrieDiffType = "inserted"
+	// TrieDiffUpdated marks a key whose value differs between the two tries
+	TrieDiffUpdated TrieDiffType = "updated"
+	// TrieDiffDeleted marks a key which exists only in the older trie
+	TrieDiffDeleted TrieDiffType = "deleted"
+)
+
+// TrieDiffEntry holds a key which differs between two trie root hashes, together with its old and new values
+type TrieDiffEntry struct {
+	Type     TrieDiffType
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
@@ -85,6 +85,10 @@ const (
 	ReceiptsUnit UnitType = 15
 	// ResultsHashesByTxHashUnit is the results hashes by transaction storage unit identifier
 	ResultsHashesByTxHashUnit UnitType = 16
+	// AddressTransactionsUnit is the transactions by address storage unit identifier
+	AddressTransactionsUnit UnitType = 17
+	// LogsIdentifierUnit is the transactions by log event identifier storage unit identifier
+	LogsIdentifierUnit UnitType = 18
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
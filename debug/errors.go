@@ -4,3 +4,9 @@ import "errors"
 
 // ErrInvalidValue signals that the provided value is invalid
 var ErrInvalidValue = errors.New("invalid value")
+
+// ErrInvalidCaptureFile signals that the provided file is not a p2p capture file
+var ErrInvalidCaptureFile = errors.New("invalid capture file")
+
+// ErrNilReplayMessenger signals that a nil replay messenger has been provided
+var ErrNilReplayMessenger = errors.New("nil replay messenger")
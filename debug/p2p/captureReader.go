@@ -0,0 +1,74 @@
+package p2p
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// ReadCaptureFiles returns the messages stored in the provided capture files. The files should be provided from the
+// oldest to the newest one (e.g. file.p2pcap.2, file.p2pcap.1, file.p2pcap) so the messages keep the capture order.
+// A truncated last record, as left by a node that stopped while writing, is ignored
+func ReadCaptureFiles(marshalizer marshal.Marshalizer, filenames ...string) ([]*CapturedMessage, error) {
+	if check.IfNil(marshalizer) {
+		return nil, p2p.ErrNilMarshalizer
+	}
+
+	capturedMessages := make([]*CapturedMessage, 0)
+	for _, filename := range filenames {
+		buff, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		capturedMessages, err = appendCapturedMessages(capturedMessages, marshalizer, buff)
+		if err != nil {
+			return nil, fmt.Errorf("%w, file %s", err, filename)
+		}
+	}
+
+	return capturedMessages, nil
+}
+
+func appendCapturedMessages(
+	capturedMessages []*CapturedMessage,
+	marshalizer marshal.Marshalizer,
+	buff []byte,
+) ([]*CapturedMessage, error) {
+	header := append([]byte(captureFileMagic), captureFileVersion)
+	if !bytes.HasPrefix(buff, header) {
+		return nil, debug.ErrInvalidCaptureFile
+	}
+
+	buff = buff[len(header):]
+	for len(buff) > 0 {
+		if len(buff) < recordLengthSize {
+			log.Warn("truncated p2p capture record ignored")
+			break
+		}
+
+		recordSize := int(binary.BigEndian.Uint32(buff))
+		buff = buff[recordLengthSize:]
+		if len(buff) < recordSize {
+			log.Warn("truncated p2p capture record ignored")
+			break
+		}
+
+		capturedMessage := &CapturedMessage{}
+		err := marshalizer.Unmarshal(capturedMessage, buff[:recordSize])
+		if err != nil {
+			return nil, fmt.Errorf("%w, record %d: %s", debug.ErrInvalidCaptureFile, len(capturedMessages), err.Error())
+		}
+
+		capturedMessages = append(capturedMessages, capturedMessage)
+		buff = buff[recordSize:]
+	}
+
+	return capturedMessages, nil
+}
@@ -0,0 +1,32 @@
+package p2p
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type disabledMessageCapturer struct {
+}
+
+// NewDisabledMessageCapturer returns a disabled instance of the message capturer
+func NewDisabledMessageCapturer() *disabledMessageCapturer {
+	return &disabledMessageCapturer{}
+}
+
+// CaptureReceivedMessage does nothing
+func (dmc *disabledMessageCapturer) CaptureReceivedMessage(_ p2p.MessageP2P, _ core.PeerID) {
+}
+
+// CaptureSentMessage does nothing
+func (dmc *disabledMessageCapturer) CaptureSentMessage(_ string, _ []byte, _ core.PeerID) {
+}
+
+// Close returns nil
+func (dmc *disabledMessageCapturer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dmc *disabledMessageCapturer) IsInterfaceNil() bool {
+	return dmc == nil
+}
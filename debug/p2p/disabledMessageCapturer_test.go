@@ -0,0 +1,20 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledMessageCapturer(t *testing.T) {
+	t.Parallel()
+
+	dmc := NewDisabledMessageCapturer()
+	assert.False(t, check.IfNil(dmc))
+
+	dmc.CaptureReceivedMessage(&message.Message{}, "")
+	dmc.CaptureSentMessage("", nil, "")
+	assert.Nil(t, dmc.Close())
+}
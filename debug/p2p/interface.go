@@ -0,0 +1,12 @@
+package p2p
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// ReplayMessenger defines the messenger able to synchronously process a replayed message
+type ReplayMessenger interface {
+	ProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,325 @@
+package p2p
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var _ p2p.MessageCapturer = (*messageCapturer)(nil)
+
+const (
+	// ReceivedMessage marks a captured message that was received and handed to the topic's processor. The messages
+	// broadcast by the node are also received (and processed) by itself, having the connected peer the node's ID
+	ReceivedMessage = uint32(0)
+	// SentMessage marks a captured message that was sent by the node. The connected peer is empty for broadcasts
+	SentMessage = uint32(1)
+)
+
+const captureFileExtension = ".p2pcap"
+const captureFileMagic = "ERDP2PCAP"
+const captureFileVersion = byte(1)
+const captureFileHeaderSize = len(captureFileMagic) + 1
+const recordLengthSize = 4
+const captureFilePermissions = 0644
+const recordsChanSize = 10000
+const fileBufferSize = 64 * 1024
+
+// ArgsMessageCapturer represents the arguments DTO used to create a message capturer
+type ArgsMessageCapturer struct {
+	Marshalizer        marshal.Marshalizer
+	SelfPeerID         core.PeerID
+	FolderPath         string
+	MaxFileSizeInBytes uint64
+	MaxNumFiles        uint32
+}
+
+// messageCapturer writes every captured message in a binary capture file. The file starts with a header (magic
+// bytes and version) and continues with records, each record being the marshaled CapturedMessage prefixed by
+// its length. When the file grows past the maximum size, it is rotated: the current file gets the .1 suffix,
+// the previous .1 becomes .2 and so on, the oldest file being removed.
+// The records are handed to a buffered channel drained by a single writer goroutine, so capturing a message never
+// waits on the disk. If the writer falls behind and the channel gets full, the new records are dropped
+type messageCapturer struct {
+	marshalizer        marshal.Marshalizer
+	selfPeerID         core.PeerID
+	filename           string
+	maxFileSizeInBytes uint64
+	maxNumFiles        uint32
+
+	mut       sync.Mutex
+	chRecords chan []byte
+	chDone    chan struct{}
+	isClosed  bool
+
+	// the following fields are used only by the writer goroutine (and by the constructor, before starting it)
+	file            *os.File
+	writer          *bufio.Writer
+	fileSizeInBytes uint64
+	closeErr        error
+}
+
+// NewMessageCapturer creates a new message capturer that writes its capture files in the provided folder
+func NewMessageCapturer(args ArgsMessageCapturer) (*messageCapturer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, p2p.ErrNilMarshalizer
+	}
+	if len(args.SelfPeerID) == 0 {
+		return nil, fmt.Errorf("%w for SelfPeerID", debug.ErrInvalidValue)
+	}
+	if len(args.FolderPath) == 0 {
+		return nil, fmt.Errorf("%w for FolderPath", debug.ErrInvalidValue)
+	}
+	if args.MaxFileSizeInBytes == 0 {
+		return nil, fmt.Errorf("%w for MaxFileSizeInBytes", debug.ErrInvalidValue)
+	}
+	if args.MaxNumFiles == 0 {
+		return nil, fmt.Errorf("%w for MaxNumFiles", debug.ErrInvalidValue)
+	}
+
+	err := os.MkdirAll(args.FolderPath, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	mc := &messageCapturer{
+		marshalizer:        args.Marshalizer,
+		selfPeerID:         args.SelfPeerID,
+		filename:           filepath.Join(args.FolderPath, CaptureFileName(args.SelfPeerID)),
+		maxFileSizeInBytes: args.MaxFileSizeInBytes,
+		maxNumFiles:        args.MaxNumFiles,
+		chRecords:          make(chan []byte, recordsChanSize),
+		chDone:             make(chan struct{}),
+	}
+
+	// the capture of a previous run is kept as the most recent rotated file
+	err = mc.rotateAndOpenFile()
+	if err != nil {
+		return nil, err
+	}
+
+	go mc.writeRecords()
+
+	log.Info("p2p message capture enabled", "file", mc.filename)
+
+	return mc, nil
+}
+
+// CaptureFileName returns the name of the current capture file written by the node with the provided peer ID.
+// The rotated files have the same name suffixed by .1 (most recent), .2 and so on
+func CaptureFileName(pid core.PeerID) string {
+	return pid.Pretty() + captureFileExtension
+}
+
+// CaptureReceivedMessage records a received message
+func (mc *messageCapturer) CaptureReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) {
+	if check.IfNil(message) {
+		return
+	}
+
+	topic := ""
+	if len(message.Topics()) > 0 {
+		topic = message.Topics()[0]
+	}
+
+	mc.write(&CapturedMessage{
+		Direction:        ReceivedMessage,
+		CaptureTimestamp: time.Now().UnixNano(),
+		Topic:            topic,
+		From:             message.From(),
+		ConnectedPeer:    fromConnectedPeer.Bytes(),
+		Timestamp:        message.Timestamp(),
+		SeqNo:            message.SeqNo(),
+		Signature:        message.Signature(),
+		Key:              message.Key(),
+		Data:             message.Data(),
+	})
+}
+
+// CaptureSentMessage records a message sent by the node. An empty toPeer denotes a broadcast
+func (mc *messageCapturer) CaptureSentMessage(topic string, buff []byte, toPeer core.PeerID) {
+	now := time.Now()
+	mc.write(&CapturedMessage{
+		Direction:        SentMessage,
+		CaptureTimestamp: now.UnixNano(),
+		Topic:            topic,
+		From:             mc.selfPeerID.Bytes(),
+		ConnectedPeer:    toPeer.Bytes(),
+		Timestamp:        now.Unix(),
+		Data:             buff,
+	})
+}
+
+func (mc *messageCapturer) write(capturedMessage *CapturedMessage) {
+	buff, err := mc.marshalizer.Marshal(capturedMessage)
+	if err != nil {
+		log.Warn("error marshaling captured p2p message", "error", err.Error())
+		return
+	}
+
+	record := make([]byte, recordLengthSize+len(buff))
+	binary.BigEndian.PutUint32(record, uint32(len(buff)))
+	copy(record[recordLengthSize:], buff)
+
+	mc.mut.Lock()
+	defer mc.mut.Unlock()
+
+	if mc.isClosed {
+		return
+	}
+
+	select {
+	case mc.chRecords <- record:
+	default:
+		log.Trace("p2p capture records channel is full, captured message dropped", "topic", capturedMessage.Topic)
+	}
+}
+
+func (mc *messageCapturer) writeRecords() {
+	for record := range mc.chRecords {
+		mc.writeRecord(record)
+	}
+
+	mc.closeErr = mc.closeFile()
+	close(mc.chDone)
+}
+
+func (mc *messageCapturer) writeRecord(record []byte) {
+	hasRecords := mc.fileSizeInBytes > uint64(captureFileHeaderSize)
+	if hasRecords && mc.fileSizeInBytes+uint64(len(record)) > mc.maxFileSizeInBytes {
+		err := mc.rotateAndOpenFile()
+		if err != nil {
+			log.Warn("error rotating the p2p capture file", "error", err.Error())
+			return
+		}
+	}
+
+	if mc.file == nil {
+		return
+	}
+
+	n, err := mc.writer.Write(record)
+	mc.fileSizeInBytes += uint64(n)
+	if err != nil {
+		log.Warn("error writing the p2p capture file", "error", err.Error())
+	}
+}
+
+func (mc *messageCapturer) closeFile() error {
+	if mc.file == nil {
+		return nil
+	}
+
+	errFlush := mc.writer.Flush()
+	errClose := mc.file.Close()
+	mc.file = nil
+	mc.writer = nil
+	if errFlush != nil {
+		return errFlush
+	}
+
+	return errClose
+}
+
+func (mc *messageCapturer) rotateAndOpenFile() error {
+	err := mc.closeFile()
+	if err != nil {
+		return err
+	}
+
+	err = mc.rotateFiles()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(mc.filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, captureFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriterSize(file, fileBufferSize)
+	header := append([]byte(captureFileMagic), captureFileVersion)
+	_, err = writer.Write(header)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	mc.file = file
+	mc.writer = writer
+	mc.fileSizeInBytes = uint64(len(header))
+
+	return nil
+}
+
+func (mc *messageCapturer) rotateFiles() error {
+	if !fileExists(mc.filename) {
+		return nil
+	}
+
+	if mc.maxNumFiles == 1 {
+		return os.Remove(mc.filename)
+	}
+
+	oldestFilename := rotatedFileName(mc.filename, mc.maxNumFiles-1)
+	if fileExists(oldestFilename) {
+		err := os.Remove(oldestFilename)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := mc.maxNumFiles - 2; i >= 1; i-- {
+		filename := rotatedFileName(mc.filename, i)
+		if !fileExists(filename) {
+			continue
+		}
+
+		err := os.Rename(filename, rotatedFileName(mc.filename, i+1))
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.Rename(mc.filename, rotatedFileName(mc.filename, 1))
+}
+
+func rotatedFileName(filename string, index uint32) string {
+	return fmt.Sprintf("%s.%d", filename, index)
+}
+
+func fileExists(filename string) bool {
+	_, err := os.Stat(filename)
+	return err == nil
+}
+
+// Close flushes the pending records and closes the current capture file. Further captured messages are ignored
+func (mc *messageCapturer) Close() error {
+	mc.mut.Lock()
+	if mc.isClosed {
+		mc.mut.Unlock()
+		return nil
+	}
+	mc.isClosed = true
+	close(mc.chRecords)
+	mc.mut.Unlock()
+
+	<-mc.chDone
+
+	return mc.closeErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mc *messageCapturer) IsInterfaceNil() bool {
+	return mc == nil
+}
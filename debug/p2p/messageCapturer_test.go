@@ -0,0 +1,285 @@
+package p2p
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const selfPid = core.PeerID("self pid")
+
+func createTempFolder(t *testing.T) string {
+	folderPath, err := ioutil.TempDir("", "p2p-capture")
+	require.Nil(t, err)
+
+	return folderPath
+}
+
+func createMockArgsMessageCapturer(folderPath string) ArgsMessageCapturer {
+	return ArgsMessageCapturer{
+		Marshalizer:        &marshal.GogoProtoMarshalizer{},
+		SelfPeerID:         selfPid,
+		FolderPath:         folderPath,
+		MaxFileSizeInBytes: core.MegabyteSize,
+		MaxNumFiles:        3,
+	}
+}
+
+func createReceivedMessage(data []byte) *message.Message {
+	return &message.Message{
+		FromField:      []byte("originator"),
+		DataField:      data,
+		SeqNoField:     []byte("seq no"),
+		TopicsField:    []string{"topic"},
+		SignatureField: []byte("signature"),
+		KeyField:       []byte("key"),
+		PeerField:      "originator",
+		TimestampField: 1234,
+	}
+}
+
+func captureFile(folderPath string) string {
+	return filepath.Join(folderPath, CaptureFileName(selfPid))
+}
+
+//------- NewMessageCapturer
+
+func TestNewMessageCapturer_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMessageCapturer("folder")
+	args.Marshalizer = nil
+	mc, err := NewMessageCapturer(args)
+	assert.True(t, check.IfNil(mc))
+	assert.Equal(t, p2p.ErrNilMarshalizer, err)
+
+	args = createMockArgsMessageCapturer("folder")
+	args.SelfPeerID = ""
+	mc, err = NewMessageCapturer(args)
+	assert.True(t, check.IfNil(mc))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+
+	args = createMockArgsMessageCapturer("")
+	mc, err = NewMessageCapturer(args)
+	assert.True(t, check.IfNil(mc))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+
+	args = createMockArgsMessageCapturer("folder")
+	args.MaxFileSizeInBytes = 0
+	mc, err = NewMessageCapturer(args)
+	assert.True(t, check.IfNil(mc))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+
+	args = createMockArgsMessageCapturer("folder")
+	args.MaxNumFiles = 0
+	mc, err = NewMessageCapturer(args)
+	assert.True(t, check.IfNil(mc))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+}
+
+func TestNewMessageCapturer_ShouldCreateCaptureFile(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	mc, err := NewMessageCapturer(createMockArgsMessageCapturer(filepath.Join(folderPath, "sub")))
+	assert.False(t, check.IfNil(mc))
+	assert.Nil(t, err)
+	assert.Nil(t, mc.Close())
+
+	capturedMessages, err := ReadCaptureFiles(&marshal.GogoProtoMarshalizer{}, captureFile(filepath.Join(folderPath, "sub")))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(capturedMessages))
+}
+
+//------- capture and read
+
+func TestMessageCapturer_CapturedMessagesShouldBeRead(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	mc, _ := NewMessageCapturer(createMockArgsMessageCapturer(folderPath))
+	receivedMessage := createReceivedMessage([]byte("received"))
+	mc.CaptureReceivedMessage(receivedMessage, "connected peer")
+	mc.CaptureReceivedMessage(nil, "connected peer")
+	mc.CaptureSentMessage("sent topic", []byte("sent"), "to peer")
+	_ = mc.Close()
+	mc.CaptureSentMessage("sent topic", []byte("after close"), "")
+
+	capturedMessages, err := ReadCaptureFiles(&marshal.GogoProtoMarshalizer{}, captureFile(folderPath))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(capturedMessages))
+
+	received := capturedMessages[0]
+	assert.Equal(t, ReceivedMessage, received.Direction)
+	assert.Equal(t, "topic", received.Topic)
+	assert.Equal(t, receivedMessage.From(), received.From)
+	assert.Equal(t, []byte("connected peer"), received.ConnectedPeer)
+	assert.Equal(t, receivedMessage.Timestamp(), received.Timestamp)
+	assert.Equal(t, receivedMessage.SeqNo(), received.SeqNo)
+	assert.Equal(t, receivedMessage.Signature(), received.Signature)
+	assert.Equal(t, receivedMessage.Key(), received.Key)
+	assert.Equal(t, receivedMessage.Data(), received.Data)
+	assert.NotEqual(t, int64(0), received.CaptureTimestamp)
+
+	sent := capturedMessages[1]
+	assert.Equal(t, SentMessage, sent.Direction)
+	assert.Equal(t, "sent topic", sent.Topic)
+	assert.Equal(t, selfPid.Bytes(), sent.From)
+	assert.Equal(t, []byte("to peer"), sent.ConnectedPeer)
+	assert.Equal(t, []byte("sent"), sent.Data)
+}
+
+func TestMessageCapturer_ShouldRotateFiles(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	args := createMockArgsMessageCapturer(folderPath)
+	args.MaxFileSizeInBytes = 100
+	mc, _ := NewMessageCapturer(args)
+	numMessages := 5
+	for i := 0; i < numMessages; i++ {
+		// each record is larger than half of the maximum file size so each message will be written in its own file
+		mc.CaptureSentMessage("topic", make([]byte, 60), "")
+	}
+	_ = mc.Close()
+
+	filename := captureFile(folderPath)
+	assert.True(t, fileExists(filename))
+	assert.True(t, fileExists(filename+".1"))
+	assert.True(t, fileExists(filename+".2"))
+	assert.False(t, fileExists(filename+".3"))
+
+	capturedMessages, err := ReadCaptureFiles(&marshal.GogoProtoMarshalizer{}, filename+".2", filename+".1", filename)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(capturedMessages))
+}
+
+func TestMessageCapturer_ShouldKeepThePreviousCapture(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	mc, _ := NewMessageCapturer(createMockArgsMessageCapturer(folderPath))
+	mc.CaptureSentMessage("topic", []byte("previous run"), "")
+	_ = mc.Close()
+
+	mc, _ = NewMessageCapturer(createMockArgsMessageCapturer(folderPath))
+	_ = mc.Close()
+
+	capturedMessages, _ := ReadCaptureFiles(&marshal.GogoProtoMarshalizer{}, captureFile(folderPath)+".1")
+	require.Equal(t, 1, len(capturedMessages))
+	assert.Equal(t, []byte("previous run"), capturedMessages[0].Data)
+}
+
+func TestMessageCapturer_ConcurrentCapturesShouldBeWrittenOnClose(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	mc, _ := NewMessageCapturer(createMockArgsMessageCapturer(folderPath))
+	numGoroutines := 10
+	numMessagesPerGoroutine := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		go func() {
+			for j := 0; j < numMessagesPerGoroutine; j++ {
+				mc.CaptureSentMessage("topic", []byte("data"), "")
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	// all the records fit in the file buffer, nothing reached the disk yet
+	fileInfo, err := os.Stat(captureFile(folderPath))
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), fileInfo.Size())
+
+	err = mc.Close()
+	assert.Nil(t, err)
+	assert.Nil(t, mc.Close())
+
+	capturedMessages, err := ReadCaptureFiles(&marshal.GogoProtoMarshalizer{}, captureFile(folderPath))
+	require.Nil(t, err)
+	assert.Equal(t, numGoroutines*numMessagesPerGoroutine, len(capturedMessages))
+}
+
+//------- ReadCaptureFiles
+
+func TestReadCaptureFiles_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	capturedMessages, err := ReadCaptureFiles(nil, "file")
+	assert.Nil(t, capturedMessages)
+	assert.Equal(t, p2p.ErrNilMarshalizer, err)
+}
+
+func TestReadCaptureFiles_InvalidFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	filename := filepath.Join(folderPath, "invalid")
+	_ = ioutil.WriteFile(filename, []byte("not a capture file"), captureFilePermissions)
+
+	capturedMessages, err := ReadCaptureFiles(&marshal.GogoProtoMarshalizer{}, filename)
+	assert.Nil(t, capturedMessages)
+	assert.True(t, errors.Is(err, debug.ErrInvalidCaptureFile))
+}
+
+func TestReadCaptureFiles_TruncatedRecordShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	folderPath := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	mc, _ := NewMessageCapturer(createMockArgsMessageCapturer(folderPath))
+	mc.CaptureSentMessage("topic", []byte("first"), "")
+	mc.CaptureSentMessage("topic", []byte("second"), "")
+	_ = mc.Close()
+
+	filename := captureFile(folderPath)
+	buff, _ := ioutil.ReadFile(filename)
+	_ = ioutil.WriteFile(filename, buff[:len(buff)-3], captureFilePermissions)
+
+	capturedMessages, err := ReadCaptureFiles(&marshal.GogoProtoMarshalizer{}, filename)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(capturedMessages))
+	assert.Equal(t, []byte("first"), capturedMessages[0].Data)
+}
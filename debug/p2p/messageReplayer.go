@@ -0,0 +1,78 @@
+package p2p
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/p2p/message"
+)
+
+// ReplayStatistics holds the outcome of a replay
+type ReplayStatistics struct {
+	NumProcessed uint32
+	NumRejected  uint32
+	NumSkipped   uint32
+}
+
+type messageReplayer struct {
+	messenger ReplayMessenger
+}
+
+// NewMessageReplayer creates a replayer that feeds captured messages into the provided messenger (usually a memp2p
+// messenger having the interceptors and consensus topics registered)
+func NewMessageReplayer(messenger ReplayMessenger) (*messageReplayer, error) {
+	if check.IfNil(messenger) {
+		return nil, debug.ErrNilReplayMessenger
+	}
+
+	return &messageReplayer{
+		messenger: messenger,
+	}, nil
+}
+
+// Replay processes the received messages one by one, in the capture order, each message being completely processed
+// before the next one is handed to the messenger. The sent messages are skipped as the messages broadcast by the
+// captured node were also captured as received by itself
+func (mr *messageReplayer) Replay(capturedMessages []*CapturedMessage) ReplayStatistics {
+	stats := ReplayStatistics{}
+	for _, capturedMessage := range capturedMessages {
+		if capturedMessage.Direction != ReceivedMessage {
+			stats.NumSkipped++
+			continue
+		}
+
+		err := mr.messenger.ProcessMessage(CreateReplayedMessage(capturedMessage), core.PeerID(capturedMessage.ConnectedPeer))
+		if err != nil {
+			log.Trace("replayed p2p message rejected",
+				"topic", capturedMessage.Topic,
+				"originator", core.PeerID(capturedMessage.From).Pretty(),
+				"error", err.Error(),
+			)
+			stats.NumRejected++
+			continue
+		}
+
+		stats.NumProcessed++
+	}
+
+	return stats
+}
+
+// CreateReplayedMessage rebuilds the p2p message from the captured data
+func CreateReplayedMessage(capturedMessage *CapturedMessage) *message.Message {
+	return &message.Message{
+		FromField:      capturedMessage.From,
+		DataField:      capturedMessage.Data,
+		SeqNoField:     capturedMessage.SeqNo,
+		TopicsField:    []string{capturedMessage.Topic},
+		SignatureField: capturedMessage.Signature,
+		KeyField:       capturedMessage.Key,
+		PeerField:      core.PeerID(capturedMessage.From),
+		TimestampField: capturedMessage.Timestamp,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *messageReplayer) IsInterfaceNil() bool {
+	return mr == nil
+}
@@ -0,0 +1,92 @@
+package p2p
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMessageReplayer_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mr, err := NewMessageReplayer(nil)
+
+	assert.True(t, check.IfNil(mr))
+	assert.Equal(t, debug.ErrNilReplayMessenger, err)
+}
+
+func TestCreateReplayedMessage(t *testing.T) {
+	t.Parallel()
+
+	capturedMessage := &CapturedMessage{
+		Topic:     "topic",
+		From:      []byte("originator"),
+		Timestamp: 1234,
+		SeqNo:     []byte("seq no"),
+		Signature: []byte("signature"),
+		Key:       []byte("key"),
+		Data:      []byte("data"),
+	}
+
+	msg := CreateReplayedMessage(capturedMessage)
+
+	assert.Equal(t, []string{"topic"}, msg.Topics())
+	assert.Equal(t, capturedMessage.From, msg.From())
+	assert.Equal(t, core.PeerID("originator"), msg.Peer())
+	assert.Equal(t, capturedMessage.Timestamp, msg.Timestamp())
+	assert.Equal(t, capturedMessage.SeqNo, msg.SeqNo())
+	assert.Equal(t, capturedMessage.Signature, msg.Signature())
+	assert.Equal(t, capturedMessage.Key, msg.Key())
+	assert.Equal(t, capturedMessage.Data, msg.Data())
+}
+
+func TestMessageReplayer_ReplayOnMemp2pShouldProcessInCaptureOrder(t *testing.T) {
+	t.Parallel()
+
+	messenger, _ := memp2p.NewMessenger(memp2p.NewNetwork())
+	_ = messenger.CreateTopic("topic", false)
+	_ = messenger.CreateTopic("no processor", false)
+
+	processed := make([]string, 0)
+	connectedPeers := make([]core.PeerID, 0)
+	_ = messenger.RegisterMessageProcessor("topic", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			processed = append(processed, string(message.Data()))
+			connectedPeers = append(connectedPeers, fromConnectedPeer)
+			if string(message.Data()) == "rejected" {
+				return errors.New("rejected")
+			}
+
+			return nil
+		},
+	})
+
+	capturedMessages := []*CapturedMessage{
+		{Direction: ReceivedMessage, Topic: "topic", Data: []byte("first"), ConnectedPeer: []byte("peer 1")},
+		{Direction: SentMessage, Topic: "topic", Data: []byte("sent")},
+		{Direction: ReceivedMessage, Topic: "topic", Data: []byte("rejected"), ConnectedPeer: []byte("peer 2")},
+		{Direction: ReceivedMessage, Topic: "no processor", Data: []byte("no processor")},
+		{Direction: ReceivedMessage, Topic: "unknown topic", Data: []byte("unknown topic")},
+		{Direction: ReceivedMessage, Topic: "topic", Data: []byte("last"), ConnectedPeer: []byte("peer 1")},
+	}
+
+	mr, _ := NewMessageReplayer(messenger)
+	stats := mr.Replay(capturedMessages)
+
+	expectedStats := ReplayStatistics{
+		NumProcessed: 2,
+		NumRejected:  3,
+		NumSkipped:   1,
+	}
+	assert.Equal(t, expectedStats, stats)
+	require.Equal(t, []string{"first", "rejected", "last"}, processed)
+	assert.Equal(t, []core.PeerID{"peer 1", "peer 2", "peer 1"}, connectedPeers)
+}
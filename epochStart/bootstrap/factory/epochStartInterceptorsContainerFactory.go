@@ -46,6 +46,7 @@ type ArgsEpochStartInterceptorContainer struct {
 	MinTransactionVersion     uint32
 	HeaderIntegrityVerifier   process.HeaderIntegrityVerifier
 	EnableSignTxWithHashEpoch uint32
+	GuardianEnableEpoch       uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
 }
@@ -98,6 +99,7 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 		ChainID:                   args.ChainID,
 		MinTransactionVersion:     args.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		GuardianEnableEpoch:       args.GuardianEnableEpoch,
 		TxSignHasher:              args.TxSignHasher,
 		EpochNotifier:             args.EpochNotifier,
 	}
@@ -105,6 +105,7 @@ type epochStartBootstrap struct {
 	statusHandler              core.AppStatusHandler
 	headerIntegrityVerifier    process.HeaderIntegrityVerifier
 	enableSignTxWithHashEpoch  uint32
+	guardianEnableEpoch        uint32
 	txSignHasher               hashing.Hasher
 	epochNotifier              process.EpochNotifier
 
@@ -221,6 +222,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		headerIntegrityVerifier:    args.HeaderIntegrityVerifier,
 		txSignHasher:               args.TxSignHasher,
 		enableSignTxWithHashEpoch:  args.GeneralConfig.GeneralSettings.TransactionSignedWithTxHashEnableEpoch,
+		guardianEnableEpoch:        args.GeneralConfig.GeneralSettings.GuardianEnableEpoch,
 		epochNotifier:              args.EpochNotifier,
 	}
 
@@ -511,6 +513,7 @@ func (e *epochStartBootstrap) createSyncers() error {
 		MinTransactionVersion:     e.genesisNodesConfig.GetMinTransactionVersion(),
 		HeaderIntegrityVerifier:   e.headerIntegrityVerifier,
 		EnableSignTxWithHashEpoch: e.enableSignTxWithHashEpoch,
+		GuardianEnableEpoch:       e.guardianEnableEpoch,
 		TxSignHasher:              e.txSignHasher,
 		EpochNotifier:             e.epochNotifier,
 	}
@@ -836,6 +839,8 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 }
 
 func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
+	e.importStateSnapshot(rootHash, e.trieStorageManagers[factory.UserAccountTrie])
+
 	thr, err := throttler.NewNumGoRoutinesThrottler(numConcurrentTrieSyncers)
 	if err != nil {
 		return err
@@ -913,6 +918,8 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 }
 
 func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
+	e.importStateSnapshot(rootHash, e.trieStorageManagers[factory.PeerAccountTrie])
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:               e.hasher,
@@ -0,0 +1,50 @@
+package bootstrap
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/stateSnapshot"
+)
+
+// importStateSnapshot seeds the trie storage with the nodes found in the snapshot file for the given root hash, if
+// such a file exists. Any failure is only logged as the trie syncer will request the missing nodes from the network
+func (e *epochStartBootstrap) importStateSnapshot(rootHash []byte, trieStorageManager data.StorageManager) {
+	if !e.generalConfig.StateSnapshotImport.Enabled {
+		return
+	}
+
+	filePath := filepath.Join(e.generalConfig.StateSnapshotImport.Directory, stateSnapshot.FileName(rootHash))
+	file, err := os.Open(filePath)
+	if err != nil {
+		log.Debug("no state snapshot file to import", "root hash", rootHash, "error", err.Error())
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	importer, err := stateSnapshot.NewStateImporter(stateSnapshot.ArgsStateImporter{
+		Marshalizer: e.marshalizer,
+		Hasher:      e.hasher,
+	})
+	if err != nil {
+		log.Warn("can not create the state snapshot importer", "error", err.Error())
+		return
+	}
+
+	log.Info("importing state snapshot", "file", filePath)
+	numNodes, err := importer.Import(bufio.NewReader(file), rootHash, trieStorageManager.Database())
+	if err != nil {
+		log.Warn("state snapshot import failed, the missing trie nodes will be synced from network",
+			"file", filePath,
+			"num imported nodes", numNodes,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	log.Info("state snapshot imported", "file", filePath, "num nodes", numNodes)
+}
@@ -0,0 +1,73 @@
+package bootstrap
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/stateSnapshot"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportTestState(t *testing.T, dir string, marshalizer marshal.Marshalizer, hasher *blake2b.Blake2b) []byte {
+	tsm, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(tsm, marshalizer, hasher, 5)
+	_ = tr.Update([]byte("key1"), []byte("value1"))
+	_ = tr.Update([]byte("key2"), []byte("value2"))
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	exporter, err := stateSnapshot.NewStateExporter(stateSnapshot.ArgsStateExporter{
+		Marshalizer:        marshalizer,
+		Hasher:             hasher,
+		TrieStorageManager: tsm,
+		MaxChunkSize:       1024,
+	})
+	require.Nil(t, err)
+
+	file, err := os.Create(filepath.Join(dir, stateSnapshot.FileName(rootHash)))
+	require.Nil(t, err)
+	_, err = exporter.Export(rootHash, false, file)
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	return rootHash
+}
+
+func TestEpochStartBootstrap_ImportStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "stateSnapshots")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := &blake2b.Blake2b{}
+	rootHash := exportTestState(t, dir, marshalizer, hasher)
+
+	args := createMockEpochStartBootstrapArgs()
+	args.Marshalizer = marshalizer
+	args.Hasher = hasher
+	args.GeneralConfig.StateSnapshotImport.Directory = dir
+	epochStartProvider, _ := NewEpochStartBootstrap(args)
+
+	db := memorydb.New()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(db)
+
+	epochStartProvider.importStateSnapshot(rootHash, storageManager)
+	assert.NotNil(t, db.Has(rootHash), "import is disabled")
+
+	epochStartProvider.generalConfig.StateSnapshotImport.Enabled = true
+	epochStartProvider.importStateSnapshot([]byte("missing root hash"), storageManager)
+	assert.NotNil(t, db.Has(rootHash))
+
+	epochStartProvider.importStateSnapshot(rootHash, storageManager)
+	assert.Nil(t, db.Has(rootHash))
+}
@@ -3,13 +3,19 @@ package facade
 import (
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	apiState "github.com/ElrondNetwork/elrond-go/api/state"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
-	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -20,23 +26,33 @@ type NodeHandler interface {
 	StartConsensus() error
 
 	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options address.AccountQueryOptions) (*big.Int, error)
 
 	// GetUsername returns the username for a specific address
-	GetUsername(address string) (string, error)
+	GetUsername(address string, options address.AccountQueryOptions) (string, error)
 
 	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	GetValueForKey(address string, key string, options address.AccountQueryOptions) (string, error)
 
 	// GetESDTBalance returns the esdt balance and properties from a given account
-	GetESDTBalance(address string, key string) (string, string, error)
+	GetESDTBalance(address string, key string, options address.AccountQueryOptions) (string, string, error)
 
 	// GetAllESDTTokens returns the value of a key from a given account
-	GetAllESDTTokens(address string) ([]string, error)
+	GetAllESDTTokens(address string, options address.AccountQueryOptions) ([]string, error)
+
+	// GetProof returns a given account together with its Merkle proof
+	GetProof(address string, options address.AccountQueryOptions) (*address.AccountProof, error)
+
+	// GetProofForKey returns the value of a key from a given account together with the Merkle proofs
+	GetProofForKey(address string, key string, options address.AccountQueryOptions) (*address.AccountProof, error)
+
+	// GetAddressTransactions returns a page of the transactions history of a given account
+	GetAddressTransactions(address string, beforeNonce uint64, size uint64) (*address.AddressTransactions, error)
 
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32,
+		guardianHex string, guardianSignatureHex string) (*transaction.Transaction, []byte, error)
 
 	//ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
@@ -50,10 +66,10 @@ type NodeHandler interface {
 
 	// GetAccount returns an accountResponse containing information
 	//  about the account corelated with provided address
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccount(address string, options address.AccountQueryOptions) (state.UserAccountHandler, error)
 
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
-	GetHeartbeats() []data.PubKeyHeartbeat
+	GetHeartbeats() []heartbeatData.PubKeyHeartbeat
 
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
@@ -71,6 +87,12 @@ type NodeHandler interface {
 
 	GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetLatestBlock(withTxs bool) (*block.APIBlock, error)
+	GetBlocksByRange(from uint64, to uint64, withTxs bool) ([]*block.APIBlock, error)
+	GetHyperblockByNonce(nonce uint64) (*block.APIHyperblock, error)
+	GetHyperblockByHash(hash string) (*block.APIHyperblock, error)
+
+	GetStateDiff(fromRootHash string, toRootHash string) (*apiState.StateDiff, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
@@ -84,6 +106,13 @@ type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	StatusMetrics() external.StatusMetricsHandler
+	GetTxHashesByLogIdentifier(identifier []byte) ([][]byte, error)
+	GetTransactionLog(txHash []byte) (data.LogHandler, error)
+	GetDelegationContractsMetaData() ([]*delegation.ContractMetaData, error)
+	GetGovernanceProposal(reference string) (*governance.Proposal, error)
+	GetGovernanceVotingPower(address string) (uint64, error)
+	GetStakingQueue() ([]*validator.QueuedNode, error)
+	GetOwnerNodesStatus(address string) (*validator.OwnerNodes, error)
 	IsInterfaceNil() bool
 }
 
@@ -1,7 +1,11 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -9,9 +13,16 @@ import (
 
 // ApiResolverStub -
 type ApiResolverStub struct {
-	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	StatusMetricsHandler              func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
+	ExecuteSCQueryHandler                func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	StatusMetricsHandler                 func() external.StatusMetricsHandler
+	ComputeTransactionGasLimitHandler    func(tx *transaction.Transaction) (uint64, error)
+	GetTxHashesByLogIdentifierCalled     func(identifier []byte) ([][]byte, error)
+	GetTransactionLogCalled              func(txHash []byte) (data.LogHandler, error)
+	GetDelegationContractsMetaDataCalled func() ([]*delegation.ContractMetaData, error)
+	GetGovernanceProposalCalled          func(reference string) (*governance.Proposal, error)
+	GetGovernanceVotingPowerCalled       func(address string) (uint64, error)
+	GetStakingQueueCalled                func() ([]*validator.QueuedNode, error)
+	GetOwnerNodesStatusCalled            func(address string) (*validator.OwnerNodes, error)
 }
 
 // ExecuteSCQuery -
@@ -29,6 +40,69 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return ars.ComputeTransactionGasLimitHandler(tx)
 }
 
+// GetTxHashesByLogIdentifier -
+func (ars *ApiResolverStub) GetTxHashesByLogIdentifier(identifier []byte) ([][]byte, error) {
+	if ars.GetTxHashesByLogIdentifierCalled != nil {
+		return ars.GetTxHashesByLogIdentifierCalled(identifier)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionLog -
+func (ars *ApiResolverStub) GetTransactionLog(txHash []byte) (data.LogHandler, error) {
+	if ars.GetTransactionLogCalled != nil {
+		return ars.GetTransactionLogCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationContractsMetaData -
+func (ars *ApiResolverStub) GetDelegationContractsMetaData() ([]*delegation.ContractMetaData, error) {
+	if ars.GetDelegationContractsMetaDataCalled != nil {
+		return ars.GetDelegationContractsMetaDataCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(reference string) (*governance.Proposal, error) {
+	if ars.GetGovernanceProposalCalled != nil {
+		return ars.GetGovernanceProposalCalled(reference)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotingPower -
+func (ars *ApiResolverStub) GetGovernanceVotingPower(address string) (uint64, error) {
+	if ars.GetGovernanceVotingPowerCalled != nil {
+		return ars.GetGovernanceVotingPowerCalled(address)
+	}
+
+	return 0, nil
+}
+
+// GetStakingQueue -
+func (ars *ApiResolverStub) GetStakingQueue() ([]*validator.QueuedNode, error) {
+	if ars.GetStakingQueueCalled != nil {
+		return ars.GetStakingQueueCalled()
+	}
+
+	return nil, nil
+}
+
+// GetOwnerNodesStatus -
+func (ars *ApiResolverStub) GetOwnerNodesStatus(address string) (*validator.OwnerNodes, error) {
+	if ars.GetOwnerNodesStatusCalled != nil {
+		return ars.GetOwnerNodesStatusCalled(address)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	apiState "github.com/ElrondNetwork/elrond-go/api/state"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -20,7 +22,7 @@ type NodeStub struct {
 	GetBalanceHandler          func(address string) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32, guardianHex string, guardianSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
@@ -38,13 +40,21 @@ type NodeStub struct {
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetLatestBlockCalled                           func(withTxs bool) (*block.APIBlock, error)
+	GetBlocksByRangeCalled                         func(from uint64, to uint64, withTxs bool) ([]*block.APIBlock, error)
+	GetHyperblockByNonceCalled                     func(nonce uint64) (*block.APIHyperblock, error)
+	GetHyperblockByHashCalled                      func(hash string) (*block.APIHyperblock, error)
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                         func(address string) ([]string, error)
+	GetProofCalled                                 func(address string) (*address.AccountProof, error)
+	GetAddressTransactionsCalled                   func(address string, beforeNonce uint64, size uint64) (*address.AddressTransactions, error)
+	GetProofForKeyCalled                           func(address string, key string) (*address.AccountProof, error)
+	GetStateDiffCalled                             func(fromRootHash string, toRootHash string) (*apiState.StateDiff, error)
 }
 
 // GetUsername -
-func (ns *NodeStub) GetUsername(address string) (string, error) {
+func (ns *NodeStub) GetUsername(address string, _ address.AccountQueryOptions) (string, error) {
 	if ns.GetUsernameCalled != nil {
 		return ns.GetUsernameCalled(address)
 	}
@@ -53,7 +63,7 @@ func (ns *NodeStub) GetUsername(address string) (string, error) {
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, _ address.AccountQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
 		return ns.GetValueForKeyCalled(address, key)
 	}
@@ -76,6 +86,51 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetLatestBlock -
+func (ns *NodeStub) GetLatestBlock(withTxs bool) (*block.APIBlock, error) {
+	if ns.GetLatestBlockCalled != nil {
+		return ns.GetLatestBlockCalled(withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetBlocksByRange -
+func (ns *NodeStub) GetBlocksByRange(from uint64, to uint64, withTxs bool) ([]*block.APIBlock, error) {
+	if ns.GetBlocksByRangeCalled != nil {
+		return ns.GetBlocksByRangeCalled(from, to, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (ns *NodeStub) GetHyperblockByNonce(nonce uint64) (*block.APIHyperblock, error) {
+	if ns.GetHyperblockByNonceCalled != nil {
+		return ns.GetHyperblockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByHash -
+func (ns *NodeStub) GetHyperblockByHash(hash string) (*block.APIHyperblock, error) {
+	if ns.GetHyperblockByHashCalled != nil {
+		return ns.GetHyperblockByHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(fromRootHash string, toRootHash string) (*apiState.StateDiff, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(fromRootHash, toRootHash)
+	}
+
+	return nil, nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -87,15 +142,15 @@ func (ns *NodeStub) StartConsensus() error {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
+func (ns *NodeStub) GetBalance(address string, _ address.AccountQueryOptions) (*big.Int, error) {
 	return ns.GetBalanceHandler(address)
 }
 
 // CreateTransaction -
 func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
-	gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error) {
+	gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32, guardianHex string, guardianSignatureHex string) (*transaction.Transaction, []byte, error) {
 
-	return ns.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
+	return ns.CreateTransactionHandler(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, data, signatureHex, chainID, version, options, guardianHex, guardianSignatureHex)
 }
 
 //ValidateTransaction -
@@ -119,7 +174,7 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error) {
+func (ns *NodeStub) GetAccount(address string, _ address.AccountQueryOptions) (state.UserAccountHandler, error) {
 	return ns.GetAccountHandler(address)
 }
 
@@ -162,7 +217,7 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 }
 
 // GetESDTBalance -
-func (ns *NodeStub) GetESDTBalance(address string, key string) (string, string, error) {
+func (ns *NodeStub) GetESDTBalance(address string, key string, _ address.AccountQueryOptions) (string, string, error) {
 	if ns.GetESDTBalanceCalled != nil {
 		return ns.GetESDTBalanceCalled(address, key)
 	}
@@ -171,7 +226,7 @@ func (ns *NodeStub) GetESDTBalance(address string, key string) (string, string,
 }
 
 // GetAllESDTTokens -
-func (ns *NodeStub) GetAllESDTTokens(address string) ([]string, error) {
+func (ns *NodeStub) GetAllESDTTokens(address string, _ address.AccountQueryOptions) ([]string, error) {
 	if ns.GetAllESDTTokensCalled != nil {
 		return ns.GetAllESDTTokensCalled(address)
 	}
@@ -179,6 +234,33 @@ func (ns *NodeStub) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
+// GetProof -
+func (ns *NodeStub) GetProof(address string, _ address.AccountQueryOptions) (*address.AccountProof, error) {
+	if ns.GetProofCalled != nil {
+		return ns.GetProofCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetAddressTransactions -
+func (ns *NodeStub) GetAddressTransactions(address string, beforeNonce uint64, size uint64) (*address.AddressTransactions, error) {
+	if ns.GetAddressTransactionsCalled != nil {
+		return ns.GetAddressTransactionsCalled(address, beforeNonce, size)
+	}
+
+	return nil, nil
+}
+
+// GetProofForKey -
+func (ns *NodeStub) GetProofForKey(address string, key string, _ address.AccountQueryOptions) (*address.AccountProof, error) {
+	if ns.GetProofForKeyCalled != nil {
+		return ns.GetProofForKeyCalled(address, key)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
@@ -11,9 +11,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	apiState "github.com/ElrondNetwork/elrond-go/api/state"
 	transactionApi "github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -23,11 +27,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/debug"
-	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -41,6 +46,9 @@ const DefaultRestInterface = "localhost:8080"
 const DefaultRestPortOff = "off"
 
 var _ = address.FacadeHandler(&nodeFacade{})
+var _ = delegation.FacadeHandler(&nodeFacade{})
+var _ = events.FacadeHandler(&nodeFacade{})
+var _ = governance.FacadeHandler(&nodeFacade{})
 var _ = hardfork.FacadeHandler(&nodeFacade{})
 var _ = node.FacadeHandler(&nodeFacade{})
 var _ = transactionApi.FacadeHandler(&nodeFacade{})
@@ -255,28 +263,43 @@ func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
 }
 
 // GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+func (nf *nodeFacade) GetBalance(address string, options address.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetUsername gets the username for a specified address
-func (nf *nodeFacade) GetUsername(address string) (string, error) {
-	return nf.node.GetUsername(address)
+func (nf *nodeFacade) GetUsername(address string, options address.AccountQueryOptions) (string, error) {
+	return nf.node.GetUsername(address, options)
 }
 
 // GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+func (nf *nodeFacade) GetValueForKey(address string, key string, options address.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
 // GetESDTBalance returns the ESDT balance and if it is frozen
-func (nf *nodeFacade) GetESDTBalance(address string, key string) (string, string, error) {
-	return nf.node.GetESDTBalance(address, key)
+func (nf *nodeFacade) GetESDTBalance(address string, key string, options address.AccountQueryOptions) (string, string, error) {
+	return nf.node.GetESDTBalance(address, key, options)
 }
 
 // GetAllESDTTokens returns all the esdt tokens for a given address
-func (nf *nodeFacade) GetAllESDTTokens(address string) ([]string, error) {
-	return nf.node.GetAllESDTTokens(address)
+func (nf *nodeFacade) GetAllESDTTokens(address string, options address.AccountQueryOptions) ([]string, error) {
+	return nf.node.GetAllESDTTokens(address, options)
+}
+
+// GetProof returns the account for a given address together with its Merkle proof
+func (nf *nodeFacade) GetProof(address string, options address.AccountQueryOptions) (*address.AccountProof, error) {
+	return nf.node.GetProof(address, options)
+}
+
+// GetProofForKey returns the value for a key in a given address together with the Merkle proofs
+func (nf *nodeFacade) GetProofForKey(address string, key string, options address.AccountQueryOptions) (*address.AccountProof, error) {
+	return nf.node.GetProofForKey(address, key, options)
+}
+
+// GetAddressTransactions returns a page of the transactions history of a given address, newest first
+func (nf *nodeFacade) GetAddressTransactions(address string, beforeNonce uint64, size uint64) (*address.AddressTransactions, error) {
+	return nf.node.GetAddressTransactions(address, beforeNonce, size)
 }
 
 // CreateTransaction creates a transaction from all needed fields
@@ -292,9 +315,11 @@ func (nf *nodeFacade) CreateTransaction(
 	chainID string,
 	version uint32,
 	options uint32,
+	guardianHex string,
+	guardianSignatureHex string,
 ) (*transaction.Transaction, []byte, error) {
 
-	return nf.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, txData, signatureHex, chainID, version, options)
+	return nf.node.CreateTransaction(nonce, value, receiverHex, senderHex, gasPrice, gasLimit, txData, signatureHex, chainID, version, options, guardianHex, guardianSignatureHex)
 }
 
 // ValidateTransaction will validate a transaction
@@ -334,12 +359,12 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (u
 
 // GetAccount returns an accountResponse containing information
 // about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options address.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccount(address, options)
 }
 
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
-func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
+func (nf *nodeFacade) GetHeartbeats() ([]heartbeatData.PubKeyHeartbeat, error) {
 	hbStatus := nf.node.GetHeartbeats()
 	if hbStatus == nil {
 		return nil, ErrHeartbeatsNotActive
@@ -416,6 +441,107 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlo
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetLatestBlock returns the latest committed block
+func (nf *nodeFacade) GetLatestBlock(withTxs bool) (*block.APIBlock, error) {
+	return nf.node.GetLatestBlock(withTxs)
+}
+
+// GetBlocksByRange returns the blocks with the nonces in the given interval
+func (nf *nodeFacade) GetBlocksByRange(from uint64, to uint64, withTxs bool) ([]*block.APIBlock, error) {
+	return nf.node.GetBlocksByRange(from, to, withTxs)
+}
+
+// GetHyperblockByNonce returns the hyperblock for a given metablock nonce
+func (nf *nodeFacade) GetHyperblockByNonce(nonce uint64) (*block.APIHyperblock, error) {
+	return nf.node.GetHyperblockByNonce(nonce)
+}
+
+// GetHyperblockByHash returns the hyperblock for a given metablock hash
+func (nf *nodeFacade) GetHyperblockByHash(hash string) (*block.APIHyperblock, error) {
+	return nf.node.GetHyperblockByHash(hash)
+}
+
+// GetStateDiff returns the changes of the state between two state root hashes
+func (nf *nodeFacade) GetStateDiff(fromRootHash string, toRootHash string) (*apiState.StateDiff, error) {
+	return nf.node.GetStateDiff(fromRootHash, toRootHash)
+}
+
+// GetDelegationContractsMetaData returns the addresses of all delegation contracts together with their metadata
+func (nf *nodeFacade) GetDelegationContractsMetaData() ([]*delegation.ContractMetaData, error) {
+	return nf.apiResolver.GetDelegationContractsMetaData()
+}
+
+// GetGovernanceProposal returns the governance proposal with the given reference together with its vote tally
+func (nf *nodeFacade) GetGovernanceProposal(reference string) (*governance.Proposal, error) {
+	return nf.apiResolver.GetGovernanceProposal(reference)
+}
+
+// GetGovernanceVotingPower returns the number of votes the given address can cast on governance proposals
+func (nf *nodeFacade) GetGovernanceVotingPower(address string) (uint64, error) {
+	return nf.apiResolver.GetGovernanceVotingPower(address)
+}
+
+// GetLogEventsByIdentifier returns the log events with the given identifier, most recent transactions first
+func (nf *nodeFacade) GetLogEventsByIdentifier(identifier string) ([]*events.LogEvent, error) {
+	txHashes, err := nf.apiResolver.GetTxHashesByLogIdentifier([]byte(identifier))
+	if err != nil {
+		return nil, err
+	}
+
+	logEvents := make([]*events.LogEvent, 0, len(txHashes))
+	for i := len(txHashes) - 1; i >= 0; i-- {
+		txLog, errGet := nf.apiResolver.GetTransactionLog(txHashes[i])
+		if errGet != nil {
+			log.Debug("GetLogEventsByIdentifier: cannot get transaction log",
+				"txHash", txHashes[i], "error", errGet.Error())
+			continue
+		}
+
+		for _, event := range txLog.GetLogEvents() {
+			if string(event.GetIdentifier()) != identifier {
+				continue
+			}
+
+			logEvent, errCreate := nf.createLogEvent(txHashes[i], event)
+			if errCreate != nil {
+				return nil, errCreate
+			}
+			logEvents = append(logEvents, logEvent)
+		}
+	}
+
+	return logEvents, nil
+}
+
+func (nf *nodeFacade) createLogEvent(txHash []byte, event data.EventHandler) (*events.LogEvent, error) {
+	address := ""
+	if len(event.GetAddress()) > 0 {
+		var err error
+		address, err = nf.node.EncodeAddressPubkey(event.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &events.LogEvent{
+		TxHash:     hex.EncodeToString(txHash),
+		Address:    address,
+		Identifier: string(event.GetIdentifier()),
+		Topics:     event.GetTopics(),
+		Data:       event.GetData(),
+	}, nil
+}
+
+// GetStakingQueue returns the ordered list of the nodes waiting in the staking queue
+func (nf *nodeFacade) GetStakingQueue() ([]*validator.QueuedNode, error) {
+	return nf.apiResolver.GetStakingQueue()
+}
+
+// GetOwnerNodesStatus returns the staked amounts and the status of all the nodes of the given owner
+func (nf *nodeFacade) GetOwnerNodesStatus(address string) (*validator.OwnerNodes, error) {
+	return nf.apiResolver.GetOwnerNodesStatus(address)
+}
+
 // Close will cleanup started go routines
 // TODO use this close method
 func (nf *nodeFacade) Close() error {
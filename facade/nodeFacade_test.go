@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -8,17 +9,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	atomicCore "github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
-	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
@@ -152,7 +159,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, address.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -179,7 +186,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, address.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -200,7 +207,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, address.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -288,7 +295,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", address.AccountQueryOptions{})
 	assert.Equal(t, called, 1)
 }
 
@@ -305,7 +312,7 @@ func TestNodeFacade_GetUsername(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	username, err := nf.GetUsername("test")
+	username, err := nf.GetUsername("test", address.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUsername, username)
 }
@@ -314,7 +321,7 @@ func TestNodeFacade_GetHeartbeatsReturnsNilShouldErr(t *testing.T) {
 	t.Parallel()
 
 	node := &mock.NodeStub{
-		GetHeartbeatsHandler: func() []data.PubKeyHeartbeat {
+		GetHeartbeatsHandler: func() []heartbeatData.PubKeyHeartbeat {
 			return nil
 		},
 	}
@@ -332,19 +339,19 @@ func TestNodeFacade_GetHeartbeats(t *testing.T) {
 	t.Parallel()
 
 	node := &mock.NodeStub{
-		GetHeartbeatsHandler: func() []data.PubKeyHeartbeat {
-			return []data.PubKeyHeartbeat{
+		GetHeartbeatsHandler: func() []heartbeatData.PubKeyHeartbeat {
+			return []heartbeatData.PubKeyHeartbeat{
 				{
 					PublicKey:       "pk1",
 					TimeStamp:       time.Now(),
-					MaxInactiveTime: data.Duration{Duration: 0},
+					MaxInactiveTime: heartbeatData.Duration{Duration: 0},
 					IsActive:        true,
 					ReceivedShardID: uint32(0),
 				},
 				{
 					PublicKey:       "pk2",
 					TimeStamp:       time.Now(),
-					MaxInactiveTime: data.Duration{Duration: 0},
+					MaxInactiveTime: heartbeatData.Duration{Duration: 0},
 					IsActive:        true,
 					ReceivedShardID: uint32(0),
 				},
@@ -379,6 +386,93 @@ func TestNodeFacade_GetDataValue(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeFacade_GetDelegationContractsMetaDataShouldCallApiResolver(t *testing.T) {
+	t.Parallel()
+
+	expectedContracts := []*delegation.ContractMetaData{{Name: "name"}}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetDelegationContractsMetaDataCalled: func() ([]*delegation.ContractMetaData, error) {
+			return expectedContracts, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	contracts, err := nf.GetDelegationContractsMetaData()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedContracts, contracts)
+}
+
+func TestNodeFacade_GetGovernanceProposalShouldCallApiResolver(t *testing.T) {
+	t.Parallel()
+
+	expectedProposal := &governance.Proposal{Reference: "reference"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalCalled: func(reference string) (*governance.Proposal, error) {
+			assert.Equal(t, "reference", reference)
+			return expectedProposal, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	proposal, err := nf.GetGovernanceProposal("reference")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProposal, proposal)
+}
+
+func TestNodeFacade_GetGovernanceVotingPowerShouldCallApiResolver(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceVotingPowerCalled: func(address string) (uint64, error) {
+			assert.Equal(t, "address", address)
+			return 5, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	votingPower, err := nf.GetGovernanceVotingPower("address")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), votingPower)
+}
+
+func TestNodeFacade_GetStakingQueueShouldCallApiResolver(t *testing.T) {
+	t.Parallel()
+
+	expectedQueue := []*validator.QueuedNode{{Position: 1, BLSKey: "aa"}}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetStakingQueueCalled: func() ([]*validator.QueuedNode, error) {
+			return expectedQueue, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	queue, err := nf.GetStakingQueue()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedQueue, queue)
+}
+
+func TestNodeFacade_GetOwnerNodesStatusShouldCallApiResolver(t *testing.T) {
+	t.Parallel()
+
+	expectedOwnerNodes := &validator.OwnerNodes{Owner: "owner"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetOwnerNodesStatusCalled: func(address string) (*validator.OwnerNodes, error) {
+			assert.Equal(t, "owner", address)
+			return expectedOwnerNodes, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	ownerNodes, err := nf.GetOwnerNodesStatus("owner")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedOwnerNodes, ownerNodes)
+}
+
 func TestNodeFacade_EmptyRestInterface(t *testing.T) {
 	t.Parallel()
 
@@ -489,7 +583,7 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 
 	nodeCreateTxWasCalled := false
 	node := &mock.NodeStub{
-		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ []byte, _ string, _ string, _, _ uint32) (*transaction.Transaction, []byte, error) {
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ string, _ uint64, _ uint64, _ []byte, _ string, _ string, _, _ uint32, _ string, _ string) (*transaction.Transaction, []byte, error) {
 			nodeCreateTxWasCalled = true
 			return nil, nil, nil
 		},
@@ -498,7 +592,7 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _, _ = nf.CreateTransaction(0, "0", "0", "0", 0, 0, []byte("0"), "0", "chainID", 1, 0)
+	_, _, _ = nf.CreateTransaction(0, "0", "0", "0", 0, 0, []byte("0"), "0", "chainID", 1, 0, "", "")
 
 	assert.True(t, nodeCreateTxWasCalled)
 }
@@ -654,3 +748,74 @@ func TestNodeFacade_GetThrottlerForEndpointShouldFindAndReturn(t *testing.T) {
 	assert.NotNil(t, thr)
 	assert.True(t, ok)
 }
+
+func TestNodeFacade_GetLogEventsByIdentifierShouldWork(t *testing.T) {
+	t.Parallel()
+
+	esdtAddress := []byte("esdt")
+	txHash1 := []byte("txHash1")
+	txHash2 := []byte("txHash2")
+	logs := map[string]*transaction.Log{
+		string(txHash1): {
+			Events: []*transaction.Event{
+				{Identifier: []byte("issue"), Address: esdtAddress, Topics: [][]byte{[]byte("TKN1")}},
+				{Identifier: []byte("ESDTTransfer"), Address: esdtAddress},
+			},
+		},
+		string(txHash2): {
+			Events: []*transaction.Event{
+				{Identifier: []byte("issue"), Address: esdtAddress, Topics: [][]byte{[]byte("TKN2")}},
+			},
+		},
+	}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTxHashesByLogIdentifierCalled: func(identifier []byte) ([][]byte, error) {
+			assert.Equal(t, []byte("issue"), identifier)
+			return [][]byte{txHash1, []byte("missing"), txHash2}, nil
+		},
+		GetTransactionLogCalled: func(txHash []byte) (data.LogHandler, error) {
+			txLog, ok := logs[string(txHash)]
+			if !ok {
+				return nil, process.ErrLogNotFound
+			}
+			return txLog, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	logEvents, err := nf.GetLogEventsByIdentifier("issue")
+	require.Nil(t, err)
+	expectedLogEvents := []*events.LogEvent{
+		{
+			TxHash:     hex.EncodeToString(txHash2),
+			Address:    hex.EncodeToString(esdtAddress),
+			Identifier: "issue",
+			Topics:     [][]byte{[]byte("TKN2")},
+		},
+		{
+			TxHash:     hex.EncodeToString(txHash1),
+			Address:    hex.EncodeToString(esdtAddress),
+			Identifier: "issue",
+			Topics:     [][]byte{[]byte("TKN1")},
+		},
+	}
+	assert.Equal(t, expectedLogEvents, logEvents)
+}
+
+func TestNodeFacade_GetLogEventsByIdentifierResolverErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTxHashesByLogIdentifierCalled: func(_ []byte) ([][]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	logEvents, err := nf.GetLogEventsByIdentifier("issue")
+	assert.Nil(t, logEvents)
+	assert.Equal(t, expectedErr, err)
+}
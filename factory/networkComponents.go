@@ -66,6 +66,7 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		ncf.mainConfig,
 		ncf.statusHandler,
 		netMessenger.ID(),
+		netMessenger.TrustedPeers(),
 	)
 	if errNewAntiflood != nil {
 		return nil, errNewAntiflood
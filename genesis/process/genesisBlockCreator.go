@@ -33,6 +33,7 @@ import (
 )
 
 const accountStartNonce = uint64(0)
+const genesisMinTxVersion = uint32(1)
 
 type genesisBlockCreator struct {
 	arg ArgsGenesisBlockCreator
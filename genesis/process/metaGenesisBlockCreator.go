@@ -28,6 +28,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	scDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -427,6 +428,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		arg.Economics,
 		virtualMachineFactory.BlockChainHookImpl(),
 		arg.Blkc,
+		scDisabled.NewDisabledHistoricalStateHandler(),
 	)
 	if err != nil {
 		return nil, err
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
@@ -22,9 +23,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	scDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -253,12 +256,32 @@ func setBalanceToTrie(arg ArgsGenesisBlockCreator, accnt genesis.InitialAccountH
 }
 
 func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalConfig config.GeneralSettingsConfig) (*genesisProcessors, error) {
+	epochNotifier := forking.NewGenericEpochNotifier()
+	epochNotifier.CheckEpoch(arg.StartEpochNum)
+
+	guardedAccounts, err := guardian.NewGuardedAccount(guardian.ArgsGuardedAccount{
+		Marshalizer:                   arg.Marshalizer,
+		EpochNotifier:                 epochNotifier,
+		GuardianActivationEpochsDelay: generalConfig.GuardianActivationEpochsDelay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:          arg.GasSchedule,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          arg.Marshalizer,
-		Accounts:             arg.Accounts,
+		GasSchedule:                    arg.GasSchedule,
+		MapDNSAddresses:                make(map[string]struct{}),
+		EnableUserNameChange:           false,
+		Marshalizer:                    arg.Marshalizer,
+		Accounts:                       arg.Accounts,
+		ShardCoordinator:               arg.ShardCoordinator,
+		GuardedAccounts:                guardedAccounts,
+		GuardianEnableEpoch:            generalConfig.GuardianEnableEpoch,
+		ESDTNFTEnableEpoch:             generalConfig.ESDTNFTEnableEpoch,
+		ESDTRolesEnableEpoch:           generalConfig.ESDTRolesEnableEpoch,
+		ESDTMultiTransferEnableEpoch:   generalConfig.ESDTMultiTransferEnableEpoch,
+		ESDTLimitedTransferEnableEpoch: generalConfig.ESDTLimitedTransferEnableEpoch,
+		EpochNotifier:                  epochNotifier,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -352,9 +375,6 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		return nil, err
 	}
 
-	epochNotifier := forking.NewGenericEpochNotifier()
-	epochNotifier.CheckEpoch(arg.StartEpochNum)
-
 	genesisFeeHandler := &disabled.FeeHandler{}
 	argsNewScProcessor := smartContract.ArgsNewSmartContractProcessor{
 		VmContainer:                    vmContainer,
@@ -413,6 +433,9 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		RelayedTxEnableEpoch:           generalConfig.RelayedTransactionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      generalConfig.MetaProtectionEnableEpoch,
+		GuardianEnableEpoch:            generalConfig.GuardianEnableEpoch,
+		GuardedAccounts:                guardedAccounts,
+		TxVersionChecker:               versioning.NewTxVersionChecker(genesisMinTxVersion),
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
@@ -475,6 +498,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		arg.Economics,
 		vmFactoryImpl.BlockChainHookImpl(),
 		arg.Blkc,
+		scDisabled.NewDisabledHistoricalStateHandler(),
 	)
 	if err != nil {
 		return nil, err
@@ -124,6 +124,7 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 				createDisabledConfig(),
 				&mock.AppStatusHandlerStub{},
 				peers[i].ID(),
+				&mock.TrustedPeersCheckerStub{},
 			)
 			log.LogIfError(err)
 		}
@@ -134,6 +135,7 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 				createWorkableConfig(),
 				statusHandler,
 				peers[i].ID(),
+				&mock.TrustedPeersCheckerStub{},
 			)
 			log.LogIfError(err)
 		}
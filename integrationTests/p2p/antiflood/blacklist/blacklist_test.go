@@ -176,6 +176,7 @@ func createBlacklistHandlersAndProcessors(
 			time.Minute*5,
 			"",
 			peers[i].ID(),
+			&mock.TrustedPeersCheckerStub{},
 		)
 		log.LogIfError(err)
 	}
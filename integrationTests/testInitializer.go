@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/forking"
+	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
@@ -49,6 +50,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/guardian"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	txProc "github.com/ElrondNetwork/elrond-go/process/transaction"
@@ -865,6 +867,17 @@ func AdbEmulateBalanceTxExecution(accounts state.AccountsAdapter, acntSrc, acntD
 	return nil
 }
 
+// CreateGuardedAccountsHandler returns a guardians handler for which a guardian change becomes active in the next epoch
+func CreateGuardedAccountsHandler(epochNotifier process.EpochNotifier) process.GuardedAccountHandler {
+	guardedAccounts, _ := guardian.NewGuardedAccount(guardian.ArgsGuardedAccount{
+		Marshalizer:                   TestMarshalizer,
+		EpochNotifier:                 epochNotifier,
+		GuardianActivationEpochsDelay: 1,
+	})
+
+	return guardedAccounts
+}
+
 // CreateSimpleTxProcessor returns a transaction processor
 func CreateSimpleTxProcessor(accnts state.AccountsAdapter) process.TransactionProcessor {
 	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
@@ -897,6 +910,8 @@ func CreateSimpleTxProcessor(accnts state.AccountsAdapter) process.TransactionPr
 		ArgsParser:       smartContract.NewArgumentParser(),
 		ScrForwarder:     &mock.IntermediateTransactionHandlerMock{},
 		EpochNotifier:    forking.NewGenericEpochNotifier(),
+		GuardedAccounts:  CreateGuardedAccountsHandler(forking.NewGenericEpochNotifier()),
+		TxVersionChecker: versioning.NewTxVersionChecker(MinTransactionVersion),
 	}
 	txProcessor, _ := txProc.NewTxProcessor(argsNewTxProcessor)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	scDisabled "github.com/ElrondNetwork/elrond-go/process/smartContract/disabled"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,6 +33,7 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 	},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		scDisabled.NewDisabledHistoricalStateHandler(),
 	)
 
 	functionName := "Get"
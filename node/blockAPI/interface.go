@@ -7,3 +7,10 @@ type APIBlockHandler interface {
 	GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error)
 	GetBlockByHash(hash []byte, withTxs bool) (*apiBlock.APIBlock, error)
 }
+
+// APIHyperblockHandler defines the behavior of a component able to return both api blocks and api hyperblocks
+type APIHyperblockHandler interface {
+	APIBlockHandler
+	GetHyperblockByNonce(nonce uint64) (*apiBlock.APIHyperblock, error)
+	GetHyperblockByHash(hash []byte) (*apiBlock.APIHyperblock, error)
+}
@@ -2,10 +2,12 @@ package blockAPI
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 )
 
@@ -112,3 +114,151 @@ func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, b
 		MiniBlocks:      miniblocks,
 	}, nil
 }
+
+// GetHyperblockByNonce will return the hyperblock of the metablock with the given nonce
+func (mbp *metaAPIBlockProcessor) GetHyperblockByNonce(nonce uint64) (*apiBlock.APIHyperblock, error) {
+	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := mbp.store.Get(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	return mbp.GetHyperblockByHash(headerHash)
+}
+
+// GetHyperblockByHash will return the hyperblock of the metablock with the given hash. The transactions of the
+// notarized shard blocks are included only if their miniblocks are available in the local storage, which is usually
+// the case only for the cross shard miniblocks involving the metachain. The returned hyperblock is flagged if any
+// of the transactions could not be fetched
+func (mbp *metaAPIBlockProcessor) GetHyperblockByHash(hash []byte) (*apiBlock.APIHyperblock, error) {
+	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock, err := mbp.convertMetaBlockBytesToAPIBlock(hash, blockBytes, true)
+	if err != nil {
+		return nil, err
+	}
+
+	shardBlocks := make([]*apiBlock.APIBlock, 0, len(metaBlock.NotarizedBlocks))
+	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
+		shardBlock, errGet := mbp.getNotarizedShardBlock(notarizedBlock.Hash, metaBlock.Epoch)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for notarized shard block %s", errGet, notarizedBlock.Hash)
+		}
+
+		shardBlocks = append(shardBlocks, shardBlock)
+	}
+
+	blocks := append([]*apiBlock.APIBlock{metaBlock}, shardBlocks...)
+	transactions := flattenTransactions(blocks)
+
+	return &apiBlock.APIHyperblock{
+		Nonce:              metaBlock.Nonce,
+		Round:              metaBlock.Round,
+		Hash:               metaBlock.Hash,
+		PrevBlockHash:      metaBlock.PrevBlockHash,
+		Epoch:              metaBlock.Epoch,
+		NumTxs:             uint32(len(transactions)),
+		HasAllTransactions: hasAllTransactions(blocks),
+		ShardBlocks:        shardBlocks,
+		Transactions:       transactions,
+	}, nil
+}
+
+func (mbp *metaAPIBlockProcessor) getNotarizedShardBlock(hexHash string, metaBlockEpoch uint32) (*apiBlock.APIBlock, error) {
+	hash, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// the notarized shard headers are saved in the storer of the epoch in which the metablock was committed
+	blockBytes, err := mbp.getFromStorerWithEpoch(dataRetriever.BlockHeaderUnit, hash, metaBlockEpoch)
+	if err != nil {
+		blockBytes, err = mbp.store.Get(dataRetriever.BlockHeaderUnit, hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	blockHeader := &block.Header{}
+	err = mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	numOfTxs := uint32(0)
+	miniblocks := make([]*apiBlock.APIMiniBlock, 0)
+	miniBlockStorer := mbp.store.GetStorer(dataRetriever.MiniBlockUnit)
+	for _, mb := range blockHeader.MiniBlockHeaders {
+		if mb.Type == block.PeerBlock {
+			continue
+		}
+
+		numOfTxs += mb.TxCount
+
+		miniblockAPI := &apiBlock.APIMiniBlock{
+			Hash:             hex.EncodeToString(mb.Hash),
+			Type:             mb.Type.String(),
+			SourceShard:      mb.SenderShardID,
+			DestinationShard: mb.ReceiverShardID,
+		}
+		if miniBlockStorer.HasInEpoch(mb.Hash, blockHeader.Epoch) == nil {
+			miniBlockCopy := mb
+			miniblockAPI.Transactions = mbp.getTxsByMb(&miniBlockCopy, blockHeader.Epoch)
+		}
+
+		miniblocks = append(miniblocks, miniblockAPI)
+	}
+
+	return &apiBlock.APIBlock{
+		Nonce:         blockHeader.Nonce,
+		Round:         blockHeader.Round,
+		Epoch:         blockHeader.Epoch,
+		Shard:         blockHeader.ShardID,
+		Hash:          hexHash,
+		PrevBlockHash: hex.EncodeToString(blockHeader.PrevHash),
+		NumTxs:        numOfTxs,
+		MiniBlocks:    miniblocks,
+	}, nil
+}
+
+// flattenTransactions gathers the transactions of all the miniblocks of the provided blocks in a single list. A cross
+// shard transaction is present in both the source and the destination blocks, so only its last occurrence is kept,
+// at the position of its first occurrence
+func flattenTransactions(blocks []*apiBlock.APIBlock) []*transaction.ApiTransactionResult {
+	transactions := make([]*transaction.ApiTransactionResult, 0)
+	indexByHash := make(map[string]int)
+	for _, blk := range blocks {
+		for _, mb := range blk.MiniBlocks {
+			for _, tx := range mb.Transactions {
+				index, found := indexByHash[tx.Hash]
+				if found {
+					transactions[index] = tx
+					continue
+				}
+
+				indexByHash[tx.Hash] = len(transactions)
+				transactions = append(transactions, tx)
+			}
+		}
+	}
+
+	return transactions
+}
+
+func hasAllTransactions(blocks []*apiBlock.APIBlock) bool {
+	for _, blk := range blocks {
+		numFetchedTxs := 0
+		for _, mb := range blk.MiniBlocks {
+			numFetchedTxs += len(mb.Transactions)
+		}
+
+		if uint32(numFetchedTxs) < blk.NumTxs {
+			return false
+		}
+	}
+
+	return true
+}
@@ -207,3 +207,24 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilDataTrie signals that user account has a nil data trie
 var ErrNilDataTrie = errors.New("nil data trie")
+
+// ErrNilBlockHeader signals that no block header is available
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrNilStateTrie signals that a nil state trie has been obtained
+var ErrNilStateTrie = errors.New("nil state trie")
+
+// ErrStateNotAvailable signals that the requested state is no longer available in storage
+var ErrStateNotAvailable = errors.New("state pruned or not available")
+
+// ErrBlockNotFound signals that the requested block could not be found
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrBlockNonceAndHashProvided signals that both a block nonce and a block hash have been provided
+var ErrBlockNonceAndHashProvided = errors.New("only one of block nonce and block hash can be provided")
+
+// ErrInvalidBlockRange signals that an invalid range of blocks has been provided
+var ErrInvalidBlockRange = errors.New("invalid block range")
+
+// ErrMetachainOnlyEndpoint signals that the requested operation is only available on the metachain
+var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on the metachain")
@@ -10,3 +10,12 @@ var ErrNilStatusMetrics = errors.New("nil status metrics handler")
 
 // ErrNilTransactionCostHandler signals that a nil transaction cost handler was provided
 var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
+
+// ErrNilTransactionLogsHandler signals that a nil transaction logs handler was provided
+var ErrNilTransactionLogsHandler = errors.New("nil transaction logs handler")
+
+// ErrNilLogsIdentifierIndex signals that a nil logs identifier index was provided
+var ErrNilLogsIdentifierIndex = errors.New("nil logs identifier index")
+
+// ErrNilSystemSCQueriesHandler signals that a nil system smart contracts queries handler was provided
+var ErrNilSystemSCQueriesHandler = errors.New("nil system smart contracts queries handler")
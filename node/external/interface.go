@@ -1,7 +1,11 @@
 package external
 
 import (
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -29,3 +33,27 @@ type TransactionCostHandler interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	IsInterfaceNil() bool
 }
+
+// TransactionLogsHandler defines the actions which should be handled by a transaction logs provider
+type TransactionLogsHandler interface {
+	GetLog(txHash []byte) (data.LogHandler, error)
+	IsInterfaceNil() bool
+}
+
+// LogsIdentifierIndexHandler defines the actions which should be handled by an index of the transaction logs by their
+// events identifiers
+type LogsIdentifierIndexHandler interface {
+	GetTxHashesByLogIdentifier(identifier []byte) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// SystemSCQueriesHandler defines the actions which should be handled by a component able to query the system smart
+// contracts and decode their responses
+type SystemSCQueriesHandler interface {
+	GetDelegationContractsMetaData() ([]*delegation.ContractMetaData, error)
+	GetGovernanceProposal(reference string) (*governance.Proposal, error)
+	GetGovernanceVotingPower(address string) (uint64, error)
+	GetStakingQueue() ([]*validator.QueuedNode, error)
+	GetOwnerNodesStatus(address string) (*validator.OwnerNodes, error)
+	IsInterfaceNil() bool
+}
@@ -1,8 +1,12 @@
 package external
 
 import (
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -12,6 +16,9 @@ type NodeApiResolver struct {
 	scQueryService       SCQueryService
 	statusMetricsHandler StatusMetricsHandler
 	txCostHandler        TransactionCostHandler
+	txLogsHandler        TransactionLogsHandler
+	logsIdentifierIndex  LogsIdentifierIndexHandler
+	systemSCQueries      SystemSCQueriesHandler
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
@@ -19,6 +26,9 @@ func NewNodeApiResolver(
 	scQueryService SCQueryService,
 	statusMetricsHandler StatusMetricsHandler,
 	txCostHandler TransactionCostHandler,
+	txLogsHandler TransactionLogsHandler,
+	logsIdentifierIndex LogsIdentifierIndexHandler,
+	systemSCQueries SystemSCQueriesHandler,
 ) (*NodeApiResolver, error) {
 	if check.IfNil(scQueryService) {
 		return nil, ErrNilSCQueryService
@@ -29,11 +39,23 @@ func NewNodeApiResolver(
 	if check.IfNil(txCostHandler) {
 		return nil, ErrNilTransactionCostHandler
 	}
+	if check.IfNil(txLogsHandler) {
+		return nil, ErrNilTransactionLogsHandler
+	}
+	if check.IfNil(logsIdentifierIndex) {
+		return nil, ErrNilLogsIdentifierIndex
+	}
+	if check.IfNil(systemSCQueries) {
+		return nil, ErrNilSystemSCQueriesHandler
+	}
 
 	return &NodeApiResolver{
 		scQueryService:       scQueryService,
 		statusMetricsHandler: statusMetricsHandler,
 		txCostHandler:        txCostHandler,
+		txLogsHandler:        txLogsHandler,
+		logsIdentifierIndex:  logsIdentifierIndex,
+		systemSCQueries:      systemSCQueries,
 	}, nil
 }
 
@@ -52,6 +74,41 @@ func (nar *NodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nar.txCostHandler.ComputeTransactionGasLimit(tx)
 }
 
+// GetTxHashesByLogIdentifier returns the hashes of the most recent transactions which generated log events with the given identifier
+func (nar *NodeApiResolver) GetTxHashesByLogIdentifier(identifier []byte) ([][]byte, error) {
+	return nar.logsIdentifierIndex.GetTxHashesByLogIdentifier(identifier)
+}
+
+// GetTransactionLog returns the log generated by the transaction with the given hash
+func (nar *NodeApiResolver) GetTransactionLog(txHash []byte) (data.LogHandler, error) {
+	return nar.txLogsHandler.GetLog(txHash)
+}
+
+// GetDelegationContractsMetaData returns the addresses of all delegation contracts together with their metadata
+func (nar *NodeApiResolver) GetDelegationContractsMetaData() ([]*delegation.ContractMetaData, error) {
+	return nar.systemSCQueries.GetDelegationContractsMetaData()
+}
+
+// GetGovernanceProposal returns the governance proposal with the given reference together with its vote tally
+func (nar *NodeApiResolver) GetGovernanceProposal(reference string) (*governance.Proposal, error) {
+	return nar.systemSCQueries.GetGovernanceProposal(reference)
+}
+
+// GetGovernanceVotingPower returns the number of votes the given address can cast on governance proposals
+func (nar *NodeApiResolver) GetGovernanceVotingPower(address string) (uint64, error) {
+	return nar.systemSCQueries.GetGovernanceVotingPower(address)
+}
+
+// GetStakingQueue returns the ordered list of the nodes waiting in the staking queue
+func (nar *NodeApiResolver) GetStakingQueue() ([]*validator.QueuedNode, error) {
+	return nar.systemSCQueries.GetStakingQueue()
+}
+
+// GetOwnerNodesStatus returns the staked amounts and the status of all the nodes of the given owner
+func (nar *NodeApiResolver) GetOwnerNodesStatus(address string) (*validator.OwnerNodes, error) {
+	return nar.systemSCQueries.GetOwnerNodesStatus(address)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
@@ -3,18 +3,24 @@ package external_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TxLogsProcessorStub{}, &testscommon.HistoryRepositoryStub{}, &mock.SystemSCQueriesHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilSCQueryService, err)
@@ -23,7 +29,7 @@ func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, &mock.TxLogsProcessorStub{}, &testscommon.HistoryRepositoryStub{}, &mock.SystemSCQueriesHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
@@ -32,16 +38,43 @@ func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil)
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, &mock.TxLogsProcessorStub{}, &testscommon.HistoryRepositoryStub{}, &mock.SystemSCQueriesHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
 }
 
+func TestNewNodeApiResolver_NilTransactionLogsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil, &testscommon.HistoryRepositoryStub{}, &mock.SystemSCQueriesHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTransactionLogsHandler, err)
+}
+
+func TestNewNodeApiResolver_NilLogsIdentifierIndexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TxLogsProcessorStub{}, nil, &mock.SystemSCQueriesHandlerStub{})
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilLogsIdentifierIndex, err)
+}
+
+func TestNewNodeApiResolver_NilSystemSCQueriesHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TxLogsProcessorStub{}, &testscommon.HistoryRepositoryStub{}, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilSystemSCQueriesHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TxLogsProcessorStub{}, &testscommon.HistoryRepositoryStub{}, &mock.SystemSCQueriesHandlerStub{})
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(nar))
@@ -57,7 +90,7 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 			return &vmcommon.VMOutput{}, nil
 		},
 	},
-		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.TxLogsProcessorStub{}, &testscommon.HistoryRepositoryStub{}, &mock.SystemSCQueriesHandlerStub{})
 
 	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
 		ScAddress: []byte{0},
@@ -80,6 +113,9 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TxLogsProcessorStub{},
+		&testscommon.HistoryRepositoryStub{},
+		&mock.SystemSCQueriesHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -99,6 +135,9 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TxLogsProcessorStub{},
+		&testscommon.HistoryRepositoryStub{},
+		&mock.SystemSCQueriesHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -118,6 +157,9 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TxLogsProcessorStub{},
+		&testscommon.HistoryRepositoryStub{},
+		&mock.SystemSCQueriesHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -137,6 +179,9 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TxLogsProcessorStub{},
+		&testscommon.HistoryRepositoryStub{},
+		&mock.SystemSCQueriesHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -156,8 +201,113 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.TxLogsProcessorStub{},
+		&testscommon.HistoryRepositoryStub{},
+		&mock.SystemSCQueriesHandlerStub{},
 	)
 	_ = nar.StatusMetrics().NetworkMetrics()
 
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GetTxHashesByLogIdentifierShouldCall(t *testing.T) {
+	t.Parallel()
+
+	identifier := []byte("issue")
+	expectedTxHashes := [][]byte{[]byte("txHash")}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.SCQueryServiceStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionCostEstimatorMock{},
+		&mock.TxLogsProcessorStub{},
+		&testscommon.HistoryRepositoryStub{
+			GetTxHashesByLogIdentifierCalled: func(id []byte) ([][]byte, error) {
+				assert.Equal(t, identifier, id)
+				return expectedTxHashes, nil
+			},
+		},
+		&mock.SystemSCQueriesHandlerStub{},
+	)
+
+	txHashes, err := nar.GetTxHashesByLogIdentifier(identifier)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedTxHashes, txHashes)
+}
+
+func TestNodeApiResolver_GetTransactionLogShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedLog := &transaction.Log{Address: []byte("address")}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.SCQueryServiceStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionCostEstimatorMock{},
+		&mock.TxLogsProcessorStub{
+			GetLogCalled: func(txHash []byte) (data.LogHandler, error) {
+				return expectedLog, nil
+			},
+		},
+		&testscommon.HistoryRepositoryStub{},
+		&mock.SystemSCQueriesHandlerStub{},
+	)
+
+	txLog, err := nar.GetTransactionLog([]byte("txHash"))
+	assert.Nil(t, err)
+	assert.Equal(t, expectedLog, txLog)
+}
+
+func TestNodeApiResolver_SystemSCQueriesShouldCall(t *testing.T) {
+	t.Parallel()
+
+	expectedContracts := []*delegation.ContractMetaData{{Name: "name"}}
+	expectedProposal := &governance.Proposal{Reference: "reference"}
+	expectedQueue := []*validator.QueuedNode{{Position: 1}}
+	expectedOwnerNodes := &validator.OwnerNodes{Owner: "owner"}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.SCQueryServiceStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionCostEstimatorMock{},
+		&mock.TxLogsProcessorStub{},
+		&testscommon.HistoryRepositoryStub{},
+		&mock.SystemSCQueriesHandlerStub{
+			GetDelegationContractsMetaDataCalled: func() ([]*delegation.ContractMetaData, error) {
+				return expectedContracts, nil
+			},
+			GetGovernanceProposalCalled: func(reference string) (*governance.Proposal, error) {
+				assert.Equal(t, "reference", reference)
+				return expectedProposal, nil
+			},
+			GetGovernanceVotingPowerCalled: func(address string) (uint64, error) {
+				assert.Equal(t, "address", address)
+				return 5, nil
+			},
+			GetStakingQueueCalled: func() ([]*validator.QueuedNode, error) {
+				return expectedQueue, nil
+			},
+			GetOwnerNodesStatusCalled: func(address string) (*validator.OwnerNodes, error) {
+				assert.Equal(t, "owner", address)
+				return expectedOwnerNodes, nil
+			},
+		},
+	)
+
+	contracts, err := nar.GetDelegationContractsMetaData()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedContracts, contracts)
+
+	proposal, err := nar.GetGovernanceProposal("reference")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedProposal, proposal)
+
+	votingPower, err := nar.GetGovernanceVotingPower("address")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), votingPower)
+
+	queue, err := nar.GetStakingQueue()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedQueue, queue)
+
+	ownerNodes, err := nar.GetOwnerNodesStatus("owner")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedOwnerNodes, ownerNodes)
+}
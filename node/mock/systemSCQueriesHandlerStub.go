@@ -0,0 +1,66 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
+)
+
+// SystemSCQueriesHandlerStub -
+type SystemSCQueriesHandlerStub struct {
+	GetDelegationContractsMetaDataCalled func() ([]*delegation.ContractMetaData, error)
+	GetGovernanceProposalCalled          func(reference string) (*governance.Proposal, error)
+	GetGovernanceVotingPowerCalled       func(address string) (uint64, error)
+	GetStakingQueueCalled                func() ([]*validator.QueuedNode, error)
+	GetOwnerNodesStatusCalled            func(address string) (*validator.OwnerNodes, error)
+}
+
+// GetDelegationContractsMetaData -
+func (stub *SystemSCQueriesHandlerStub) GetDelegationContractsMetaData() ([]*delegation.ContractMetaData, error) {
+	if stub.GetDelegationContractsMetaDataCalled != nil {
+		return stub.GetDelegationContractsMetaDataCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (stub *SystemSCQueriesHandlerStub) GetGovernanceProposal(reference string) (*governance.Proposal, error) {
+	if stub.GetGovernanceProposalCalled != nil {
+		return stub.GetGovernanceProposalCalled(reference)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotingPower -
+func (stub *SystemSCQueriesHandlerStub) GetGovernanceVotingPower(address string) (uint64, error) {
+	if stub.GetGovernanceVotingPowerCalled != nil {
+		return stub.GetGovernanceVotingPowerCalled(address)
+	}
+
+	return 0, nil
+}
+
+// GetStakingQueue -
+func (stub *SystemSCQueriesHandlerStub) GetStakingQueue() ([]*validator.QueuedNode, error) {
+	if stub.GetStakingQueueCalled != nil {
+		return stub.GetStakingQueueCalled()
+	}
+
+	return nil, nil
+}
+
+// GetOwnerNodesStatus -
+func (stub *SystemSCQueriesHandlerStub) GetOwnerNodesStatus(address string) (*validator.OwnerNodes, error) {
+	if stub.GetOwnerNodesStatusCalled != nil {
+		return stub.GetOwnerNodesStatusCalled(address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *SystemSCQueriesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
@@ -162,6 +163,7 @@ type Node struct {
 	historyRepository dblookupext.HistoryRepository
 
 	enableSignTxWithHashEpoch uint32
+	guardianEnableEpoch       uint32
 	txSignHasher              hashing.Hasher
 	txVersionChecker          process.TxVersionCheckerHandler
 	isInImportMode            bool
@@ -407,8 +409,8 @@ func (n *Node) addCloserInstances(closers ...update.Closer) error {
 }
 
 // GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetBalance(address string, options apiAddress.AccountQueryOptions) (*big.Int, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -422,8 +424,8 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 }
 
 // GetUsername gets the username for a specific address
-func (n *Node) GetUsername(address string) (string, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetUsername(address string, options apiAddress.AccountQueryOptions) (string, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -438,13 +440,13 @@ func (n *Node) GetUsername(address string) (string, error) {
 }
 
 // GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+func (n *Node) GetValueForKey(address string, key string, options apiAddress.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -463,8 +465,8 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 }
 
 // GetESDTBalance returns the esdt balance and properties from a given account
-func (n *Node) GetESDTBalance(address string, tokenName string) (string, string, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetESDTBalance(address string, tokenName string, options apiAddress.AccountQueryOptions) (string, string, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", "", err
 	}
@@ -490,8 +492,8 @@ func (n *Node) GetESDTBalance(address string, tokenName string) (string, string,
 }
 
 // GetAllESDTTokens returns the value of a key from a given account
-func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetAllESDTTokens(address string, options apiAddress.AccountQueryOptions) ([]string, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -532,7 +534,7 @@ func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
 	return foundTokens, nil
 }
 
-func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
+func (n *Node) getAccountHandler(address string, options apiAddress.AccountQueryOptions) (state.AccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -541,7 +543,13 @@ func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
-	return n.accounts.GetExistingAccount(addr)
+
+	accountsAdapter, err := n.getAccountsAdapterForQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsAdapter.GetExistingAccount(addr)
 }
 
 func (n *Node) castAccountToUserAccount(ah state.AccountHandler) (state.UserAccountHandler, bool) {
@@ -959,6 +967,7 @@ func (n *Node) commonTransactionValidation(tx *transaction.Transaction) (process
 
 	currentEpoch := n.epochStartTrigger.Epoch()
 	enableSignWithTxHash := currentEpoch >= n.enableSignTxWithHashEpoch
+	enableGuardedTx := currentEpoch >= n.guardianEnableEpoch
 
 	argumentParser := smartContract.NewArgumentParser()
 	intTx, err := procTx.NewInterceptedTransaction(
@@ -975,6 +984,7 @@ func (n *Node) commonTransactionValidation(tx *transaction.Transaction) (process
 		argumentParser,
 		n.chainID,
 		enableSignWithTxHash,
+		enableGuardedTx,
 		n.txSignHasher,
 		n.txVersionChecker,
 	)
@@ -1050,6 +1060,8 @@ func (n *Node) CreateTransaction(
 	chainID string,
 	version uint32,
 	options uint32,
+	guardianHex string,
+	guardianSignatureHex string,
 ) (*transaction.Transaction, []byte, error) {
 	if version == 0 {
 		return nil, nil, ErrInvalidTransactionVersion
@@ -1084,6 +1096,19 @@ func (n *Node) CreateTransaction(
 		return nil, nil, ErrInvalidValue
 	}
 
+	var guardianAddress []byte
+	if len(guardianHex) > 0 {
+		guardianAddress, err = n.addressPubkeyConverter.Decode(guardianHex)
+		if err != nil {
+			return nil, nil, errors.New("could not create guardian address from provided param")
+		}
+	}
+
+	guardianSignatureBytes, err := hex.DecodeString(guardianSignatureHex)
+	if err != nil {
+		return nil, nil, errors.New("could not fetch guardian signature bytes")
+	}
+
 	tx := &transaction.Transaction{
 		Nonce:     nonce,
 		Value:     valAsBigInt,
@@ -1096,6 +1121,9 @@ func (n *Node) CreateTransaction(
 		ChainID:   []byte(chainID),
 		Version:   version,
 		Options:   options,
+
+		GuardianAddr:      guardianAddress,
+		GuardianSignature: guardianSignatureBytes,
 	}
 
 	var txHash []byte
@@ -1108,7 +1136,7 @@ func (n *Node) CreateTransaction(
 }
 
 // GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+func (n *Node) GetAccount(address string, options apiAddress.AccountQueryOptions) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
 		return nil, ErrNilPubkeyConverter
 	}
@@ -1121,7 +1149,12 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accountsAdapter, err := n.getAccountsAdapterForQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accWrp, err := accountsAdapter.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
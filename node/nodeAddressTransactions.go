@@ -0,0 +1,63 @@
+package node
+
+import (
+	"encoding/hex"
+
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// GetAddressTransactions returns a page of the transactions, smart contract results and receipts which touched the
+// given address, newest first, starting with the ones of the block preceding beforeNonce. The history is only
+// available if the address index of the history repository is enabled
+func (n *Node) GetAddressTransactions(address string, beforeNonce uint64, size uint64) (*apiAddress.AddressTransactions, error) {
+	if check.IfNil(n.historyRepository) {
+		return nil, dblookupext.ErrAddressIndexNotEnabled
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, total, err := n.historyRepository.GetAddressTransactions(addressBytes, beforeNonce, size)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &apiAddress.AddressTransactions{
+		Transactions: make([]*apiAddress.AddressTransaction, 0, len(entries)),
+		Total:        total,
+	}
+	for _, entry := range entries {
+		result.Transactions = append(result.Transactions, &apiAddress.AddressTransaction{
+			Hash:       hex.EncodeToString(entry.Hash),
+			Type:       string(addressTransactionType(block.Type(entry.Type))),
+			BlockNonce: entry.HeaderNonce,
+			BlockHash:  hex.EncodeToString(entry.HeaderHash),
+			Round:      entry.Round,
+			Epoch:      entry.Epoch,
+			Timestamp:  entry.Timestamp,
+		})
+	}
+
+	return result, nil
+}
+
+func addressTransactionType(miniblockType block.Type) transaction.TxType {
+	switch miniblockType {
+	case block.SmartContractResultBlock:
+		return transaction.TxTypeUnsigned
+	case block.RewardsBlock:
+		return transaction.TxTypeReward
+	case block.InvalidBlock:
+		return transaction.TxTypeInvalid
+	case block.ReceiptBlock:
+		return transaction.TxTypeReceipt
+	default:
+		return transaction.TxTypeNormal
+	}
+}
@@ -0,0 +1,77 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetAddressTransactionsInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+	)
+
+	result, err := n.GetAddressTransactions("not a hex address", 0, 10)
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetAddressTransactionsRepositoryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			GetAddressTransactionsCalled: func(_ []byte, _ uint64, _ uint64) ([]*dblookupext.AddressTransaction, uint64, error) {
+				return nil, 0, dblookupext.ErrAddressIndexNotEnabled
+			},
+		}),
+	)
+
+	result, err := n.GetAddressTransactions(createDummyHexAddress(64), 0, 10)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, dblookupext.ErrAddressIndexNotEnabled))
+}
+
+func TestNode_GetAddressTransactionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(address)
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			GetAddressTransactionsCalled: func(addr []byte, beforeNonce uint64, size uint64) ([]*dblookupext.AddressTransaction, uint64, error) {
+				assert.Equal(t, addressBytes, addr)
+				assert.Equal(t, uint64(3), beforeNonce)
+				assert.Equal(t, uint64(2), size)
+
+				return []*dblookupext.AddressTransaction{
+					{Hash: []byte("scr"), Type: int32(block.SmartContractResultBlock), HeaderHash: []byte("hdr2"), HeaderNonce: 2},
+					{Hash: []byte("tx"), Type: int32(block.TxBlock), HeaderHash: []byte("hdr1"), HeaderNonce: 1, Epoch: 3},
+				}, 7, nil
+			},
+		}),
+	)
+
+	result, err := n.GetAddressTransactions(address, 3, 2)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), result.Total)
+	require.Equal(t, 2, len(result.Transactions))
+	assert.Equal(t, hex.EncodeToString([]byte("scr")), result.Transactions[0].Hash)
+	assert.Equal(t, "unsigned", result.Transactions[0].Type)
+	assert.Equal(t, hex.EncodeToString([]byte("hdr2")), result.Transactions[0].BlockHash)
+	assert.Equal(t, "normal", result.Transactions[1].Type)
+	assert.Equal(t, uint64(1), result.Transactions[1].BlockNonce)
+	assert.Equal(t, uint32(3), result.Transactions[1].Epoch)
+}
@@ -2,6 +2,8 @@ package node
 
 import (
 	"encoding/hex"
+	"fmt"
+	"math"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -26,28 +28,86 @@ func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock,
 	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetLatestBlock returns the latest committed block
+func (n *Node) GetLatestBlock(withTxs bool) (*apiBlock.APIBlock, error) {
+	headerHash := n.blkc.GetCurrentBlockHeaderHash()
+	if len(headerHash) == 0 {
+		return nil, ErrNilBlockHeader
+	}
+
+	apiBlockProcessor := n.createAPIBlockProcessor()
+
+	return apiBlockProcessor.GetBlockByHash(headerHash, withTxs)
+}
+
+// GetBlocksByRange returns the blocks with the nonces in the [from, to] interval, in ascending order of their nonces
+func (n *Node) GetBlocksByRange(from uint64, to uint64, withTxs bool) ([]*apiBlock.APIBlock, error) {
+	if from > to {
+		return nil, ErrInvalidBlockRange
+	}
+
+	apiBlockProcessor := n.createAPIBlockProcessor()
+	blocks := make([]*apiBlock.APIBlock, 0, to-from+1)
+	for nonce := from; nonce <= to; nonce++ {
+		blk, err := apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
+		if err != nil {
+			return nil, fmt.Errorf("%w for nonce %d", err, nonce)
+		}
+
+		blocks = append(blocks, blk)
+
+		if nonce == math.MaxUint64 {
+			break
+		}
+	}
+
+	return blocks, nil
+}
+
+// GetHyperblockByNonce returns the metablock with the given nonce together with all the shard blocks it notarizes.
+// It is only available on the metachain
+func (n *Node) GetHyperblockByNonce(nonce uint64) (*apiBlock.APIHyperblock, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	return n.createMetaAPIBlockProcessor().GetHyperblockByNonce(nonce)
+}
+
+// GetHyperblockByHash returns the metablock with the given hash together with all the shard blocks it notarizes.
+// It is only available on the metachain
+func (n *Node) GetHyperblockByHash(hash string) (*apiBlock.APIHyperblock, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyEndpoint
+	}
+
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.createMetaAPIBlockProcessor().GetHyperblockByHash(decodedHash)
+}
+
 func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 	if n.shardCoordinator.SelfId() != core.MetachainShardId {
-		return blockAPI.NewShardApiBlockProcessor(
-			&blockAPI.APIBlockProcessorArg{
-				SelfShardID:              n.shardCoordinator.SelfId(),
-				Store:                    n.store,
-				Marshalizer:              n.internalMarshalizer,
-				Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
-				HistoryRepo:              n.historyRepository,
-				UnmarshalTx:              n.unmarshalTransaction,
-			},
-		)
-	}
-
-	return blockAPI.NewMetaApiBlockProcessor(
-		&blockAPI.APIBlockProcessorArg{
-			SelfShardID:              n.shardCoordinator.SelfId(),
-			Store:                    n.store,
-			Marshalizer:              n.internalMarshalizer,
-			Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
-			HistoryRepo:              n.historyRepository,
-			UnmarshalTx:              n.unmarshalTransaction,
-		},
-	)
+		return blockAPI.NewShardApiBlockProcessor(n.createAPIBlockProcessorArg())
+	}
+
+	return n.createMetaAPIBlockProcessor()
+}
+
+func (n *Node) createMetaAPIBlockProcessor() blockAPI.APIHyperblockHandler {
+	return blockAPI.NewMetaApiBlockProcessor(n.createAPIBlockProcessorArg())
+}
+
+func (n *Node) createAPIBlockProcessorArg() *blockAPI.APIBlockProcessorArg {
+	return &blockAPI.APIBlockProcessorArg{
+		SelfShardID:              n.shardCoordinator.SelfId(),
+		Store:                    n.store,
+		Marshalizer:              n.internalMarshalizer,
+		Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
+		HistoryRepo:              n.historyRepository,
+		UnmarshalTx:              n.unmarshalTransaction,
+	}
 }
@@ -3,6 +3,8 @@ package node_test
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
@@ -263,3 +265,271 @@ func TestGetBlockByNonceFromNormalNode(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestGetLatestBlock_NoCurrentBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithBlockChain(&mock.BlockChainMock{}),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	blk, err := n.GetLatestBlock(false)
+	assert.Equal(t, node.ErrNilBlockHeader, err)
+	assert.Nil(t, blk)
+}
+
+func TestGetLatestBlock_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("latest header hash")
+	header := &block.Header{Nonce: 7, Round: 8}
+	headerBytes, _ := json.Marshal(header)
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				return headerHash
+			},
+		}),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				assert.Equal(t, dataRetriever.BlockHeaderUnit, unitType)
+				assert.Equal(t, headerHash, key)
+				return headerBytes, nil
+			},
+		}),
+	)
+
+	blk, err := n.GetLatestBlock(false)
+	assert.Nil(t, err)
+	assert.Equal(t, header.Nonce, blk.Nonce)
+	assert.Equal(t, hex.EncodeToString(headerHash), blk.Hash)
+}
+
+func createNodeWithShardBlocks(nonces ...uint64) *node.Node {
+	nonceConverter := mock.NewNonceHashConverterMock()
+	storedBlocks := make(map[string][]byte)
+	for _, nonce := range nonces {
+		headerHash := []byte(fmt.Sprintf("hash%d", nonce))
+		headerBytes, _ := json.Marshal(&block.Header{Nonce: nonce})
+		storedBlocks[string(nonceConverter.ToByteSlice(nonce))] = headerHash
+		storedBlocks[string(headerHash)] = headerBytes
+	}
+
+	n, _ := node.NewNode(
+		node.WithUint64ByteSliceConverter(nonceConverter),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				value, found := storedBlocks[string(key)]
+				if !found {
+					return nil, errors.New("key not found")
+				}
+
+				return value, nil
+			},
+		}),
+	)
+
+	return n
+}
+
+func TestGetBlocksByRange_InvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithShardBlocks(1, 2)
+
+	blocks, err := n.GetBlocksByRange(2, 1, false)
+	assert.Equal(t, node.ErrInvalidBlockRange, err)
+	assert.Nil(t, blocks)
+}
+
+func TestGetBlocksByRange_MissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithShardBlocks(1, 2)
+
+	blocks, err := n.GetBlocksByRange(1, 3, false)
+	assert.NotNil(t, err)
+	assert.Nil(t, blocks)
+}
+
+func TestGetBlocksByRange_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithShardBlocks(1, 2, 3, 4)
+
+	blocks, err := n.GetBlocksByRange(2, 4, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(blocks))
+	for i, blk := range blocks {
+		assert.Equal(t, uint64(i+2), blk.Nonce)
+		assert.Equal(t, hex.EncodeToString([]byte(fmt.Sprintf("hash%d", blk.Nonce))), blk.Hash)
+	}
+}
+
+func TestGetHyperblockByNonce_OnShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	hyperblock, err := n.GetHyperblockByNonce(1)
+	assert.Equal(t, node.ErrMetachainOnlyEndpoint, err)
+	assert.Nil(t, hyperblock)
+}
+
+func TestGetHyperblockByHash_ShouldReturnNotarizedShardBlocks(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	shardBlockHash := []byte("shard block hash")
+	metaBlock := &block.MetaBlock{
+		Nonce: 10,
+		Round: 11,
+		Epoch: 2,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: shardBlockHash, Nonce: 20, ShardID: 1},
+		},
+	}
+	shardBlock := &block.Header{
+		Nonce:   20,
+		ShardID: 1,
+		Epoch:   2,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("mbHash"), TxCount: 3, SenderShardID: 1, ReceiverShardID: 1},
+		},
+	}
+	storerMock := mock.NewStorerMock()
+	metaBlockBytes, _ := json.Marshal(metaBlock)
+	shardBlockBytes, _ := json.Marshal(shardBlock)
+	_ = storerMock.Put(metaBlockHash, metaBlockBytes)
+	_ = storerMock.Put(shardBlockHash, shardBlockBytes)
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return storerMock.Get(key)
+			},
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return storerMock
+			},
+		}),
+	)
+
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString(metaBlockHash))
+	assert.Nil(t, err)
+	assert.Equal(t, metaBlock.Nonce, hyperblock.Nonce)
+	assert.Equal(t, metaBlock.Round, hyperblock.Round)
+	assert.Equal(t, hex.EncodeToString(metaBlockHash), hyperblock.Hash)
+	assert.Equal(t, 1, len(hyperblock.ShardBlocks))
+	assert.Equal(t, shardBlock.Nonce, hyperblock.ShardBlocks[0].Nonce)
+	assert.Equal(t, uint32(1), hyperblock.ShardBlocks[0].Shard)
+	assert.Equal(t, uint32(3), hyperblock.ShardBlocks[0].NumTxs)
+	assert.Equal(t, hex.EncodeToString(shardBlockHash), hyperblock.ShardBlocks[0].Hash)
+	assert.Equal(t, 0, len(hyperblock.Transactions))
+	assert.False(t, hyperblock.HasAllTransactions)
+}
+
+func TestGetHyperblockByHash_MissingShardBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	metaBlock := &block.MetaBlock{
+		Nonce: 10,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: []byte("missing shard block hash"), Nonce: 20, ShardID: 1},
+		},
+	}
+	storerMock := mock.NewStorerMock()
+	metaBlockBytes, _ := json.Marshal(metaBlock)
+	_ = storerMock.Put(metaBlockHash, metaBlockBytes)
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return storerMock.Get(key)
+			},
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return storerMock
+			},
+		}),
+	)
+
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString(metaBlockHash))
+	assert.NotNil(t, err)
+	assert.Nil(t, hyperblock)
+}
+
+func TestGetHyperblockByHash_WithoutTransactionsShouldHaveAllTransactions(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	shardBlockHash := []byte("shard block hash")
+	metaBlock := &block.MetaBlock{
+		Nonce: 10,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: shardBlockHash, Nonce: 20, ShardID: 1},
+		},
+	}
+	shardBlock := &block.Header{
+		Nonce:   20,
+		ShardID: 1,
+	}
+	storerMock := mock.NewStorerMock()
+	metaBlockBytes, _ := json.Marshal(metaBlock)
+	shardBlockBytes, _ := json.Marshal(shardBlock)
+	_ = storerMock.Put(metaBlockHash, metaBlockBytes)
+	_ = storerMock.Put(shardBlockHash, shardBlockBytes)
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return storerMock.Get(key)
+			},
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return storerMock
+			},
+		}),
+	)
+
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString(metaBlockHash))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(hyperblock.ShardBlocks))
+	assert.True(t, hyperblock.HasAllTransactions)
+}
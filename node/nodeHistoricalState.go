@@ -0,0 +1,155 @@
+package node
+
+import (
+	"fmt"
+
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// getAccountsAdapterForQuery returns the accounts adapter that should be used in order to serve the given query.
+// Historical queries are served by a separate, read-only, accounts adapter instance so that block processing is
+// not affected
+func (n *Node) getAccountsAdapterForQuery(options apiAddress.AccountQueryOptions) (state.AccountsAdapter, error) {
+	if !options.IsHistorical() {
+		return n.accounts, nil
+	}
+
+	header, _, err := n.getHeaderForQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.createHistoricalAccountsAdapter(header.GetRootHash())
+}
+
+func (n *Node) createHistoricalAccountsAdapter(rootHash []byte) (state.AccountsAdapter, error) {
+	emptyTrie, err := n.accounts.GetTrie(nil)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(emptyTrie) {
+		return nil, ErrNilStateTrie
+	}
+
+	accountsAdapter, err := state.NewAccountsDB(emptyTrie, n.hasher, n.internalMarshalizer, factory.NewAccountCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrStateNotAvailable, err)
+	}
+
+	return accountsAdapter, nil
+}
+
+// getHeaderForQuery returns the header, and its hash, identified by the given options. If the options do not
+// identify a block, the current block header is returned
+func (n *Node) getHeaderForQuery(options apiAddress.AccountQueryOptions) (data.HeaderHandler, []byte, error) {
+	if !options.IsHistorical() {
+		return n.getLatestHeader()
+	}
+	if options.HasBlockNonce && len(options.BlockHash) > 0 {
+		return nil, nil, ErrBlockNonceAndHashProvided
+	}
+
+	headerHash := options.BlockHash
+	if options.HasBlockNonce {
+		var err error
+		headerHash, err = n.getHeaderHashByNonce(options.BlockNonce)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	header, err := n.getHeaderByHash(headerHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, headerHash, nil
+}
+
+func (n *Node) getLatestHeader() (data.HeaderHandler, []byte, error) {
+	if check.IfNil(n.blkc) {
+		return nil, nil, ErrNilBlockchain
+	}
+
+	header := n.blkc.GetCurrentBlockHeader()
+	if !check.IfNil(header) {
+		return header, n.blkc.GetCurrentBlockHeaderHash(), nil
+	}
+
+	header = n.blkc.GetGenesisHeader()
+	if !check.IfNil(header) {
+		return header, n.blkc.GetGenesisHeaderHash(), nil
+	}
+
+	return nil, nil, ErrNilBlockHeader
+}
+
+func (n *Node) getHeaderHashByNonce(nonce uint64) ([]byte, error) {
+	if check.IfNil(n.store) {
+		return nil, ErrNilStore
+	}
+
+	storerUnit := dataRetriever.MetaHdrNonceHashDataUnit
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		storerUnit = dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(n.shardCoordinator.SelfId())
+	}
+
+	nonceToByteSlice := n.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := n.store.Get(storerUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, fmt.Errorf("%w for nonce %d: %v", ErrBlockNotFound, nonce, err)
+	}
+
+	return headerHash, nil
+}
+
+func (n *Node) getHeaderByHash(headerHash []byte) (data.HeaderHandler, error) {
+	if check.IfNil(n.store) {
+		return nil, ErrNilStore
+	}
+
+	storerUnit := dataRetriever.BlockHeaderUnit
+	var header data.HeaderHandler = &block.Header{}
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		storerUnit = dataRetriever.MetaBlockUnit
+		header = &block.MetaBlock{}
+	}
+
+	headerBytes, err := n.getFromStorer(storerUnit, headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBlockNotFound, err)
+	}
+
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (n *Node) getFromStorer(unit dataRetriever.UnitType, key []byte) ([]byte, error) {
+	if check.IfNil(n.historyRepository) || !n.historyRepository.IsEnabled() {
+		return n.store.Get(unit, key)
+	}
+
+	epoch, err := n.historyRepository.GetEpochByHash(key)
+	if err != nil {
+		return nil, err
+	}
+
+	storer := n.store.GetStorer(unit)
+	return storer.GetFromEpoch(key, epoch)
+}
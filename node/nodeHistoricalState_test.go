@@ -0,0 +1,141 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForHistoricalQueries(
+	marshalizer marshal.Marshalizer,
+	headerNonce uint64,
+	headerHash []byte,
+	header *block.Header,
+	historicalTrie data.Trie,
+	recreateErr error,
+) *node.Node {
+	nonceConverter := mock.NewNonceHashConverterMock()
+	headerBytes, _ := marshalizer.Marshal(header)
+	store := &mock.ChainStorerMock{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			if unitType == dataRetriever.ShardHdrNonceHashDataUnit && bytes.Equal(key, nonceConverter.ToByteSlice(headerNonce)) {
+				return headerHash, nil
+			}
+			if unitType == dataRetriever.BlockHeaderUnit && bytes.Equal(key, headerHash) {
+				return headerBytes, nil
+			}
+
+			return nil, errors.New("key not found")
+		},
+	}
+
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			return nil, errors.New("the current state should not be used")
+		},
+		GetTrieCalled: func(_ []byte) (data.Trie, error) {
+			return &mock.TrieStub{
+				RecreateCalled: func(root []byte) (data.Trie, error) {
+					if recreateErr != nil {
+						return nil, recreateErr
+					}
+
+					return historicalTrie, nil
+				},
+			}, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithInternalMarshalizer(marshalizer, 0),
+		node.WithHasher(&mock.HasherMock{}),
+		node.WithDataStore(store),
+		node.WithUint64ByteSliceConverter(nonceConverter),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	return n
+}
+
+func TestNode_GetBalanceAtBlockNonceShouldReadHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	address := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(address)
+	account, _ := state.NewUserAccount(addressBytes)
+	_ = account.AddToBalance(big.NewInt(42))
+	accountBytes, _ := marshalizer.Marshal(account)
+
+	headerHash := []byte("header hash")
+	header := &block.Header{Nonce: 10, RootHash: []byte("historical root hash")}
+	historicalTrie := &mock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return accountBytes, nil
+		},
+	}
+
+	n := createNodeForHistoricalQueries(marshalizer, header.Nonce, headerHash, header, historicalTrie, nil)
+
+	balance, err := n.GetBalance(address, apiAddress.AccountQueryOptions{BlockNonce: header.Nonce, HasBlockNonce: true})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(42), balance)
+
+	balance, err = n.GetBalance(address, apiAddress.AccountQueryOptions{BlockHash: headerHash})
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(42), balance)
+}
+
+func TestNode_GetBalanceAtUnknownBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	header := &block.Header{Nonce: 10, RootHash: []byte("historical root hash")}
+	n := createNodeForHistoricalQueries(marshalizer, header.Nonce, []byte("header hash"), header, &mock.TrieStub{}, nil)
+
+	balance, err := n.GetBalance(createDummyHexAddress(64), apiAddress.AccountQueryOptions{BlockNonce: 11, HasBlockNonce: true})
+	assert.Nil(t, balance)
+	assert.True(t, errors.Is(err, node.ErrBlockNotFound))
+}
+
+func TestNode_GetBalanceAtBlockNonceAndHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	headerHash := []byte("header hash")
+	header := &block.Header{Nonce: 10, RootHash: []byte("historical root hash")}
+	n := createNodeForHistoricalQueries(marshalizer, header.Nonce, headerHash, header, &mock.TrieStub{}, nil)
+
+	options := apiAddress.AccountQueryOptions{BlockNonce: header.Nonce, HasBlockNonce: true, BlockHash: headerHash}
+	balance, err := n.GetBalance(createDummyHexAddress(64), options)
+	assert.Nil(t, balance)
+	assert.Equal(t, node.ErrBlockNonceAndHashProvided, err)
+}
+
+func TestNode_GetBalanceAtBlockWithPrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	headerHash := []byte("header hash")
+	header := &block.Header{Nonce: 10, RootHash: []byte("historical root hash")}
+	n := createNodeForHistoricalQueries(marshalizer, header.Nonce, headerHash, header, nil, errors.New("missing trie node"))
+
+	balance, err := n.GetBalance(createDummyHexAddress(64), apiAddress.AccountQueryOptions{BlockHash: headerHash})
+	assert.Nil(t, balance)
+	assert.True(t, errors.Is(err, node.ErrStateNotAvailable))
+}
@@ -0,0 +1,131 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetProof returns the account for the given address together with its Merkle proof. The proof is generated
+// against the state root hash of the block identified by the given options or of the current block
+func (n *Node) GetProof(address string, options apiAddress.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	return n.getAccountProof(address, options)
+}
+
+// GetProofForKey returns the value for the given address and key together with the Merkle proofs of both the
+// account and the key. The data trie proof leads to the raw leaf value, which is the value followed by the key
+// and the address
+func (n *Node) GetProofForKey(address string, key string, options apiAddress.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	accountProof, err := n.getAccountProof(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof.Key = keyBytes
+	accountProof.DataTrieRootHash = accountProof.Account.GetRootHash()
+	accountProof.DataTrieProof = make([][]byte, 0)
+	if len(accountProof.DataTrieRootHash) == 0 {
+		return accountProof, nil
+	}
+
+	dataTrie, err := n.accounts.GetTrie(accountProof.DataTrieRootHash)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(dataTrie) {
+		return nil, ErrNilDataTrie
+	}
+
+	accountProof.DataTrieProof, err = dataTrie.GetProof(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	leafValue, err := dataTrie.Get(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	tailLength := len(keyBytes) + len(accountProof.Account.AddressBytes())
+	if len(leafValue) >= tailLength {
+		accountProof.Value = leafValue[:len(leafValue)-tailLength]
+	}
+
+	return accountProof, nil
+}
+
+func (n *Node) getAccountProof(address string, options apiAddress.AccountQueryOptions) (*apiAddress.AccountProof, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	header, headerHash, err := n.getHeaderForQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	stateTrie, err := n.accounts.GetTrie(header.GetRootHash())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrStateNotAvailable, err)
+	}
+	if check.IfNil(stateTrie) {
+		return nil, ErrNilStateTrie
+	}
+
+	proof, err := stateTrie.GetProof(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := n.getUserAccountFromTrie(stateTrie, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiAddress.AccountProof{
+		Account:    account,
+		BlockNonce: header.GetNonce(),
+		BlockHash:  headerHash,
+		RootHash:   header.GetRootHash(),
+		Proof:      proof,
+	}, nil
+}
+
+func (n *Node) getUserAccountFromTrie(tr data.Trie, address []byte) (state.UserAccountHandler, error) {
+	account, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	accountBytes, err := tr.Get(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(accountBytes) == 0 {
+		return account, nil
+	}
+
+	err = n.internalMarshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
@@ -0,0 +1,188 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	apiAddress "github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBlockchainForProofs(rootHash []byte) data.ChainHandler {
+	blkc := blockchain.NewBlockChain()
+	_ = blkc.SetCurrentBlockHeader(&block.Header{Nonce: 37, RootHash: rootHash})
+	blkc.SetCurrentBlockHeaderHash([]byte("header hash"))
+
+	return blkc
+}
+
+func TestNode_GetProofNilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+	)
+
+	accountProof, err := n.GetProof(createDummyHexAddress(64), apiAddress.AccountQueryOptions{})
+	assert.Nil(t, accountProof)
+	assert.Equal(t, node.ErrNilPubkeyConverter, err)
+}
+
+func TestNode_GetProofNilAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+	)
+
+	accountProof, err := n.GetProof(createDummyHexAddress(64), apiAddress.AccountQueryOptions{})
+	assert.Nil(t, accountProof)
+	assert.Equal(t, node.ErrNilAccountsAdapter, err)
+}
+
+func TestNode_GetProofGetTrieFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	accDB := &mock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (data.Trie, error) {
+			return nil, expectedErr
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithBlockChain(createBlockchainForProofs([]byte("root hash"))),
+	)
+
+	accountProof, err := n.GetProof(createDummyHexAddress(64), apiAddress.AccountQueryOptions{})
+	assert.Nil(t, accountProof)
+	assert.True(t, errors.Is(err, node.ErrStateNotAvailable))
+	assert.True(t, strings.Contains(err.Error(), expectedErr.Error()))
+}
+
+func TestNode_GetProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	address := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(address)
+	account, _ := state.NewUserAccount(addressBytes)
+	_ = account.AddToBalance(big.NewInt(100))
+	accountBytes, _ := marshalizer.Marshal(account)
+
+	rootHash := []byte("root hash")
+	expectedProof := [][]byte{[]byte("node1"), []byte("node2")}
+	stateTrie := &mock.TrieStub{
+		GetProofCalled: func(key []byte) ([][]byte, error) {
+			assert.Equal(t, addressBytes, key)
+			return expectedProof, nil
+		},
+		GetCalled: func(key []byte) ([]byte, error) {
+			return accountBytes, nil
+		},
+	}
+	accDB := &mock.AccountsStub{
+		GetTrieCalled: func(hash []byte) (data.Trie, error) {
+			assert.Equal(t, rootHash, hash)
+			return stateTrie, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithBlockChain(createBlockchainForProofs(rootHash)),
+		node.WithInternalMarshalizer(marshalizer, 0),
+	)
+
+	accountProof, err := n.GetProof(address, apiAddress.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, expectedProof, accountProof.Proof)
+	assert.Equal(t, rootHash, accountProof.RootHash)
+	assert.Equal(t, uint64(37), accountProof.BlockNonce)
+	assert.Equal(t, []byte("header hash"), accountProof.BlockHash)
+	assert.Equal(t, big.NewInt(100), accountProof.Account.GetBalance())
+}
+
+func TestNode_GetProofForKeyInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	accountProof, err := n.GetProofForKey(createDummyHexAddress(64), "invalid hex key", apiAddress.AccountQueryOptions{})
+	assert.Nil(t, accountProof)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetProofForKeyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	address := createDummyHexAddress(64)
+	addressBytes, _ := hex.DecodeString(address)
+	dataTrieRootHash := []byte("data trie root hash")
+	account, _ := state.NewUserAccount(addressBytes)
+	account.SetRootHash(dataTrieRootHash)
+	accountBytes, _ := marshalizer.Marshal(account)
+
+	key := []byte("key")
+	value := []byte("value")
+	leafValue := append(append(append([]byte{}, value...), key...), addressBytes...)
+
+	rootHash := []byte("root hash")
+	expectedProof := [][]byte{[]byte("node1"), []byte("node2")}
+	expectedDataTrieProof := [][]byte{[]byte("node3")}
+	stateTrie := &mock.TrieStub{
+		GetProofCalled: func(_ []byte) ([][]byte, error) {
+			return expectedProof, nil
+		},
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return accountBytes, nil
+		},
+	}
+	dataTrie := &mock.TrieStub{
+		GetProofCalled: func(k []byte) ([][]byte, error) {
+			assert.Equal(t, key, k)
+			return expectedDataTrieProof, nil
+		},
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return leafValue, nil
+		},
+	}
+	accDB := &mock.AccountsStub{
+		GetTrieCalled: func(hash []byte) (data.Trie, error) {
+			if string(hash) == string(dataTrieRootHash) {
+				return dataTrie, nil
+			}
+			return stateTrie, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accDB),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithBlockChain(createBlockchainForProofs(rootHash)),
+		node.WithInternalMarshalizer(marshalizer, 0),
+	)
+
+	keyProof, err := n.GetProofForKey(address, hex.EncodeToString(key), apiAddress.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, expectedProof, keyProof.Proof)
+	assert.Equal(t, expectedDataTrieProof, keyProof.DataTrieProof)
+	assert.Equal(t, dataTrieRootHash, keyProof.DataTrieRootHash)
+	assert.Equal(t, key, keyProof.Key)
+	assert.Equal(t, value, keyProof.Value)
+}
@@ -0,0 +1,81 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	apiState "github.com/ElrondNetwork/elrond-go/api/state"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// maxStateDiffAccounts is the maximum number of changed accounts returned by a single state diff request
+const maxStateDiffAccounts = 10000
+
+// GetStateDiff returns the accounts, together with their data trie keys, which were inserted, updated or deleted
+// between the two hex encoded state root hashes. The result is truncated if it contains too many accounts
+func (n *Node) GetStateDiff(fromRootHash string, toRootHash string) (*apiState.StateDiff, error) {
+	if check.IfNil(n.accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	fromRootHashBytes, err := hex.DecodeString(fromRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from root hash: %w", err)
+	}
+	toRootHashBytes, err := hex.DecodeString(toRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to root hash: %w", err)
+	}
+
+	stateDiff := &apiState.StateDiff{
+		FromRootHash: fromRootHash,
+		ToRootHash:   toRootHash,
+		Accounts:     make([]*apiState.AccountDiff, 0),
+	}
+	err = n.accounts.GetStateDiff(fromRootHashBytes, toRootHashBytes, func(entry *state.StateDiffEntry) bool {
+		if len(stateDiff.Accounts) >= maxStateDiffAccounts {
+			stateDiff.Truncated = true
+			return false
+		}
+
+		stateDiff.Accounts = append(stateDiff.Accounts, n.convertStateDiffEntry(entry))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stateDiff, nil
+}
+
+func (n *Node) convertStateDiffEntry(entry *state.StateDiffEntry) *apiState.AccountDiff {
+	accountDiff := &apiState.AccountDiff{
+		Type:     string(entry.Type),
+		Key:      hex.EncodeToString(entry.Key),
+		OldValue: hex.EncodeToString(entry.OldValue),
+		NewValue: hex.EncodeToString(entry.NewValue),
+	}
+	if len(entry.Key) == n.addressPubkeyConverter.Len() {
+		accountDiff.Address = n.addressPubkeyConverter.Encode(entry.Key)
+	}
+
+	for _, change := range entry.DataTrieChanges {
+		accountDiff.DataTrieChanges = append(accountDiff.DataTrieChanges, convertTrieDiffEntry(change))
+	}
+
+	return accountDiff
+}
+
+func convertTrieDiffEntry(entry *data.TrieDiffEntry) *apiState.KeyDiff {
+	return &apiState.KeyDiff{
+		Type:     string(entry.Type),
+		Key:      hex.EncodeToString(entry.Key),
+		OldValue: hex.EncodeToString(entry.OldValue),
+		NewValue: hex.EncodeToString(entry.NewValue),
+	}
+}
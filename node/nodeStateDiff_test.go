@@ -0,0 +1,124 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetStateDiffNilAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+	)
+
+	stateDiff, err := n.GetStateDiff("aa", "bb")
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, node.ErrNilAccountsAdapter, err)
+}
+
+func TestNode_GetStateDiffInvalidRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+	)
+
+	stateDiff, err := n.GetStateDiff("not hex", "bb")
+	assert.Nil(t, stateDiff)
+	assert.NotNil(t, err)
+
+	stateDiff, err = n.GetStateDiff("aa", "not hex")
+	assert.Nil(t, stateDiff)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetStateDiffAccountsAdapterErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetStateDiffCalled: func(_ []byte, _ []byte, _ func(entry *state.StateDiffEntry) bool) error {
+				return expectedErr
+			},
+		}),
+	)
+
+	stateDiff, err := n.GetStateDiff("aa", "bb")
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetStateDiffShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pubkeyConverter := createMockPubkeyConverter()
+	address := make([]byte, pubkeyConverter.Len())
+	address[0] = 1
+	codeHash := []byte("code hash")
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(pubkeyConverter),
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetStateDiffCalled: func(fromRootHash []byte, toRootHash []byte, handler func(entry *state.StateDiffEntry) bool) error {
+				assert.Equal(t, []byte{0xaa}, fromRootHash)
+				assert.Equal(t, []byte{0xbb}, toRootHash)
+
+				handler(&state.StateDiffEntry{
+					TrieDiffEntry: &data.TrieDiffEntry{
+						Type:     data.TrieDiffUpdated,
+						Key:      address,
+						OldValue: []byte("old"),
+						NewValue: []byte("new"),
+					},
+					DataTrieChanges: []*data.TrieDiffEntry{
+						{Type: data.TrieDiffDeleted, Key: []byte("key"), OldValue: []byte("value")},
+					},
+				})
+				handler(&state.StateDiffEntry{
+					TrieDiffEntry: &data.TrieDiffEntry{
+						Type:     data.TrieDiffInserted,
+						Key:      codeHash,
+						NewValue: []byte("code"),
+					},
+				})
+
+				return nil
+			},
+		}),
+	)
+
+	stateDiff, err := n.GetStateDiff("aa", "bb")
+	require.Nil(t, err)
+	assert.Equal(t, "aa", stateDiff.FromRootHash)
+	assert.Equal(t, "bb", stateDiff.ToRootHash)
+	assert.False(t, stateDiff.Truncated)
+	require.Equal(t, 2, len(stateDiff.Accounts))
+
+	accountDiff := stateDiff.Accounts[0]
+	assert.Equal(t, string(data.TrieDiffUpdated), accountDiff.Type)
+	assert.Equal(t, hex.EncodeToString(address), accountDiff.Key)
+	assert.Equal(t, pubkeyConverter.Encode(address), accountDiff.Address)
+	assert.Equal(t, hex.EncodeToString([]byte("old")), accountDiff.OldValue)
+	assert.Equal(t, hex.EncodeToString([]byte("new")), accountDiff.NewValue)
+	require.Equal(t, 1, len(accountDiff.DataTrieChanges))
+	assert.Equal(t, string(data.TrieDiffDeleted), accountDiff.DataTrieChanges[0].Type)
+	assert.Equal(t, hex.EncodeToString([]byte("key")), accountDiff.DataTrieChanges[0].Key)
+	assert.Equal(t, hex.EncodeToString([]byte("value")), accountDiff.DataTrieChanges[0].OldValue)
+	assert.Empty(t, accountDiff.DataTrieChanges[0].NewValue)
+
+	codeDiff := stateDiff.Accounts[1]
+	assert.Equal(t, string(data.TrieDiffInserted), codeDiff.Type)
+	assert.Equal(t, hex.EncodeToString(codeHash), codeDiff.Key)
+	assert.Empty(t, codeDiff.Address)
+}
@@ -743,6 +743,14 @@ func WithEnableSignTxWithHashEpoch(enableSignTxWithHashEpoch uint32) Option {
 	}
 }
 
+// WithGuardianEnableEpoch sets up guardianEnableEpoch for the node
+func WithGuardianEnableEpoch(guardianEnableEpoch uint32) Option {
+	return func(n *Node) error {
+		n.guardianEnableEpoch = guardianEnableEpoch
+		return nil
+	}
+}
+
 // WithTxSignHasher sets up a transaction sign hasher for the node
 func WithTxSignHasher(txSignHasher hashing.Hasher) Option {
 	return func(n *Node) error {
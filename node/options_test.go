@@ -1440,6 +1440,19 @@ func TestWithSignTxWithHashEpoch_EnableSignTxWithHashEpochShouldWork(t *testing.
 	assert.Nil(t, err)
 }
 
+func TestWithGuardianEnableEpoch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	epochEnable := uint32(10)
+	opt := WithGuardianEnableEpoch(epochEnable)
+	err := opt(node)
+
+	assert.Equal(t, epochEnable, node.guardianEnableEpoch)
+	assert.Nil(t, err)
+}
+
 func TestWithTxSignHasher_NilTxSignHasherShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,18 @@
+package systemSCQueries
+
+import "errors"
+
+// ErrNilSCQueryService signals that a nil SC query service has been provided
+var ErrNilSCQueryService = errors.New("nil SC query service")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrInvalidDelegationMetaData signals that a delegation contract returned an invalid metadata
+var ErrInvalidDelegationMetaData = errors.New("invalid delegation metadata")
+
+// ErrInvalidGovernanceQueryResponse signals that the governance contract returned an unexpected response
+var ErrInvalidGovernanceQueryResponse = errors.New("invalid governance query response")
+
+// ErrInvalidStakingQueryResponse signals that the staking or validator contract returned an unexpected response
+var ErrInvalidStakingQueryResponse = errors.New("invalid staking query response")
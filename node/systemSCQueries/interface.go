@@ -0,0 +1,12 @@
+package systemSCQueries
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// SCQueryService defines how data should be get from a SC account
+type SCQueryService interface {
+	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	IsInterfaceNil() bool
+}
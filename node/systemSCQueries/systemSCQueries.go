@@ -0,0 +1,296 @@
+package systemSCQueries
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	systemVm "github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var log = logger.GetOrCreate("node/systemSCQueries")
+
+const (
+	numQueueDataFields = 4
+	jailedStatus       = "jailed"
+	unStakedStatus     = "unStaked"
+)
+
+// ArgsSystemSCQueries defines the arguments needed for a system smart contracts queries component
+type ArgsSystemSCQueries struct {
+	SCQueryService         SCQueryService
+	AddressPubkeyConverter core.PubkeyConverter
+}
+
+type systemSCQueries struct {
+	scQueryService         SCQueryService
+	addressPubkeyConverter core.PubkeyConverter
+}
+
+// NewSystemSCQueries creates a component which queries the system smart contracts (delegation manager, governance,
+// staking and validator) and decodes their responses for the API
+func NewSystemSCQueries(args ArgsSystemSCQueries) (*systemSCQueries, error) {
+	if check.IfNil(args.SCQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &systemSCQueries{
+		scQueryService:         args.SCQueryService,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+	}, nil
+}
+
+// GetDelegationContractsMetaData returns the addresses of all delegation contracts together with their metadata
+func (ssq *systemSCQueries) GetDelegationContractsMetaData() ([]*delegation.ContractMetaData, error) {
+	scAddresses, err := ssq.executeQuery(systemVm.DelegationManagerSCAddress, systemVm.DelegationManagerSCAddress, "getAllContractAddresses")
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]*delegation.ContractMetaData, 0, len(scAddresses))
+	for _, scAddress := range scAddresses {
+		if bytes.Equal(scAddress, systemVm.FirstDelegationSCAddress) {
+			continue
+		}
+
+		contract, errGet := ssq.getDelegationContractMetaData(scAddress)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		contracts = append(contracts, contract)
+	}
+
+	return contracts, nil
+}
+
+func (ssq *systemSCQueries) getDelegationContractMetaData(scAddress []byte) (*delegation.ContractMetaData, error) {
+	encodedAddress := ssq.addressPubkeyConverter.Encode(scAddress)
+	returnData, err := ssq.executeQuery(scAddress, scAddress, "getMetaData")
+	if err != nil {
+		return nil, fmt.Errorf("%w for delegation contract %s", err, encodedAddress)
+	}
+	if len(returnData) != 3 {
+		return nil, fmt.Errorf("%w for delegation contract %s", ErrInvalidDelegationMetaData, encodedAddress)
+	}
+
+	return &delegation.ContractMetaData{
+		Address:    encodedAddress,
+		Name:       string(returnData[0]),
+		Website:    string(returnData[1]),
+		Identifier: string(returnData[2]),
+	}, nil
+}
+
+// GetGovernanceProposal returns the governance proposal with the given reference together with its vote tally
+func (ssq *systemSCQueries) GetGovernanceProposal(reference string) (*governance.Proposal, error) {
+	proposalData, err := ssq.executeGovernanceQuery("getProposal", 6, []byte(reference))
+	if err != nil {
+		return nil, err
+	}
+	votesData, err := ssq.executeGovernanceQuery("getProposalVotes", 4, []byte(reference))
+	if err != nil {
+		return nil, err
+	}
+	configData, err := ssq.executeGovernanceQuery("getConfig", 5)
+	if err != nil {
+		return nil, err
+	}
+
+	votes := &governance.ProposalVotes{
+		Yes:     big.NewInt(0).SetBytes(votesData[0]).Int64(),
+		No:      big.NewInt(0).SetBytes(votesData[1]).Int64(),
+		Veto:    big.NewInt(0).SetBytes(votesData[2]).Int64(),
+		Abstain: big.NewInt(0).SetBytes(votesData[3]).Int64(),
+	}
+	votes.Total = votes.Yes + votes.No + votes.Veto + votes.Abstain
+
+	quorum := &governance.ProposalQuorum{
+		MinQuorum:        big.NewInt(0).SetBytes(configData[1]).Int64(),
+		MinPassThreshold: big.NewInt(0).SetBytes(configData[2]).Int64(),
+		MinVetoThreshold: big.NewInt(0).SetBytes(configData[3]).Int64(),
+	}
+	quorum.QuorumReached = votes.Total >= quorum.MinQuorum
+	quorum.Vetoed = votes.Veto > quorum.MinVetoThreshold
+	quorum.Passing = quorum.QuorumReached && !quorum.Vetoed && votes.Yes > quorum.MinPassThreshold
+
+	return &governance.Proposal{
+		Reference:      string(proposalData[1]),
+		Issuer:         ssq.addressPubkeyConverter.Encode(proposalData[0]),
+		StartVoteNonce: big.NewInt(0).SetBytes(proposalData[2]).Uint64(),
+		EndVoteNonce:   big.NewInt(0).SetBytes(proposalData[3]).Uint64(),
+		Status:         string(proposalData[4]),
+		Passed:         string(proposalData[5]) == "true",
+		Votes:          votes,
+		Quorum:         quorum,
+	}, nil
+}
+
+// GetGovernanceVotingPower returns the number of votes the given address can cast on governance proposals
+func (ssq *systemSCQueries) GetGovernanceVotingPower(address string) (uint64, error) {
+	addressBytes, err := ssq.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return 0, err
+	}
+
+	returnData, err := ssq.executeGovernanceQuery("getVotingPower", 1, addressBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return big.NewInt(0).SetBytes(returnData[0]).Uint64(), nil
+}
+
+func (ssq *systemSCQueries) executeGovernanceQuery(funcName string, numReturnData int, arguments ...[]byte) ([][]byte, error) {
+	returnData, err := ssq.executeQuery(systemVm.GovernanceSCAddress, systemVm.GovernanceSCAddress, funcName, arguments...)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numReturnData {
+		return nil, fmt.Errorf("%w for %s", ErrInvalidGovernanceQueryResponse, funcName)
+	}
+
+	return returnData, nil
+}
+
+// GetStakingQueue returns the ordered list of the nodes waiting in the staking queue
+func (ssq *systemSCQueries) GetStakingQueue() ([]*validator.QueuedNode, error) {
+	returnData, err := ssq.executeQuery(systemVm.StakingSCAddress, systemVm.ValidatorSCAddress, "getQueueData")
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData)%numQueueDataFields != 0 {
+		return nil, fmt.Errorf("%w for getQueueData", ErrInvalidStakingQueryResponse)
+	}
+
+	queue := make([]*validator.QueuedNode, 0, len(returnData)/numQueueDataFields)
+	for i := 0; i < len(returnData); i += numQueueDataFields {
+		owner, errEncode := ssq.encodeHexAddress(returnData[i+1])
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		rewardAddress, errEncode := ssq.encodeHexAddress(returnData[i+2])
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		registrationNonce, errParse := strconv.ParseUint(string(returnData[i+3]), 10, 64)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		queue = append(queue, &validator.QueuedNode{
+			Position:          len(queue) + 1,
+			BLSKey:            string(returnData[i]),
+			Owner:             owner,
+			RewardAddress:     rewardAddress,
+			RegistrationNonce: registrationNonce,
+		})
+	}
+
+	return queue, nil
+}
+
+// GetOwnerNodesStatus returns the staked amounts and the status of all the nodes of the given owner
+func (ssq *systemSCQueries) GetOwnerNodesStatus(address string) (*validator.OwnerNodes, error) {
+	ownerAddress, err := ssq.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	stakedData, err := ssq.executeQuery(systemVm.ValidatorSCAddress, ownerAddress, "getTotalStakedTopUpBlsKeys")
+	if err != nil {
+		return nil, err
+	}
+	if len(stakedData) < 2 {
+		return nil, fmt.Errorf("%w for getTotalStakedTopUpBlsKeys", ErrInvalidStakingQueryResponse)
+	}
+
+	keysStatus, err := ssq.executeQuery(systemVm.ValidatorSCAddress, systemVm.ValidatorSCAddress, "getBlsKeysStatus", ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(keysStatus)%2 != 0 {
+		return nil, fmt.Errorf("%w for getBlsKeysStatus", ErrInvalidStakingQueryResponse)
+	}
+
+	nodes := make([]*validator.OwnerNode, 0, len(keysStatus)/2)
+	for i := 0; i < len(keysStatus); i += 2 {
+		node := &validator.OwnerNode{
+			BLSKey: hex.EncodeToString(keysStatus[i]),
+			Status: string(keysStatus[i+1]),
+			Jailed: string(keysStatus[i+1]) == jailedStatus,
+		}
+		if node.Status == unStakedStatus {
+			node.RemainingUnBondPeriod = ssq.getRemainingUnBondPeriod(keysStatus[i])
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return &validator.OwnerNodes{
+		Owner:       address,
+		TopUp:       string(stakedData[0]),
+		TotalStaked: string(stakedData[1]),
+		Nodes:       nodes,
+	}, nil
+}
+
+func (ssq *systemSCQueries) getRemainingUnBondPeriod(blsKey []byte) uint64 {
+	returnData, err := ssq.executeQuery(systemVm.StakingSCAddress, systemVm.ValidatorSCAddress, "getRemainingUnBondPeriod", blsKey)
+	if err != nil || len(returnData) != 1 {
+		log.Debug("cannot get remaining unbond period", "bls key", blsKey, "error", err)
+		return 0
+	}
+
+	remaining, err := strconv.ParseUint(string(returnData[0]), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return remaining
+}
+
+// encodeHexAddress converts an address returned hex encoded by the staking contract into its human readable form
+func (ssq *systemSCQueries) encodeHexAddress(hexAddress []byte) (string, error) {
+	if len(hexAddress) == 0 {
+		return "", nil
+	}
+
+	address, err := hex.DecodeString(string(hexAddress))
+	if err != nil {
+		return "", err
+	}
+
+	return ssq.addressPubkeyConverter.Encode(address), nil
+}
+
+func (ssq *systemSCQueries) executeQuery(scAddress []byte, callerAddress []byte, funcName string, arguments ...[]byte) ([][]byte, error) {
+	vmOutput, err := ssq.scQueryService.ExecuteQuery(&process.SCQuery{
+		ScAddress:  scAddress,
+		FuncName:   funcName,
+		CallerAddr: callerAddress,
+		Arguments:  arguments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssq *systemSCQueries) IsInterfaceNil() bool {
+	return ssq == nil
+}
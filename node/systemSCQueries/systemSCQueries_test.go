@@ -0,0 +1,301 @@
+package systemSCQueries
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	systemVm "github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() ArgsSystemSCQueries {
+	return ArgsSystemSCQueries{
+		SCQueryService:         &mock.SCQueryServiceStub{},
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+	}
+}
+
+func TestNewSystemSCQueries_NilSCQueryServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SCQueryService = nil
+	ssq, err := NewSystemSCQueries(args)
+	assert.True(t, check.IfNil(ssq))
+	assert.Equal(t, ErrNilSCQueryService, err)
+}
+
+func TestNewSystemSCQueries_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.AddressPubkeyConverter = nil
+	ssq, err := NewSystemSCQueries(args)
+	assert.True(t, check.IfNil(ssq))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+}
+
+func TestNewSystemSCQueries_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ssq, err := NewSystemSCQueries(createMockArgs())
+	assert.False(t, check.IfNil(ssq))
+	assert.Nil(t, err)
+}
+
+func TestSystemSCQueries_GetDelegationContractsMetaDataQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	contracts, err := ssq.GetDelegationContractsMetaData()
+	assert.Nil(t, contracts)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestSystemSCQueries_GetDelegationContractsMetaDataInvalidMetaDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scAddress := []byte("delegation contract address")
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			if query.FuncName == "getAllContractAddresses" {
+				return &vmcommon.VMOutput{ReturnData: [][]byte{scAddress}}, nil
+			}
+
+			return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("name")}}, nil
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	contracts, err := ssq.GetDelegationContractsMetaData()
+	assert.Nil(t, contracts)
+	assert.True(t, errors.Is(err, ErrInvalidDelegationMetaData))
+}
+
+func TestSystemSCQueries_GetDelegationContractsMetaDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	scAddress := []byte("delegation contract address")
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			switch query.FuncName {
+			case "getAllContractAddresses":
+				assert.Equal(t, systemVm.DelegationManagerSCAddress, query.ScAddress)
+				return &vmcommon.VMOutput{ReturnData: [][]byte{systemVm.FirstDelegationSCAddress, scAddress}}, nil
+			case "getMetaData":
+				assert.Equal(t, scAddress, query.ScAddress)
+				return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("name"), []byte("website"), []byte("identifier")}}, nil
+			}
+
+			return nil, errors.New("unexpected query")
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	contracts, err := ssq.GetDelegationContractsMetaData()
+	require.Nil(t, err)
+	expectedContracts := []*delegation.ContractMetaData{
+		{
+			Address:    hex.EncodeToString(scAddress),
+			Name:       "name",
+			Website:    "website",
+			Identifier: "identifier",
+		},
+	}
+	assert.Equal(t, expectedContracts, contracts)
+}
+
+func TestSystemSCQueries_GetGovernanceProposalInvalidResponseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("issuer")}}, nil
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	proposal, err := ssq.GetGovernanceProposal("reference")
+	assert.Nil(t, proposal)
+	assert.True(t, errors.Is(err, ErrInvalidGovernanceQueryResponse))
+}
+
+func TestSystemSCQueries_GetGovernanceProposalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	reference := "0123456789012345678901234567890123456789"
+	issuer := []byte("issuer")
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, systemVm.GovernanceSCAddress, query.ScAddress)
+			switch query.FuncName {
+			case "getProposal":
+				assert.Equal(t, [][]byte{[]byte(reference)}, query.Arguments)
+				return &vmcommon.VMOutput{ReturnData: [][]byte{
+					issuer, []byte(reference), {100}, {200}, []byte("ended"), []byte("false"),
+				}}, nil
+			case "getProposalVotes":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{{2}, {1}, {3}, {}}}, nil
+			case "getConfig":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{{10}, {4}, {1}, {2}, {100}}}, nil
+			}
+
+			return nil, errors.New("unexpected query")
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	proposal, err := ssq.GetGovernanceProposal(reference)
+	require.Nil(t, err)
+	expectedProposal := &governance.Proposal{
+		Reference:      reference,
+		Issuer:         hex.EncodeToString(issuer),
+		StartVoteNonce: 100,
+		EndVoteNonce:   200,
+		Status:         "ended",
+		Passed:         false,
+		Votes: &governance.ProposalVotes{
+			Yes:     2,
+			No:      1,
+			Veto:    3,
+			Abstain: 0,
+			Total:   6,
+		},
+		Quorum: &governance.ProposalQuorum{
+			MinQuorum:        4,
+			MinPassThreshold: 1,
+			MinVetoThreshold: 2,
+			QuorumReached:    true,
+			Vetoed:           true,
+			Passing:          false,
+		},
+	}
+	assert.Equal(t, expectedProposal, proposal)
+}
+
+func TestSystemSCQueries_GetGovernanceVotingPowerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, "getVotingPower", query.FuncName)
+			assert.Equal(t, [][]byte{address}, query.Arguments)
+			return &vmcommon.VMOutput{ReturnData: [][]byte{{5}}}, nil
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	votingPower, err := ssq.GetGovernanceVotingPower(hex.EncodeToString(address))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), votingPower)
+}
+
+func TestSystemSCQueries_GetStakingQueueShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	reward := []byte("reward")
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, systemVm.StakingSCAddress, query.ScAddress)
+			assert.Equal(t, systemVm.ValidatorSCAddress, query.CallerAddr)
+			assert.Equal(t, "getQueueData", query.FuncName)
+			return &vmcommon.VMOutput{ReturnData: [][]byte{
+				[]byte("aa"), []byte(hex.EncodeToString(owner)), []byte(hex.EncodeToString(reward)), []byte("37"),
+				[]byte("bb"), []byte(""), []byte(hex.EncodeToString(reward)), []byte("38"),
+			}}, nil
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	queue, err := ssq.GetStakingQueue()
+	require.Nil(t, err)
+	expectedQueue := []*validator.QueuedNode{
+		{Position: 1, BLSKey: "aa", Owner: hex.EncodeToString(owner), RewardAddress: hex.EncodeToString(reward), RegistrationNonce: 37},
+		{Position: 2, BLSKey: "bb", Owner: "", RewardAddress: hex.EncodeToString(reward), RegistrationNonce: 38},
+	}
+	assert.Equal(t, expectedQueue, queue)
+}
+
+func TestSystemSCQueries_GetStakingQueueInvalidResponseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("aa")}}, nil
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	queue, err := ssq.GetStakingQueue()
+	assert.Nil(t, queue)
+	assert.True(t, errors.Is(err, ErrInvalidStakingQueryResponse))
+}
+
+func TestSystemSCQueries_GetOwnerNodesStatusShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	args := createMockArgs()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			switch query.FuncName {
+			case "getTotalStakedTopUpBlsKeys":
+				assert.Equal(t, owner, query.CallerAddr)
+				return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("2500"), []byte("7500"), {1}, {2}, {3}}}, nil
+			case "getBlsKeysStatus":
+				assert.Equal(t, [][]byte{owner}, query.Arguments)
+				return &vmcommon.VMOutput{ReturnData: [][]byte{
+					{1}, []byte("staked"),
+					{2}, []byte("jailed"),
+					{3}, []byte("unStaked"),
+				}}, nil
+			case "getRemainingUnBondPeriod":
+				assert.Equal(t, [][]byte{{3}}, query.Arguments)
+				return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("120")}}, nil
+			}
+
+			return nil, errors.New("unexpected query")
+		},
+	}
+	ssq, _ := NewSystemSCQueries(args)
+
+	ownerNodes, err := ssq.GetOwnerNodesStatus(hex.EncodeToString(owner))
+	require.Nil(t, err)
+	expectedOwnerNodes := &validator.OwnerNodes{
+		Owner:       hex.EncodeToString(owner),
+		TotalStaked: "7500",
+		TopUp:       "2500",
+		Nodes: []*validator.OwnerNode{
+			{BLSKey: "01", Status: "staked"},
+			{BLSKey: "02", Status: "jailed", Jailed: true},
+			{BLSKey: "03", Status: "unStaked", RemainingUnBondPeriod: 120},
+		},
+	}
+	assert.Equal(t, expectedOwnerNodes, ownerNodes)
+}
@@ -105,6 +105,16 @@ func (w *readOnlyAccountsDB) RecreateAllTries(_ []byte, _ context.Context) (map[
 	return nil, nil
 }
 
+// GetTrie will call the original accounts' function with the same name
+func (w *readOnlyAccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	return w.originalAccounts.GetTrie(rootHash)
+}
+
+// GetStateDiff will call the original accounts' function with the same name
+func (w *readOnlyAccountsDB) GetStateDiff(fromRootHash []byte, toRootHash []byte, handler func(entry *state.StateDiffEntry) bool) error {
+	return w.originalAccounts.GetStateDiff(fromRootHash, toRootHash, handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (w *readOnlyAccountsDB) IsInterfaceNil() bool {
 	return w == nil
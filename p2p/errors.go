@@ -143,3 +143,18 @@ var ErrUnsupportedMessageVersion = errors.New("unsupported message version")
 
 // ErrNilSyncTimer signals that a nil sync timer was provided
 var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrUnknownCompressionCodec signals that an unknown compression codec was provided
+var ErrUnknownCompressionCodec = errors.New("unknown compression codec")
+
+// ErrDecompressedPayloadTooLarge signals that the decompressed payload exceeds the maximum allowed size
+var ErrDecompressedPayloadTooLarge = errors.New("decompressed payload too large")
+
+// ErrUnexpectedCompressionCodec signals that a compression codec was set on a message version that does not support it
+var ErrUnexpectedCompressionCodec = errors.New("unexpected compression codec")
+
+// ErrNilTrustedPeersChecker signals that a nil trusted peers checker was provided
+var ErrNilTrustedPeersChecker = errors.New("nil trusted peers checker")
+
+// ErrNoStaticPeersForSentryMode signals that the sentry mode was enabled without providing any static peer
+var ErrNoStaticPeersForSentryMode = errors.New("sentry mode requires at least one static peer")
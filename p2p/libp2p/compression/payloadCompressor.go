@@ -0,0 +1,129 @@
+package compression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/golang/snappy"
+)
+
+const (
+	// NoCompression is the codec identifier of an uncompressed payload
+	NoCompression = uint32(0)
+	// SnappyCompression is the codec identifier of a snappy compressed payload
+	SnappyCompression = uint32(1)
+)
+
+// SnappyCodecName is the config name of the snappy codec
+const SnappyCodecName = "snappy"
+
+type topicThreshold struct {
+	topicPrefix string
+	threshold   int
+}
+
+type payloadCompressor struct {
+	enabled          bool
+	codec            uint32
+	defaultThreshold int
+	topicThresholds  []topicThreshold
+}
+
+// NewPayloadCompressor creates a new payload compressor based on the provided config. A disabled config
+// creates a compressor that leaves all payloads unchanged
+func NewPayloadCompressor(cfg config.PayloadCompressionConfig) (*payloadCompressor, error) {
+	pc := &payloadCompressor{
+		enabled:          cfg.Enabled,
+		codec:            NoCompression,
+		defaultThreshold: int(cfg.DefaultThresholdInBytes),
+		topicThresholds:  make([]topicThreshold, 0, len(cfg.TopicThresholds)),
+	}
+	if !cfg.Enabled {
+		return pc, nil
+	}
+
+	codec, err := codecFromName(cfg.Codec)
+	if err != nil {
+		return nil, err
+	}
+	pc.codec = codec
+
+	for _, tt := range cfg.TopicThresholds {
+		pc.topicThresholds = append(pc.topicThresholds, topicThreshold{
+			topicPrefix: tt.Topic,
+			threshold:   int(tt.ThresholdInBytes),
+		})
+	}
+
+	return pc, nil
+}
+
+func codecFromName(name string) (uint32, error) {
+	switch strings.ToLower(name) {
+	case SnappyCodecName:
+		return SnappyCompression, nil
+	default:
+		return NoCompression, fmt.Errorf("%w: %s", p2p.ErrUnknownCompressionCodec, name)
+	}
+}
+
+// Compress returns the compressed payload together with the used codec. The payload is returned unchanged
+// with the NoCompression codec if compression is disabled, the payload is under the topic threshold or
+// the compressed form is not smaller than the original
+func (pc *payloadCompressor) Compress(topic string, buff []byte) ([]byte, uint32) {
+	if !pc.enabled || len(buff) < pc.thresholdForTopic(topic) {
+		return buff, NoCompression
+	}
+
+	compressed := snappy.Encode(nil, buff)
+	if len(compressed) >= len(buff) {
+		return buff, NoCompression
+	}
+
+	return compressed, pc.codec
+}
+
+// thresholdForTopic returns the threshold of the longest configured topic prefix matching the topic
+func (pc *payloadCompressor) thresholdForTopic(topic string) int {
+	threshold := pc.defaultThreshold
+	longestMatch := -1
+	for _, tt := range pc.topicThresholds {
+		if !strings.HasPrefix(topic, tt.topicPrefix) || len(tt.topicPrefix) <= longestMatch {
+			continue
+		}
+
+		threshold = tt.threshold
+		longestMatch = len(tt.topicPrefix)
+	}
+
+	return threshold
+}
+
+// Decompress decompresses the payload using the provided codec, rejecting payloads that would
+// decompress to more than maxDecompressedSize bytes
+func Decompress(codec uint32, buff []byte, maxDecompressedSize int) ([]byte, error) {
+	switch codec {
+	case NoCompression:
+		return buff, nil
+	case SnappyCompression:
+		decodedLen, err := snappy.DecodedLen(buff)
+		if err != nil {
+			return nil, err
+		}
+		if decodedLen > maxDecompressedSize {
+			return nil, fmt.Errorf("%w, maximum %d, got %d",
+				p2p.ErrDecompressedPayloadTooLarge, maxDecompressedSize, decodedLen)
+		}
+
+		return snappy.Decode(nil, buff)
+	default:
+		return nil, fmt.Errorf("%w: %d", p2p.ErrUnknownCompressionCodec, codec)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pc *payloadCompressor) IsInterfaceNil() bool {
+	return pc == nil
+}
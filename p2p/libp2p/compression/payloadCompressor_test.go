@@ -0,0 +1,135 @@
+package compression
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var compressiblePayload = bytes.Repeat([]byte("payload"), 100)
+
+func createMockPayloadCompressionConfig() config.PayloadCompressionConfig {
+	return config.PayloadCompressionConfig{
+		Enabled:                 true,
+		Codec:                   SnappyCodecName,
+		DefaultThresholdInBytes: 100,
+		TopicThresholds: []config.TopicCompressionThresholdConfig{
+			{
+				Topic:            "transactions",
+				ThresholdInBytes: 1000,
+			},
+			{
+				Topic:            "transactions_0",
+				ThresholdInBytes: 10,
+			},
+		},
+	}
+}
+
+func TestNewPayloadCompressor_UnknownCodecShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPayloadCompressionConfig()
+	cfg.Codec = "unknown"
+	pc, err := NewPayloadCompressor(cfg)
+
+	assert.True(t, check.IfNil(pc))
+	assert.True(t, errors.Is(err, p2p.ErrUnknownCompressionCodec))
+}
+
+func TestNewPayloadCompressor_DisabledShouldNotCheckCodec(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPayloadCompressionConfig()
+	cfg.Enabled = false
+	cfg.Codec = "unknown"
+	pc, err := NewPayloadCompressor(cfg)
+
+	assert.False(t, check.IfNil(pc))
+	assert.Nil(t, err)
+}
+
+func TestPayloadCompressor_CompressDisabledShouldReturnPayload(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPayloadCompressionConfig()
+	cfg.Enabled = false
+	pc, _ := NewPayloadCompressor(cfg)
+
+	payload, codec := pc.Compress("topic", compressiblePayload)
+
+	assert.Equal(t, compressiblePayload, payload)
+	assert.Equal(t, NoCompression, codec)
+}
+
+func TestPayloadCompressor_CompressUnderThresholdShouldReturnPayload(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := NewPayloadCompressor(createMockPayloadCompressionConfig())
+
+	payload, codec := pc.Compress("transactions_1", compressiblePayload)
+
+	assert.Equal(t, compressiblePayload, payload)
+	assert.Equal(t, NoCompression, codec)
+}
+
+func TestPayloadCompressor_CompressIncompressiblePayloadShouldReturnPayload(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := NewPayloadCompressor(createMockPayloadCompressionConfig())
+	incompressible := []byte("abcdefghijklmnopqrstuvwxyz")
+
+	payload, codec := pc.Compress("transactions_0", incompressible)
+
+	assert.Equal(t, incompressible, payload)
+	assert.Equal(t, NoCompression, codec)
+}
+
+func TestPayloadCompressor_CompressShouldUseLongestMatchingTopicPrefix(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := NewPayloadCompressor(createMockPayloadCompressionConfig())
+
+	assert.Equal(t, 100, pc.thresholdForTopic("shardBlocks"))
+	assert.Equal(t, 1000, pc.thresholdForTopic("transactions_1"))
+	assert.Equal(t, 10, pc.thresholdForTopic("transactions_0_1"))
+}
+
+func TestPayloadCompressor_CompressDecompressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := NewPayloadCompressor(createMockPayloadCompressionConfig())
+
+	payload, codec := pc.Compress("transactions_0_1", compressiblePayload)
+	require.Equal(t, SnappyCompression, codec)
+	assert.True(t, len(payload) < len(compressiblePayload))
+
+	decompressed, err := Decompress(codec, payload, len(compressiblePayload))
+	assert.Nil(t, err)
+	assert.Equal(t, compressiblePayload, decompressed)
+}
+
+func TestDecompress_TooLargePayloadShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := NewPayloadCompressor(createMockPayloadCompressionConfig())
+	payload, codec := pc.Compress("topic", compressiblePayload)
+
+	decompressed, err := Decompress(codec, payload, len(compressiblePayload)-1)
+	assert.Nil(t, decompressed)
+	assert.True(t, errors.Is(err, p2p.ErrDecompressedPayloadTooLarge))
+}
+
+func TestDecompress_UnknownCodecShouldErr(t *testing.T) {
+	t.Parallel()
+
+	decompressed, err := Decompress(1000, []byte("payload"), 100)
+	assert.Nil(t, decompressed)
+	assert.True(t, errors.Is(err, p2p.ErrUnknownCompressionCodec))
+}
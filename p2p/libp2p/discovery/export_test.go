@@ -1,6 +1,7 @@
 package discovery
 
 import (
+	"context"
 	"time"
 )
 
@@ -23,3 +24,15 @@ func (ckdd *ContinuousKadDhtDiscoverer) StopDHT() error {
 
 	return err
 }
+
+//------- MdnsDiscoverer
+
+const MdnsName = mdnsName
+
+func (md *MdnsDiscoverer) HandlePacket(packet []byte) {
+	md.handlePacket(context.Background(), packet)
+}
+
+func (md *MdnsDiscoverer) CreateAnnouncement() []byte {
+	return md.createAnnouncement()
+}
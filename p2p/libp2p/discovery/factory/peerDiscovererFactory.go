@@ -18,11 +18,34 @@ func NewPeerDiscoverer(
 	sharder p2p.CommonSharder,
 	p2pConfig config.P2PConfig,
 ) (p2p.PeerDiscoverer, error) {
-	if p2pConfig.KadDhtPeerDiscovery.Enabled {
+	if !p2pConfig.KadDhtPeerDiscovery.Enabled {
+		return discovery.NewNilDiscoverer(), nil
+	}
+
+	switch p2pConfig.KadDhtPeerDiscovery.Type {
+	case p2p.KadDhtDiscovery, "":
 		return createKadDhtPeerDiscoverer(context, host, sharder, p2pConfig)
+	case p2p.MdnsDiscovery:
+		return createMdnsPeerDiscoverer(context, host, p2pConfig)
+	default:
+		return nil, fmt.Errorf("%w unable to select peer discoverer: unknown discovery type '%s'",
+			p2p.ErrInvalidValue, p2pConfig.KadDhtPeerDiscovery.Type)
+	}
+}
+
+func createMdnsPeerDiscoverer(
+	context context.Context,
+	host discovery.ConnectableHost,
+	p2pConfig config.P2PConfig,
+) (p2p.PeerDiscoverer, error) {
+	arg := discovery.ArgMdns{
+		Context:          context,
+		Host:             host,
+		ServiceTag:       p2pConfig.KadDhtPeerDiscovery.ProtocolID,
+		AnnounceInterval: time.Second * time.Duration(p2pConfig.KadDhtPeerDiscovery.RefreshIntervalInSec),
 	}
 
-	return discovery.NewNilDiscoverer(), nil
+	return discovery.NewMdnsDiscoverer(arg)
 }
 
 func createKadDhtPeerDiscoverer(
@@ -85,3 +85,52 @@ func TestNewPeerDiscoverer_UnknownShouldErr(t *testing.T) {
 	assert.True(t, check.IfNil(pDiscoverer))
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
 }
+
+func TestNewPeerDiscoverer_MdnsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled:              true,
+			Type:                 p2p.MdnsDiscovery,
+			RefreshIntervalInSec: 1,
+			ProtocolID:           "/erd/kad/1.0.0",
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+	_, ok := pDiscoverer.(*discovery.MdnsDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestNewPeerDiscoverer_UnknownDiscoveryTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled:              true,
+			Type:                 "unknown",
+			RefreshIntervalInSec: 1,
+		},
+		Sharding: config.ShardingConfig{
+			Type: p2p.ListsSharder,
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+
+	assert.True(t, check.IfNil(pDiscoverer))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
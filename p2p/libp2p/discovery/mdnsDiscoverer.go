@@ -0,0 +1,276 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+var _ p2p.PeerDiscoverer = (*MdnsDiscoverer)(nil)
+var _ p2p.Reconnecter = (*MdnsDiscoverer)(nil)
+
+const mdnsName = "mdns discovery"
+const mdnsConnectTimeout = time.Second * 10
+const mdnsMaxPacketSize = 9000
+
+var mdnsGroupAddress = &net.UDPAddr{
+	IP:   net.IPv4(224, 0, 0, 251),
+	Port: 5353,
+}
+
+// ArgMdns represents the mDNS discoverer config argument DTO
+type ArgMdns struct {
+	Context          context.Context
+	Host             ConnectableHost
+	ServiceTag       string
+	AnnounceInterval time.Duration
+}
+
+// MdnsDiscoverer is the peer discovery implementation that finds peers on the local network
+// by periodically multicasting the host's addresses as mDNS TXT records and connecting to the
+// addresses announced by the other peers that use the same service tag
+// It does not require any outside service (seednode, DNS server) and it is aimed for local testnets
+type MdnsDiscoverer struct {
+	host             ConnectableHost
+	context          context.Context
+	serviceName      []string
+	announceInterval time.Duration
+
+	mutConn    sync.RWMutex
+	listenConn *net.UDPConn
+	sendConn   *net.UDPConn
+	cancel     context.CancelFunc
+
+	mutDialingPeers sync.Mutex
+	dialingPeers    map[peer.ID]struct{}
+}
+
+// NewMdnsDiscoverer creates a new mDNS discovery type implementation
+func NewMdnsDiscoverer(arg ArgMdns) (*MdnsDiscoverer, error) {
+	if check.IfNilReflect(arg.Context) {
+		return nil, p2p.ErrNilContext
+	}
+	if check.IfNilReflect(arg.Host) {
+		return nil, p2p.ErrNilHost
+	}
+	if arg.AnnounceInterval < time.Second {
+		return nil, fmt.Errorf("%w, AnnounceInterval should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+	serviceName, err := mdnsServiceName(arg.ServiceTag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MdnsDiscoverer{
+		host:             arg.Host,
+		context:          arg.Context,
+		serviceName:      serviceName,
+		announceInterval: arg.AnnounceInterval,
+		dialingPeers:     make(map[peer.ID]struct{}),
+	}, nil
+}
+
+// Bootstrap will join the mDNS multicast group and will start announcing the host and connecting to the
+// announced peers
+func (md *MdnsDiscoverer) Bootstrap() error {
+	md.mutConn.Lock()
+	defer md.mutConn.Unlock()
+
+	if md.listenConn != nil {
+		return p2p.ErrPeerDiscoveryProcessAlreadyStarted
+	}
+
+	listenConn, err := net.ListenMulticastUDP("udp4", nil, mdnsGroupAddress)
+	if err != nil {
+		return fmt.Errorf("%w while joining the mdns multicast group", err)
+	}
+	// the multicast listener disables the loopback of the sent packets, so a distinct socket is used for
+	// announcing in order for the nodes running on the same machine to find each other
+	sendConn, err := net.ListenUDP("udp4", nil)
+	if err != nil {
+		_ = listenConn.Close()
+		return fmt.Errorf("%w while creating the mdns announcing socket", err)
+	}
+
+	ctx, cancel := context.WithCancel(md.context)
+	md.listenConn = listenConn
+	md.sendConn = sendConn
+	md.cancel = cancel
+
+	go md.processPackets(ctx, listenConn, sendConn)
+	go md.announceContinuously(ctx)
+
+	return nil
+}
+
+func (md *MdnsDiscoverer) processPackets(ctx context.Context, listenConn *net.UDPConn, sendConn *net.UDPConn) {
+	go func() {
+		<-ctx.Done()
+		log.Debug("closing the mdns discovery process")
+		_ = listenConn.Close()
+		_ = sendConn.Close()
+	}()
+
+	buff := make([]byte, mdnsMaxPacketSize)
+	for {
+		n, _, err := listenConn.ReadFromUDP(buff)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				log.Trace("mdns read error", "error", err.Error())
+				continue
+			}
+		}
+
+		md.handlePacket(ctx, buff[:n])
+	}
+}
+
+func (md *MdnsDiscoverer) handlePacket(ctx context.Context, packet []byte) {
+	addresses, err := decodeMdnsAnnouncement(md.serviceName, packet)
+	if err != nil {
+		log.Trace("mdns ignored packet", "error", err.Error())
+		return
+	}
+	if len(addresses) == 0 {
+		return
+	}
+
+	multiAddresses := make([]multiaddr.Multiaddr, 0, len(addresses))
+	for _, address := range addresses {
+		multiAddress, errNewMultiaddr := multiaddr.NewMultiaddr(address)
+		if errNewMultiaddr != nil {
+			log.Trace("mdns ignored address", "address", address, "error", errNewMultiaddr.Error())
+			continue
+		}
+
+		multiAddresses = append(multiAddresses, multiAddress)
+	}
+
+	pInfos, err := peer.AddrInfosFromP2pAddrs(multiAddresses...)
+	if err != nil {
+		log.Trace("mdns ignored announcement", "error", err.Error())
+		return
+	}
+
+	for _, pInfo := range pInfos {
+		if pInfo.ID == md.host.ID() {
+			continue
+		}
+		if md.host.Network().Connectedness(pInfo.ID) == network.Connected {
+			continue
+		}
+		// peers announce themselves periodically, so an announcement received while a previous dial to the
+		// same peer is still in progress should not start another one
+		if !md.markDialing(pInfo.ID) {
+			continue
+		}
+
+		go md.connectToPeer(ctx, pInfo)
+	}
+}
+
+func (md *MdnsDiscoverer) markDialing(pid peer.ID) bool {
+	md.mutDialingPeers.Lock()
+	defer md.mutDialingPeers.Unlock()
+
+	_, isDialing := md.dialingPeers[pid]
+	if isDialing {
+		return false
+	}
+	md.dialingPeers[pid] = struct{}{}
+
+	return true
+}
+
+func (md *MdnsDiscoverer) unmarkDialing(pid peer.ID) {
+	md.mutDialingPeers.Lock()
+	delete(md.dialingPeers, pid)
+	md.mutDialingPeers.Unlock()
+}
+
+func (md *MdnsDiscoverer) connectToPeer(ctx context.Context, pInfo peer.AddrInfo) {
+	defer md.unmarkDialing(pInfo.ID)
+
+	ctxConnect, cancel := context.WithTimeout(ctx, mdnsConnectTimeout)
+	defer cancel()
+
+	err := md.host.Connect(ctxConnect, pInfo)
+	if err != nil {
+		log.Debug("error connecting to mdns announced peer",
+			"pid", pInfo.ID.Pretty(),
+			"error", err.Error(),
+		)
+		return
+	}
+
+	log.Debug("connected to mdns announced peer", "pid", pInfo.ID.Pretty())
+}
+
+func (md *MdnsDiscoverer) announceContinuously(ctx context.Context) {
+	log.Debug("starting the mdns announcing process")
+	for {
+		md.announce()
+
+		select {
+		case <-time.After(md.announceInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (md *MdnsDiscoverer) announce() {
+	md.mutConn.RLock()
+	conn := md.sendConn
+	md.mutConn.RUnlock()
+
+	if conn == nil {
+		return
+	}
+
+	_, err := conn.WriteToUDP(md.createAnnouncement(), mdnsGroupAddress)
+	if err != nil {
+		log.Debug("error sending the mdns announcement", "error", err.Error())
+	}
+}
+
+func (md *MdnsDiscoverer) createAnnouncement() []byte {
+	suffix := "/p2p/" + md.host.ID().Pretty()
+	hostAddresses := md.host.Addrs()
+	addresses := make([]string, 0, len(hostAddresses))
+	for _, address := range hostAddresses {
+		addresses = append(addresses, address.String()+suffix)
+	}
+
+	return encodeMdnsAnnouncement(md.serviceName, addresses)
+}
+
+// Name returns the name of the mDNS peer discovery implementation
+func (md *MdnsDiscoverer) Name() string {
+	return mdnsName
+}
+
+// ReconnectToNetwork will announce the host right away so the other peers can connect to it
+func (md *MdnsDiscoverer) ReconnectToNetwork() <-chan struct{} {
+	chanDone := make(chan struct{}, 1)
+	md.announce()
+	chanDone <- struct{}{}
+
+	return chanDone
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (md *MdnsDiscoverer) IsInterfaceNil() bool {
+	return md == nil
+}
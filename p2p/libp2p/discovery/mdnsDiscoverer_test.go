@@ -0,0 +1,317 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const announcerPid = "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+const receiverPid = "QmYJyUMAcXEw1b5bFfbBbzYu5wyyjLMRHXGUkCXpag74Fu"
+
+func createMockMdnsArgument() discovery.ArgMdns {
+	return discovery.ArgMdns{
+		Context:          context.Background(),
+		Host:             &mock.ConnectableHostStub{},
+		ServiceTag:       "/erd/test/0.0.0",
+		AnnounceInterval: time.Second,
+	}
+}
+
+func createMdnsHostStub(t *testing.T, pidString string, addresses ...string) *mock.ConnectableHostStub {
+	pid, err := peer.Decode(pidString)
+	require.Nil(t, err)
+
+	multiAddresses := make([]multiaddr.Multiaddr, 0, len(addresses))
+	for _, address := range addresses {
+		multiAddresses = append(multiAddresses, multiaddr.StringCast(address))
+	}
+
+	return &mock.ConnectableHostStub{
+		IDCalled: func() peer.ID {
+			return pid
+		},
+		AddrsCalled: func() []multiaddr.Multiaddr {
+			return multiAddresses
+		},
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{}
+		},
+	}
+}
+
+func createAnnouncement(t *testing.T, serviceTag string) []byte {
+	arg := createMockMdnsArgument()
+	arg.ServiceTag = serviceTag
+	arg.Host = createMdnsHostStub(t, announcerPid, "/ip4/127.0.0.1/tcp/10000", "/ip4/192.168.0.2/tcp/10000")
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	return md.CreateAnnouncement()
+}
+
+//------- NewMdnsDiscoverer
+
+func TestNewMdnsDiscoverer_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockMdnsArgument()
+	arg.Context = nil
+
+	md, err := discovery.NewMdnsDiscoverer(arg)
+
+	assert.True(t, check.IfNil(md))
+	assert.True(t, errors.Is(err, p2p.ErrNilContext))
+}
+
+func TestNewMdnsDiscoverer_NilHostShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockMdnsArgument()
+	arg.Host = nil
+
+	md, err := discovery.NewMdnsDiscoverer(arg)
+
+	assert.True(t, check.IfNil(md))
+	assert.True(t, errors.Is(err, p2p.ErrNilHost))
+}
+
+func TestNewMdnsDiscoverer_InvalidAnnounceIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockMdnsArgument()
+	arg.AnnounceInterval = time.Second - time.Nanosecond
+
+	md, err := discovery.NewMdnsDiscoverer(arg)
+
+	assert.True(t, check.IfNil(md))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewMdnsDiscoverer_ServiceTagTooLongShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockMdnsArgument()
+	arg.ServiceTag = strings.Repeat("a", 63)
+
+	md, err := discovery.NewMdnsDiscoverer(arg)
+
+	assert.True(t, check.IfNil(md))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewMdnsDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	md, err := discovery.NewMdnsDiscoverer(createMockMdnsArgument())
+
+	assert.False(t, check.IfNil(md))
+	assert.Nil(t, err)
+	assert.Equal(t, discovery.MdnsName, md.Name())
+}
+
+//------- HandlePacket
+
+func TestMdnsDiscoverer_HandlePacketShouldConnectToAnnouncedPeer(t *testing.T) {
+	t.Parallel()
+
+	chConnected := make(chan peer.AddrInfo, 1)
+	host := createMdnsHostStub(t, receiverPid)
+	host.ConnectCalled = func(ctx context.Context, pi peer.AddrInfo) error {
+		chConnected <- pi
+		return nil
+	}
+	arg := createMockMdnsArgument()
+	arg.Host = host
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	md.HandlePacket(createAnnouncement(t, arg.ServiceTag))
+
+	select {
+	case pInfo := <-chConnected:
+		assert.Equal(t, announcerPid, pInfo.ID.Pretty())
+		require.Equal(t, 2, len(pInfo.Addrs))
+		assert.Equal(t, "/ip4/127.0.0.1/tcp/10000", pInfo.Addrs[0].String())
+		assert.Equal(t, "/ip4/192.168.0.2/tcp/10000", pInfo.Addrs[1].String())
+	case <-time.After(timeoutWaitResponses):
+		assert.Fail(t, "timeout while waiting to connect to the announced peer")
+	}
+}
+
+func TestMdnsDiscoverer_HandlePacketWhileDialingShouldNotDialAgain(t *testing.T) {
+	t.Parallel()
+
+	numConnectCalls := uint32(0)
+	chConnectStarted := make(chan struct{}, 10)
+	chReleaseConnect := make(chan struct{})
+	host := createMdnsHostStub(t, receiverPid)
+	host.ConnectCalled = func(ctx context.Context, pi peer.AddrInfo) error {
+		atomic.AddUint32(&numConnectCalls, 1)
+		chConnectStarted <- struct{}{}
+		<-chReleaseConnect
+		return errors.New("dial failed")
+	}
+	arg := createMockMdnsArgument()
+	arg.Host = host
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	announcement := createAnnouncement(t, arg.ServiceTag)
+	md.HandlePacket(announcement)
+	select {
+	case <-chConnectStarted:
+	case <-time.After(timeoutWaitResponses):
+		require.Fail(t, "timeout while waiting to connect to the announced peer")
+	}
+
+	md.HandlePacket(announcement)
+	md.HandlePacket(announcement)
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numConnectCalls))
+
+	close(chReleaseConnect)
+	time.Sleep(time.Millisecond * 100)
+
+	md.HandlePacket(announcement)
+	select {
+	case <-chConnectStarted:
+	case <-time.After(timeoutWaitResponses):
+		assert.Fail(t, "the peer should have been dialed again after the previous dial ended")
+	}
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numConnectCalls))
+}
+
+func TestMdnsDiscoverer_HandlePacketOwnAnnouncementShouldNotConnect(t *testing.T) {
+	t.Parallel()
+
+	host := createMdnsHostStub(t, announcerPid)
+	host.ConnectCalled = func(ctx context.Context, pi peer.AddrInfo) error {
+		assert.Fail(t, "should have not connected to self")
+		return nil
+	}
+	arg := createMockMdnsArgument()
+	arg.Host = host
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	md.HandlePacket(createAnnouncement(t, arg.ServiceTag))
+	time.Sleep(time.Millisecond * 100)
+}
+
+func TestMdnsDiscoverer_HandlePacketAlreadyConnectedShouldNotConnect(t *testing.T) {
+	t.Parallel()
+
+	host := createMdnsHostStub(t, receiverPid)
+	host.NetworkCalled = func() network.Network {
+		return &mock.NetworkStub{
+			ConnectednessCalled: func(id peer.ID) network.Connectedness {
+				return network.Connected
+			},
+		}
+	}
+	host.ConnectCalled = func(ctx context.Context, pi peer.AddrInfo) error {
+		assert.Fail(t, "should have not connected to an already connected peer")
+		return nil
+	}
+	arg := createMockMdnsArgument()
+	arg.Host = host
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	md.HandlePacket(createAnnouncement(t, arg.ServiceTag))
+	time.Sleep(time.Millisecond * 100)
+}
+
+func TestMdnsDiscoverer_HandlePacketOtherServiceTagShouldNotConnect(t *testing.T) {
+	t.Parallel()
+
+	host := createMdnsHostStub(t, receiverPid)
+	host.ConnectCalled = func(ctx context.Context, pi peer.AddrInfo) error {
+		assert.Fail(t, "should have not connected to a peer from another network")
+		return nil
+	}
+	arg := createMockMdnsArgument()
+	arg.Host = host
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	md.HandlePacket(createAnnouncement(t, "/erd/other/0.0.0"))
+	time.Sleep(time.Millisecond * 100)
+}
+
+func TestMdnsDiscoverer_HandlePacketMalformedShouldNotConnectOrPanic(t *testing.T) {
+	t.Parallel()
+
+	host := createMdnsHostStub(t, receiverPid)
+	host.ConnectCalled = func(ctx context.Context, pi peer.AddrInfo) error {
+		assert.Fail(t, "should have not connected")
+		return nil
+	}
+	arg := createMockMdnsArgument()
+	arg.Host = host
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	announcement := createAnnouncement(t, arg.ServiceTag)
+	for i := 0; i < len(announcement)-1; i++ {
+		md.HandlePacket(announcement[:i])
+	}
+	md.HandlePacket(nil)
+	time.Sleep(time.Millisecond * 100)
+}
+
+func TestMdnsDiscoverer_HandlePacketWithCompressedNameShouldConnect(t *testing.T) {
+	t.Parallel()
+
+	chConnected := make(chan peer.AddrInfo, 1)
+	host := createMdnsHostStub(t, receiverPid)
+	host.ConnectCalled = func(ctx context.Context, pi peer.AddrInfo) error {
+		chConnected <- pi
+		return nil
+	}
+	arg := createMockMdnsArgument()
+	arg.ServiceTag = "test"
+	arg.Host = host
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	txt := "dnsaddr=/ip4/127.0.0.1/tcp/10000/p2p/" + announcerPid
+	packet := []byte{
+		0, 0, 0x84, 0, // id, flags (response)
+		0, 1, 0, 1, 0, 0, 0, 0, // 1 question, 1 answer
+		5, '_', 't', 'e', 's', 't', 4, '_', 'u', 'd', 'p', 5, 'l', 'o', 'c', 'a', 'l', 0, // question name at offset 12
+		0, 16, 0, 1, // TXT, IN
+		0xC0, 12, // answer name pointing to the question name
+		0, 16, 0x80, 1, // TXT, IN with cache flush bit
+		0, 0, 0, 120, // TTL
+		0, byte(len(txt) + 1), byte(len(txt)),
+	}
+	packet = append(packet, txt...)
+
+	md.HandlePacket(packet)
+
+	select {
+	case pInfo := <-chConnected:
+		assert.Equal(t, announcerPid, pInfo.ID.Pretty())
+	case <-time.After(timeoutWaitResponses):
+		assert.Fail(t, "timeout while waiting to connect to the announced peer")
+	}
+}
+
+func TestMdnsDiscoverer_ReconnectToNetworkNotStartedShouldNotBlock(t *testing.T) {
+	t.Parallel()
+
+	md, _ := discovery.NewMdnsDiscoverer(createMockMdnsArgument())
+
+	select {
+	case <-md.ReconnectToNetwork():
+	case <-time.After(timeoutWaitResponses):
+		assert.Fail(t, "ReconnectToNetwork should have not blocked")
+	}
+}
@@ -0,0 +1,213 @@
+package discovery
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+const (
+	mdnsHeaderSize         = 12
+	mdnsFlagResponse       = uint16(0x8400)
+	mdnsFlagResponseMask   = uint16(0x8000)
+	mdnsTypeTXT            = uint16(16)
+	mdnsClassIN            = uint16(1)
+	mdnsClassMask          = uint16(0x7FFF)
+	mdnsRecordTTLInSec     = uint32(120)
+	mdnsMaxLabelSize       = 63
+	mdnsMaxStringSize      = 255
+	mdnsMaxCompressionJump = 16
+	mdnsAddressPrefix      = "dnsaddr="
+)
+
+var errMalformedMdnsPacket = errors.New("malformed mdns packet")
+
+// mdnsServiceName returns the DNS labels under which the peers advertise their addresses
+// The service tag is kept as a single label so it may contain any character, including dots
+func mdnsServiceName(serviceTag string) ([]string, error) {
+	tagLabel := "_" + serviceTag
+	if len(tagLabel) > mdnsMaxLabelSize {
+		return nil, fmt.Errorf("%w, service tag should not exceed %d characters", p2p.ErrInvalidValue, mdnsMaxLabelSize-1)
+	}
+
+	return []string{tagLabel, "_udp", "local"}, nil
+}
+
+// encodeMdnsAnnouncement creates an unsolicited mDNS response containing one TXT record that holds
+// all provided addresses. Addresses that do not fit in a TXT string are skipped.
+func encodeMdnsAnnouncement(serviceName []string, addresses []string) []byte {
+	buff := make([]byte, mdnsHeaderSize, 512)
+	binary.BigEndian.PutUint16(buff[2:], mdnsFlagResponse)
+	binary.BigEndian.PutUint16(buff[6:], 1)
+
+	for _, label := range serviceName {
+		buff = append(buff, byte(len(label)))
+		buff = append(buff, label...)
+	}
+	buff = append(buff, 0)
+
+	rdata := make([]byte, 0, 256)
+	for _, address := range addresses {
+		txt := mdnsAddressPrefix + address
+		if len(txt) > mdnsMaxStringSize {
+			continue
+		}
+
+		rdata = append(rdata, byte(len(txt)))
+		rdata = append(rdata, txt...)
+	}
+
+	buff = appendUint16(buff, mdnsTypeTXT)
+	buff = appendUint16(buff, mdnsClassIN)
+	buff = appendUint32(buff, mdnsRecordTTLInSec)
+	buff = appendUint16(buff, uint16(len(rdata)))
+
+	return append(buff, rdata...)
+}
+
+// decodeMdnsAnnouncement extracts the addresses found in the TXT answers that match the service name
+// Packets that are not responses yield no addresses
+func decodeMdnsAnnouncement(serviceName []string, buff []byte) ([]string, error) {
+	if len(buff) < mdnsHeaderSize {
+		return nil, errMalformedMdnsPacket
+	}
+
+	flags := binary.BigEndian.Uint16(buff[2:])
+	if flags&mdnsFlagResponseMask == 0 {
+		return nil, nil
+	}
+
+	numQuestions := int(binary.BigEndian.Uint16(buff[4:]))
+	numRecords := int(binary.BigEndian.Uint16(buff[6:])) +
+		int(binary.BigEndian.Uint16(buff[8:])) +
+		int(binary.BigEndian.Uint16(buff[10:]))
+
+	offset := mdnsHeaderSize
+	var err error
+	for i := 0; i < numQuestions; i++ {
+		_, offset, err = readMdnsName(buff, offset)
+		if err != nil {
+			return nil, err
+		}
+		offset += 4
+		if offset > len(buff) {
+			return nil, errMalformedMdnsPacket
+		}
+	}
+
+	addresses := make([]string, 0)
+	for i := 0; i < numRecords; i++ {
+		var labels []string
+		labels, offset, err = readMdnsName(buff, offset)
+		if err != nil {
+			return nil, err
+		}
+		if offset+10 > len(buff) {
+			return nil, errMalformedMdnsPacket
+		}
+
+		recordType := binary.BigEndian.Uint16(buff[offset:])
+		recordClass := binary.BigEndian.Uint16(buff[offset+2:]) & mdnsClassMask
+		rdLength := int(binary.BigEndian.Uint16(buff[offset+8:]))
+		offset += 10
+		if offset+rdLength > len(buff) {
+			return nil, errMalformedMdnsPacket
+		}
+
+		rdata := buff[offset : offset+rdLength]
+		offset += rdLength
+
+		isServiceRecord := recordType == mdnsTypeTXT && recordClass == mdnsClassIN && isSameMdnsName(labels, serviceName)
+		if !isServiceRecord {
+			continue
+		}
+
+		addresses, err = appendTxtAddresses(addresses, rdata)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addresses, nil
+}
+
+func appendTxtAddresses(addresses []string, rdata []byte) ([]string, error) {
+	for len(rdata) > 0 {
+		size := int(rdata[0])
+		if 1+size > len(rdata) {
+			return nil, errMalformedMdnsPacket
+		}
+
+		txt := string(rdata[1 : 1+size])
+		rdata = rdata[1+size:]
+		if strings.HasPrefix(txt, mdnsAddressPrefix) {
+			addresses = append(addresses, strings.TrimPrefix(txt, mdnsAddressPrefix))
+		}
+	}
+
+	return addresses, nil
+}
+
+// readMdnsName reads a (possibly compressed) name starting from offset and returns its labels
+// together with the offset of the first byte after the name
+func readMdnsName(buff []byte, offset int) ([]string, int, error) {
+	labels := make([]string, 0)
+	nextOffset := -1
+	numJumps := 0
+
+	for {
+		if offset >= len(buff) {
+			return nil, 0, errMalformedMdnsPacket
+		}
+
+		size := int(buff[offset])
+		switch {
+		case size == 0:
+			if nextOffset < 0 {
+				nextOffset = offset + 1
+			}
+			return labels, nextOffset, nil
+		case size&0xC0 == 0xC0:
+			if offset+1 >= len(buff) || numJumps >= mdnsMaxCompressionJump {
+				return nil, 0, errMalformedMdnsPacket
+			}
+			if nextOffset < 0 {
+				nextOffset = offset + 2
+			}
+			offset = int(binary.BigEndian.Uint16(buff[offset:]) & 0x3FFF)
+			numJumps++
+		case size > mdnsMaxLabelSize:
+			return nil, 0, errMalformedMdnsPacket
+		default:
+			if offset+1+size > len(buff) {
+				return nil, 0, errMalformedMdnsPacket
+			}
+			labels = append(labels, string(buff[offset+1:offset+1+size]))
+			offset += 1 + size
+		}
+	}
+}
+
+func isSameMdnsName(labels []string, serviceName []string) bool {
+	if len(labels) != len(serviceName) {
+		return false
+	}
+	for i := range labels {
+		if !strings.EqualFold(labels[i], serviceName[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func appendUint16(buff []byte, value uint16) []byte {
+	return append(buff, byte(value>>8), byte(value))
+}
+
+func appendUint32(buff []byte, value uint32) []byte {
+	return append(buff, byte(value>>24), byte(value>>16), byte(value>>8), byte(value))
+}
@@ -3,8 +3,10 @@ package libp2p
 import (
 	"context"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -18,11 +20,32 @@ var PubsubTimeCacheDuration = pubsubTimeCacheDuration
 var AcceptMessagesInAdvanceDuration = acceptMessagesInAdvanceDuration
 
 const CurrentTopicMessageVersion = currentTopicMessageVersion
+const UncompressedTopicMessageVersion = uncompressedTopicMessageVersion
 
 func (netMes *networkMessenger) SetHost(newHost ConnectableHost) {
 	netMes.p2pHost = newHost
 }
 
+func (netMes *networkMessenger) PeerSupportsCompression(pid core.PeerID) bool {
+	return netMes.peerSupportsCompression(peer.ID(pid))
+}
+
+func (netMes *networkMessenger) CanCompressBroadcasts() bool {
+	return netMes.canCompressBroadcasts()
+}
+
+func (netMes *networkMessenger) CreateMessageBytes(topic string, buff []byte, receiversSupportCompression bool) []byte {
+	return netMes.createMessageBytes(topic, buff, func() bool {
+		return receiversSupportCompression
+	})
+}
+
+func NewMockMessengerOnHost(args ArgsNetworkMessenger, h host.Host) (*networkMessenger, error) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return createMessenger(args, h, ctx, cancelFunc, false)
+}
+
 func (netMes *networkMessenger) SetLoadBalancer(outgoingPLB p2p.ChannelLoadBalancer) {
 	netMes.outgoingPLB = outgoingPLB
 }
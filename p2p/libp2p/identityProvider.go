@@ -23,6 +23,7 @@ type identityProvider struct {
 	signerVerifier           p2p.SignerVerifier
 	marshalizer              p2p.Marshalizer
 	receiveTimeout           time.Duration
+	authenticationFilter     p2p.TrustedPeersChecker
 }
 
 // NewIdentityProvider creates a wrapper over libp2p's network.Notifiee implementation
@@ -68,6 +69,11 @@ func (ip *identityProvider) ListenClose(network.Network, multiaddr.Multiaddr) {}
 
 // Connected is called when a connection opened
 func (ip *identityProvider) Connected(_ network.Network, conn network.Conn) {
+	if !ip.shouldAuthenticateTo(core.PeerID(conn.RemotePeer())) {
+		log.Trace("identity provider will not authenticate to untrusted peer", "pid", conn.RemotePeer().Pretty())
+		return
+	}
+
 	go func() {
 		ctx := context.Background()
 		s, err := ip.host.NewStream(ctx, conn.RemotePeer(), authProtocolID)
@@ -92,6 +98,20 @@ func (ip *identityProvider) Connected(_ network.Network, conn network.Conn) {
 	}()
 }
 
+// setAuthenticationFilter restricts the peers this node authenticates to (sending its public key) to the trusted ones.
+// Used in sentry mode so the untrusted peers can not link the node's public key to its peer ID
+func (ip *identityProvider) setAuthenticationFilter(trustedPeers p2p.TrustedPeersChecker) {
+	ip.authenticationFilter = trustedPeers
+}
+
+func (ip *identityProvider) shouldAuthenticateTo(pid core.PeerID) bool {
+	if check.IfNil(ip.authenticationFilter) {
+		return true
+	}
+
+	return ip.authenticationFilter.IsTrusted(pid)
+}
+
 // Disconnected is called when a connection closed
 func (ip *identityProvider) Disconnected(network.Network, network.Conn) {}
 
@@ -27,3 +27,9 @@ type PeerDiscovererWithSharder interface {
 	p2p.PeerDiscoverer
 	SetSharder(sharder Sharder) error
 }
+
+// PayloadCompressor defines the behavior of a component able to compress the payloads of the sent topic messages
+type PayloadCompressor interface {
+	Compress(topic string, buff []byte) ([]byte, uint32)
+	IsInterfaceNil() bool
+}
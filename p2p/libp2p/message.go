@@ -7,12 +7,18 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-pubsub"
 )
 
-const currentTopicMessageVersion = uint32(1)
+// uncompressedTopicMessageVersion is the version of the messages that carry the payload as is. It is still emitted
+// for all uncompressed payloads so the nodes that do not know about compression can process them
+const uncompressedTopicMessageVersion = uint32(1)
+
+// currentTopicMessageVersion is the version of the messages that can carry a compressed payload
+const currentTopicMessageVersion = uint32(2)
 
 // NewMessage returns a new instance of a Message object
 func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Message, error) {
@@ -35,10 +41,15 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 		return nil, fmt.Errorf("%w error: %s", p2p.ErrMessageUnmarshalError, err.Error())
 	}
 
-	//TODO change this area when new versions of the message will need to be implemented
-	if topicMessage.Version != currentTopicMessageVersion {
-		return nil, fmt.Errorf("%w, supported %d, got %d",
-			p2p.ErrUnsupportedMessageVersion, currentTopicMessageVersion, topicMessage.Version)
+	switch topicMessage.Version {
+	case uncompressedTopicMessageVersion:
+		if topicMessage.Compression != compression.NoCompression {
+			return nil, fmt.Errorf("%w for version %d", p2p.ErrUnexpectedCompressionCodec, topicMessage.Version)
+		}
+	case currentTopicMessageVersion:
+	default:
+		return nil, fmt.Errorf("%w, supported %d and %d, got %d",
+			p2p.ErrUnsupportedMessageVersion, uncompressedTopicMessageVersion, currentTopicMessageVersion, topicMessage.Version)
 	}
 
 	if len(topicMessage.SignatureOnPid)+len(topicMessage.Pk) > 0 {
@@ -46,7 +57,10 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 			p2p.ErrUnsupportedFields)
 	}
 
-	newMsg.DataField = topicMessage.Payload
+	newMsg.DataField, err = compression.Decompress(topicMessage.Compression, topicMessage.Payload, maxSendBuffSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w error: %s", p2p.ErrMessageUnmarshalError, err.Error())
+	}
 	newMsg.TimestampField = topicMessage.Timestamp
 
 	id, err := peer.IDFromBytes(newMsg.From())
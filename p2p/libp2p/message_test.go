@@ -12,8 +12,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/snappy"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -198,3 +200,97 @@ func TestMessage_PopulatedSigFieldShouldErr(t *testing.T) {
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
 }
+
+func createPubsubMessage(marshalizer p2p.Marshalizer, topicMessage *data.TopicMessage) *pubsub.Message {
+	buff, _ := marshalizer.Marshal(topicMessage)
+	mes := &pubsubpb.Message{
+		From: getRandomID(),
+		Data: buff,
+	}
+
+	return &pubsub.Message{Message: mes}
+}
+
+func TestMessage_UncompressedVersionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:   libp2p.UncompressedTopicMessageVersion,
+		Timestamp: time.Now().Unix(),
+		Payload:   []byte("data"),
+	}
+
+	m, err := libp2p.NewMessage(createPubsubMessage(marshalizer, topicMessage), marshalizer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("data"), m.Data())
+}
+
+func TestMessage_UncompressedVersionWithCompressionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.UncompressedTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     snappy.Encode(nil, []byte("data")),
+		Compression: compression.SnappyCompression,
+	}
+
+	m, err := libp2p.NewMessage(createPubsubMessage(marshalizer, topicMessage), marshalizer)
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrUnexpectedCompressionCodec))
+}
+
+func TestMessage_CompressedPayloadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.CurrentTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     snappy.Encode(nil, []byte("data")),
+		Compression: compression.SnappyCompression,
+	}
+
+	m, err := libp2p.NewMessage(createPubsubMessage(marshalizer, topicMessage), marshalizer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("data"), m.Data())
+}
+
+func TestMessage_CorruptedCompressedPayloadShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.CurrentTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     []byte("not a snappy payload"),
+		Compression: compression.SnappyCompression,
+	}
+
+	m, err := libp2p.NewMessage(createPubsubMessage(marshalizer, topicMessage), marshalizer)
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrMessageUnmarshalError))
+}
+
+func TestMessage_UnknownCompressionCodecShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.CurrentTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     []byte("data"),
+		Compression: 1000,
+	}
+
+	m, err := libp2p.NewMessage(createPubsubMessage(marshalizer, topicMessage), marshalizer)
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrMessageUnmarshalError))
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"sync/atomic"
+)
+
+// Compression is a metric that counts the payload bytes saved by compressing the sent messages
+type Compression struct {
+	numBytesSaved         uint64
+	numCompressedPayloads uint64
+}
+
+// NewCompression returns a new compression metric instance
+func NewCompression() *Compression {
+	return &Compression{
+		numBytesSaved:         0,
+		numCompressedPayloads: 0,
+	}
+}
+
+// AddPayload records the size of a payload before and after it was (possibly) compressed
+func (comp *Compression) AddPayload(originalSize int, sentSize int) {
+	if sentSize >= originalSize {
+		return
+	}
+
+	atomic.AddUint64(&comp.numBytesSaved, uint64(originalSize-sentSize))
+	atomic.AddUint64(&comp.numCompressedPayloads, 1)
+}
+
+// ResetNumBytesSaved resets the numBytesSaved counter returning the previous value
+func (comp *Compression) ResetNumBytesSaved() uint64 {
+	return atomic.SwapUint64(&comp.numBytesSaved, 0)
+}
+
+// ResetNumCompressedPayloads resets the numCompressedPayloads counter returning the previous value
+func (comp *Compression) ResetNumCompressedPayloads() uint64 {
+	return atomic.SwapUint64(&comp.numCompressedPayloads, 0)
+}
@@ -0,0 +1,24 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompression_AddPayloadShouldCountOnlyCompressedPayloads(t *testing.T) {
+	t.Parallel()
+
+	comp := metrics.NewCompression()
+
+	comp.AddPayload(100, 100)
+	comp.AddPayload(100, 120)
+	comp.AddPayload(100, 40)
+	comp.AddPayload(50, 20)
+
+	assert.Equal(t, uint64(2), comp.ResetNumCompressedPayloads())
+	assert.Equal(t, uint64(90), comp.ResetNumBytesSaved())
+	assert.Equal(t, uint64(0), comp.ResetNumCompressedPayloads())
+	assert.Equal(t, uint64(0), comp.ResetNumBytesSaved())
+}
@@ -18,8 +18,10 @@ import (
 	p2pDebug "github.com/ElrondNetwork/elrond-go/debug/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	connMonitorFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/connectionMonitor/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/disabled"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
 	discoveryFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/networksharding/factory"
@@ -46,6 +48,9 @@ const ListenLocalhostAddrWithIp4AndTcp = "/ip4/127.0.0.1/tcp/"
 // DirectSendID represents the protocol ID for sending and receiving direct P2P messages
 const DirectSendID = protocol.ID("/erd/directsend/1.0.0")
 
+// PayloadCompressionID represents the protocol ID advertised by the nodes able to decompress the version 2 topic messages
+const PayloadCompressionID = protocol.ID("/erd/payloadcompression/1.0.0")
+
 const durationBetweenSends = time.Microsecond * 10
 const durationCheckConnections = time.Second
 const refreshPeersOnTopic = time.Second * 3
@@ -90,13 +95,23 @@ type networkMessenger struct {
 	mutTopics           sync.RWMutex
 	processors          map[string]p2p.MessageProcessor
 	topics              map[string]*pubsub.Topic
+	relayedTopics       map[string]*pubsub.Topic
 	subscriptions       map[string]*pubsub.Subscription
 	outgoingPLB         p2p.ChannelLoadBalancer
 	poc                 *peersOnChannel
 	goRoutinesThrottler *throttler.NumGoRoutinesThrottler
 	ip                  *identityProvider
 	connectionsMetric   *metrics.Connections
+	compressionMetric   *metrics.Compression
+	payloadCompressor   PayloadCompressor
+	compressBroadcasts  bool
+	trustedPeers        p2p.TrustedPeersChecker
+	staticPeersKeeper   *staticPeersKeeper
+	sentryMode          bool
+	directPeers         []peer.AddrInfo
+	sentryRelayer       *sentryRelayer
 	debugger            p2p.Debugger
+	capturer            p2p.MessageCapturer
 	marshalizer         p2p.Marshalizer
 	syncTimer           p2p.SyncTimer
 }
@@ -139,6 +154,9 @@ func NewNetworkMessenger(args ArgsNetworkMessenger) (*networkMessenger, error) {
 		libp2p.DisableRelay(),
 		libp2p.NATPortMap(),
 	}
+	if args.P2pConfig.Node.SentryMode {
+		opts = append(opts, libp2p.AddrsFactory(hideAllAddresses))
+	}
 
 	setupExternalP2PLoggers()
 
@@ -193,14 +211,31 @@ func createMessenger(
 		p2pHost:           NewConnectableHost(p2pHost),
 		processors:        make(map[string]p2p.MessageProcessor),
 		topics:            make(map[string]*pubsub.Topic),
+		relayedTopics:     make(map[string]*pubsub.Topic),
 		subscriptions:     make(map[string]*pubsub.Subscription),
 		outgoingPLB:       loadBalancer.NewOutgoingChannelLoadBalancer(),
 		peerShardResolver: &unknownPeerShardResolver{},
 		marshalizer:       args.Marshalizer,
 		syncTimer:         args.SyncTimer,
+		sentryMode:        args.P2pConfig.Node.SentryMode,
 	}
 	netMes.debugger = p2pDebug.NewP2PDebugger(core.PeerID(p2pHost.ID()))
 
+	err = netMes.createPayloadCompressor(args.P2pConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = netMes.createMessageCapturer(args.P2pConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = netMes.createTrustedAndStaticPeers(args.P2pConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	err = netMes.createPubSub(withMessageSigning)
 	if err != nil {
 		return nil, err
@@ -221,6 +256,11 @@ func createMessenger(
 		return nil, err
 	}
 
+	err = netMes.createSentryConnectionsFilter()
+	if err != nil {
+		return nil, err
+	}
+
 	netMes.createConnectionsMetric()
 
 	netMes.ds, err = NewDirectSender(ctx, p2pHost, netMes.directMessageHandler)
@@ -244,6 +284,12 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 		log.Warn("signature verification is turned off in network messenger instance")
 		optsPS = append(optsPS, pubsub.WithMessageSignaturePolicy(noSignPolicy))
 	}
+	if len(netMes.directPeers) > 0 {
+		optsPS = append(optsPS, pubsub.WithDirectPeers(netMes.directPeers))
+	}
+	if netMes.sentryRelayer != nil {
+		optsPS = append(optsPS, pubsub.WithEventTracer(netMes.sentryRelayer))
+	}
 
 	pubsub.TimeCacheDuration = pubsubTimeCacheDuration
 
@@ -261,6 +307,10 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 		return err
 	}
 
+	if netMes.sentryRelayer != nil {
+		go netMes.relayProtectedPeersTopics()
+	}
+
 	go func(plb p2p.ChannelLoadBalancer) {
 		for {
 			select {
@@ -286,7 +336,7 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 				continue
 			}
 
-			buffToSend := netMes.createMessageBytes(sendableData.Buff)
+			buffToSend := netMes.createMessageBytes(sendableData.Topic, sendableData.Buff, netMes.canCompressBroadcasts)
 			if len(buffToSend) == 0 {
 				continue
 			}
@@ -294,18 +344,32 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 			errPublish := topic.Publish(netMes.ctx, buffToSend)
 			if errPublish != nil {
 				log.Trace("error sending data", "error", errPublish)
+				continue
 			}
+
+			netMes.capturer.CaptureSentMessage(sendableData.Topic, sendableData.Buff, "")
 		}
 	}(netMes.outgoingPLB)
 
 	return nil
 }
 
-func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
+// createMessageBytes wraps the buffer in a topic message. The payload is compressed only if the provided handler
+// confirms that the receivers are able to decompress it, otherwise the version 1 message is used
+func (netMes *networkMessenger) createMessageBytes(topic string, buff []byte, receiversSupportCompression func() bool) []byte {
+	payload, codec := netMes.payloadCompressor.Compress(topic, buff)
+	if codec != compression.NoCompression && !receiversSupportCompression() {
+		payload, codec = buff, compression.NoCompression
+	}
 	message := &data.TopicMessage{
-		Version:   currentTopicMessageVersion,
-		Payload:   buff,
-		Timestamp: netMes.syncTimer.CurrentTime().Unix(),
+		Version:     uncompressedTopicMessageVersion,
+		Payload:     payload,
+		Timestamp:   netMes.syncTimer.CurrentTime().Unix(),
+		Compression: codec,
+	}
+	if codec != compression.NoCompression {
+		message.Version = currentTopicMessageVersion
+		netMes.compressionMetric.AddPayload(len(buff), len(payload))
 	}
 
 	buffToSend, errMarshal := netMes.marshalizer.Marshal(message)
@@ -317,9 +381,154 @@ func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
 	return buffToSend
 }
 
+func (netMes *networkMessenger) createMessageCapturer(p2pConfig config.P2PConfig) error {
+	captureConfig := p2pConfig.MessageCapture
+	if !captureConfig.Enabled {
+		netMes.capturer = p2pDebug.NewDisabledMessageCapturer()
+		return nil
+	}
+
+	args := p2pDebug.ArgsMessageCapturer{
+		Marshalizer:        netMes.marshalizer,
+		SelfPeerID:         netMes.ID(),
+		FolderPath:         captureConfig.FolderPath,
+		MaxFileSizeInBytes: uint64(captureConfig.MaxFileSizeInMB) * core.MegabyteSize,
+		MaxNumFiles:        captureConfig.MaxNumFiles,
+	}
+
+	var err error
+	netMes.capturer, err = p2pDebug.NewMessageCapturer(args)
+
+	return err
+}
+
+func (netMes *networkMessenger) createPayloadCompressor(p2pConfig config.P2PConfig) error {
+	var err error
+	netMes.payloadCompressor, err = compression.NewPayloadCompressor(p2pConfig.PayloadCompression)
+	if err != nil {
+		return err
+	}
+
+	netMes.compressionMetric = metrics.NewCompression()
+	netMes.compressBroadcasts = p2pConfig.PayloadCompression.CompressBroadcasts
+
+	// all the nodes are able to decompress the received payloads, regardless of the compression config, so the
+	// support is always advertised to the connected peers through the identify protocol
+	netMes.p2pHost.SetStreamHandler(PayloadCompressionID, func(stream network.Stream) {
+		_ = stream.Reset()
+	})
+
+	return nil
+}
+
+// canCompressBroadcasts returns true if the broadcast compression was enabled and all the connected peers advertised
+// the compression support. The broadcast messages are relayed as they are, so the flag should be set only after all
+// the network nodes were upgraded
+func (netMes *networkMessenger) canCompressBroadcasts() bool {
+	if !netMes.compressBroadcasts {
+		return false
+	}
+
+	for _, pid := range netMes.p2pHost.Network().Peers() {
+		if !netMes.peerSupportsCompression(pid) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (netMes *networkMessenger) peerSupportsCompression(pid peer.ID) bool {
+	protocols, err := netMes.p2pHost.Peerstore().SupportsProtocols(pid, string(PayloadCompressionID))
+
+	return err == nil && len(protocols) > 0
+}
+
+func (netMes *networkMessenger) createTrustedAndStaticPeers(p2pConfig config.P2PConfig) error {
+	if p2pConfig.Node.SentryMode && len(p2pConfig.Node.StaticPeers) == 0 {
+		return p2p.ErrNoStaticPeersForSentryMode
+	}
+
+	// the validators protected by this node are trusted, so their connections are never pruned
+	trustedPeers := append(make([]string, 0), p2pConfig.Node.TrustedPeers...)
+	trustedPeers = append(trustedPeers, p2pConfig.Node.ProtectedPeers...)
+
+	var err error
+	netMes.trustedPeers, err = NewTrustedPeersHolder(trustedPeers, p2pConfig.Node.StaticPeers)
+	if err != nil {
+		return err
+	}
+
+	staticAddrInfos, err := parseStaticPeers(p2pConfig.Node.StaticPeers)
+	if err != nil {
+		return err
+	}
+	netMes.staticPeersKeeper = newStaticPeersKeeper(netMes.p2pHost, staticAddrInfos)
+
+	// a validator in sentry mode and its sentries are gossipsub direct peers, so all the messages on the topics
+	// subscribed by both of them are always forwarded, regardless of the mesh state
+	if netMes.sentryMode {
+		netMes.directPeers = append(netMes.directPeers, staticAddrInfos...)
+	}
+	if len(p2pConfig.Node.ProtectedPeers) > 0 {
+		netMes.sentryRelayer, err = newSentryRelayer(p2pConfig.Node.ProtectedPeers)
+		if err != nil {
+			return err
+		}
+		netMes.directPeers = append(netMes.directPeers, netMes.sentryRelayer.protectedAddrInfos()...)
+	}
+
+	return nil
+}
+
+// relayProtectedPeersTopics relays the topics subscribed by the validators protected by this sentry node
+func (netMes *networkMessenger) relayProtectedPeersTopics() {
+	for {
+		select {
+		case topic := <-netMes.sentryRelayer.topics():
+			err := netMes.relayTopic(topic)
+			if err != nil {
+				log.Warn("sentry relayer: can not relay topic", "topic", topic, "error", err.Error())
+			}
+		case <-netMes.ctx.Done():
+			return
+		}
+	}
+}
+
+// relayTopic joins the topic without delivering its messages to this node, unless the topic is already created
+func (netMes *networkMessenger) relayTopic(name string) error {
+	netMes.mutTopics.Lock()
+	defer netMes.mutTopics.Unlock()
+
+	_, found := netMes.topics[name]
+	if found {
+		return nil
+	}
+	_, found = netMes.relayedTopics[name]
+	if found {
+		return nil
+	}
+
+	topic, err := netMes.pb.Join(name)
+	if err != nil {
+		return fmt.Errorf("%w for topic %s", err, name)
+	}
+	_, err = topic.Relay()
+	if err != nil {
+		return fmt.Errorf("%w for topic %s", err, name)
+	}
+
+	netMes.relayedTopics[name] = topic
+	log.Debug("sentry relayer: relaying topic of protected peer", "topic", name)
+
+	return nil
+}
+
 func (netMes *networkMessenger) createSharder(p2pConfig config.P2PConfig) error {
 	args := factory.ArgsSharderFactory{
 		PeerShardResolver:       &unknownPeerShardResolver{},
+		TrustedPeers:            netMes.trustedPeers,
 		Pid:                     netMes.p2pHost.ID(),
 		MaxConnectionCount:      p2pConfig.Sharding.TargetPeerCount,
 		MaxIntraShardValidators: int(p2pConfig.Sharding.MaxIntraShardValidators),
@@ -336,6 +545,12 @@ func (netMes *networkMessenger) createSharder(p2pConfig config.P2PConfig) error
 }
 
 func (netMes *networkMessenger) createDiscoverer(p2pConfig config.P2PConfig) error {
+	if netMes.sentryMode {
+		log.Info("sentry mode: peer discovery is disabled, the node will only connect to its static peers")
+		netMes.peerDiscoverer = discovery.NewNilDiscoverer()
+		return nil
+	}
+
 	var err error
 	netMes.peerDiscoverer, err = discoveryFactory.NewPeerDiscoverer(
 		netMes.ctx,
@@ -383,6 +598,20 @@ func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConf
 	return nil
 }
 
+func (netMes *networkMessenger) createSentryConnectionsFilter() error {
+	if !netMes.sentryMode {
+		return nil
+	}
+
+	filter, err := newSentryConnectionsFilter(netMes.trustedPeers)
+	if err != nil {
+		return err
+	}
+	netMes.p2pHost.Network().Notify(filter)
+
+	return nil
+}
+
 func (netMes *networkMessenger) createConnectionsMetric() {
 	netMes.connectionsMetric = metrics.NewConnections()
 	netMes.p2pHost.Network().Notify(netMes.connectionsMetric)
@@ -431,6 +660,8 @@ func (netMes *networkMessenger) printLogsStats() {
 		log.Debug("network connection metrics",
 			"connections/s", connsPerSec,
 			"disconnections/s", disconnsPerSec,
+			"compressed payloads", netMes.compressionMetric.ResetNumCompressedPayloads(),
+			"bytes saved by compression", netMes.compressionMetric.ResetNumBytesSaved(),
 		)
 	}
 }
@@ -517,6 +748,15 @@ func (netMes *networkMessenger) Close() error {
 			"error", err)
 	}
 
+	log.Debug("closing network messenger's message capturer...")
+	errCapturer := netMes.capturer.Close()
+	if errCapturer != nil {
+		err = errCapturer
+		log.Warn("networkMessenger.Close",
+			"component", "capturer",
+			"error", err)
+	}
+
 	if err == nil {
 		log.Info("network messenger closed successfully")
 	}
@@ -559,9 +799,16 @@ func (netMes *networkMessenger) ConnectToPeer(address string) error {
 
 // Bootstrap will start the peer discovery mechanism
 func (netMes *networkMessenger) Bootstrap() error {
+	netMes.staticPeersKeeper.startKeepingConnections(netMes.ctx)
+
 	return netMes.peerDiscoverer.Bootstrap()
 }
 
+// TrustedPeers returns the component able to tell if a peer was configured as trusted
+func (netMes *networkMessenger) TrustedPeers() p2p.TrustedPeersChecker {
+	return netMes.trustedPeers
+}
+
 // IsConnected returns true if current node is connected to provided peer
 func (netMes *networkMessenger) IsConnected(peerID core.PeerID) bool {
 	h := netMes.p2pHost
@@ -643,10 +890,16 @@ func (netMes *networkMessenger) CreateTopic(name string, createChannelForTopic b
 		return nil
 	}
 
-	topic, err := netMes.pb.Join(name)
-	if err != nil {
-		return fmt.Errorf("%w for topic %s", err, name)
+	// a topic already relayed for a protected peer is reused, as the pubsub instance allows joining a topic only once
+	topic, found := netMes.relayedTopics[name]
+	if !found {
+		var err error
+		topic, err = netMes.pb.Join(name)
+		if err != nil {
+			return fmt.Errorf("%w for topic %s", err, name)
+		}
 	}
+	delete(netMes.relayedTopics, name)
 
 	netMes.topics[name] = topic
 	subscrRequest, err := topic.Subscribe()
@@ -777,6 +1030,7 @@ func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, top
 			log.Trace("p2p validator - new message", "error", err.Error(), "topics", message.TopicIDs)
 			return false
 		}
+		netMes.capturer.CaptureReceivedMessage(msg, fromConnectedPeer)
 
 		err = handler.ProcessReceivedMessage(msg, fromConnectedPeer)
 		if err != nil {
@@ -833,6 +1087,10 @@ func (netMes *networkMessenger) blacklistPid(pid core.PeerID, banDuration time.D
 	if len(pid) == 0 {
 		return
 	}
+	if netMes.trustedPeers.IsTrusted(pid) {
+		log.Debug("will not blacklist trusted peer due to incompatible p2p message", "pid", pid.Pretty())
+		return
+	}
 
 	log.Debug("blacklisted due to incompatible p2p message",
 		"pid", pid.Pretty(),
@@ -949,17 +1207,23 @@ func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, p
 		return err
 	}
 
-	buffToSend := netMes.createMessageBytes(buff)
+	buffToSend := netMes.createMessageBytes(topic, buff, func() bool {
+		return peerID == netMes.ID() || netMes.peerSupportsCompression(peer.ID(peerID))
+	})
 	if len(buffToSend) == 0 {
 		return nil
 	}
 
 	if peerID == netMes.ID() {
+		netMes.capturer.CaptureSentMessage(topic, buff, peerID)
 		return netMes.sendDirectToSelf(topic, buffToSend)
 	}
 
 	err = netMes.ds.Send(topic, buffToSend, peerID)
 	netMes.debugger.AddOutgoingMessage(topic, uint64(len(buffToSend)), err != nil)
+	if err == nil {
+		netMes.capturer.CaptureSentMessage(topic, buff, peerID)
+	}
 
 	return err
 }
@@ -986,6 +1250,7 @@ func (netMes *networkMessenger) directMessageHandler(message *pubsub.Message, fr
 	if err != nil {
 		return err
 	}
+	netMes.capturer.CaptureReceivedMessage(msg, fromConnectedPeer)
 
 	netMes.mutTopics.RLock()
 	processor = netMes.processors[topic]
@@ -5,6 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -14,9 +17,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	p2pDebug "github.com/ElrondNetwork/elrond-go/debug/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/compression"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -26,6 +31,7 @@ import (
 	"github.com/libp2p/go-libp2p-pubsub/pb"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var timeoutWaitResponses = time.Second * 2
@@ -155,6 +161,36 @@ func TestNewNetworkMessenger_NilSyncTimerShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, p2p.ErrNilSyncTimer))
 }
 
+func TestNewNetworkMessenger_UnknownCompressionCodecShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.PayloadCompression = config.PayloadCompressionConfig{
+		Enabled: true,
+		Codec:   "unknown",
+	}
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrUnknownCompressionCodec))
+}
+
+func TestNewNetworkMessenger_InvalidStaticPeerShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.Node.StaticPeers = []string{"invalid address"}
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewNetworkMessenger_SentryModeWithoutStaticPeersShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.Node.SentryMode = true
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrNoStaticPeersForSentryMode))
+}
+
 func TestNewNetworkMessenger_WithDeactivatedKadDiscovererShouldWork(t *testing.T) {
 	arg := createMockNetworkArgs()
 	mes, err := libp2p.NewNetworkMessenger(arg)
@@ -463,6 +499,288 @@ func TestLibp2pMessenger_BroadcastDataBetween2PeersShouldWork(t *testing.T) {
 	_ = mes2.Close()
 }
 
+func createCompressionConfig() config.PayloadCompressionConfig {
+	return config.PayloadCompressionConfig{
+		Enabled:                 true,
+		CompressBroadcasts:      true,
+		Codec:                   compression.SnappyCodecName,
+		DefaultThresholdInBytes: 1 << 20,
+		TopicThresholds: []config.TopicCompressionThresholdConfig{
+			{
+				Topic:            "te",
+				ThresholdInBytes: 128,
+			},
+		},
+	}
+}
+
+func TestLibp2pMessenger_BroadcastCompressedDataBetween2PeersShouldWork(t *testing.T) {
+	msg := bytes.Repeat([]byte("compressible test message "), 100)
+
+	netw := mocknet.New(context.Background())
+	args := createMockNetworkArgs()
+	args.P2pConfig.PayloadCompression = createCompressionConfig()
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	// the receiver does not compress its own messages but should be able to decompress the received ones
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	adr2 := mes2.Addresses()[0]
+	_ = mes1.ConnectToPeer(adr2)
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	assert.True(t, mes1.PeerSupportsCompression(mes2.ID()))
+	assert.True(t, mes1.CanCompressBroadcasts())
+
+	mes1.Broadcast("test", msg)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_CompressionOnlyForPeersThatAdvertisedSupport(t *testing.T) {
+	netw := mocknet.New(context.Background())
+	args := createMockNetworkArgs()
+	args.P2pConfig.PayloadCompression = createCompressionConfig()
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	// a plain host stands for a node that does not know about the compressed messages
+	oldHost, _ := netw.GenPeer()
+	_ = netw.LinkAll()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+	_, err := netw.ConnectPeers(peer.ID(mes1.ID()), oldHost.ID())
+	require.Nil(t, err)
+	time.Sleep(time.Second)
+
+	assert.True(t, mes1.PeerSupportsCompression(mes2.ID()))
+	assert.False(t, mes1.PeerSupportsCompression(core.PeerID(oldHost.ID())))
+	assert.False(t, mes1.CanCompressBroadcasts())
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+	_ = oldHost.Close()
+}
+
+func TestLibp2pMessenger_CompressBroadcastsDisabledShouldNotCompressBroadcasts(t *testing.T) {
+	netw := mocknet.New(context.Background())
+	args := createMockNetworkArgs()
+	args.P2pConfig.PayloadCompression = createCompressionConfig()
+	args.P2pConfig.PayloadCompression.CompressBroadcasts = false
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+	time.Sleep(time.Second)
+
+	assert.True(t, mes1.PeerSupportsCompression(mes2.ID()))
+	assert.False(t, mes1.CanCompressBroadcasts())
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_CreateMessageBytesForReceiversWithoutCompressionSupportShouldUseVersion1(t *testing.T) {
+	args := createMockNetworkArgs()
+	args.P2pConfig.PayloadCompression = createCompressionConfig()
+	mes, _ := libp2p.NewMockMessenger(args, mocknet.New(context.Background()))
+	msg := bytes.Repeat([]byte("compressible test message "), 100)
+
+	topicMessage := &data.TopicMessage{}
+	err := args.Marshalizer.Unmarshal(topicMessage, mes.CreateMessageBytes("test", msg, false))
+	require.Nil(t, err)
+	assert.Equal(t, libp2p.UncompressedTopicMessageVersion, topicMessage.Version)
+	assert.Equal(t, compression.NoCompression, topicMessage.Compression)
+	assert.Equal(t, msg, topicMessage.Payload)
+
+	topicMessage = &data.TopicMessage{}
+	err = args.Marshalizer.Unmarshal(topicMessage, mes.CreateMessageBytes("test", msg, true))
+	require.Nil(t, err)
+	assert.Equal(t, libp2p.CurrentTopicMessageVersion, topicMessage.Version)
+	assert.Equal(t, compression.SnappyCompression, topicMessage.Compression)
+
+	_ = mes.Close()
+}
+
+func TestLibp2pMessenger_BroadcastWithMessageCaptureShouldWriteCaptureFile(t *testing.T) {
+	msg := []byte("captured test message")
+	folderPath, err := ioutil.TempDir("", "p2p-capture")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(folderPath)
+	}()
+
+	netw := mocknet.New(context.Background())
+	args := createMockNetworkArgs()
+	args.P2pConfig.MessageCapture = config.MessageCaptureConfig{
+		Enabled:         true,
+		FolderPath:      folderPath,
+		MaxFileSizeInMB: 1,
+		MaxNumFiles:     2,
+	}
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	adr2 := mes2.Addresses()[0]
+	_ = mes1.ConnectToPeer(adr2)
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	mes1.Broadcast("test", msg)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+	time.Sleep(time.Millisecond * 100)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+
+	captureFile := filepath.Join(folderPath, p2pDebug.CaptureFileName(mes1.ID()))
+	capturedMessages, err := p2pDebug.ReadCaptureFiles(args.Marshalizer, captureFile)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(capturedMessages))
+
+	numSent := 0
+	numReceived := 0
+	for _, capturedMessage := range capturedMessages {
+		assert.Equal(t, "test", capturedMessage.Topic)
+		assert.Equal(t, msg, capturedMessage.Data)
+		assert.Equal(t, mes1.ID().Bytes(), capturedMessage.From)
+
+		switch capturedMessage.Direction {
+		case p2pDebug.SentMessage:
+			numSent++
+			assert.Equal(t, 0, len(capturedMessage.ConnectedPeer))
+		case p2pDebug.ReceivedMessage:
+			numReceived++
+			assert.Equal(t, mes1.ID().Bytes(), capturedMessage.ConnectedPeer)
+			assert.NotEqual(t, 0, len(capturedMessage.SeqNo))
+		}
+	}
+	assert.Equal(t, 1, numSent)
+	assert.Equal(t, 1, numReceived)
+}
+
+func TestLibp2pMessenger_BootstrapShouldConnectToStaticPeers(t *testing.T) {
+	netw := mocknet.New(context.Background())
+
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	args := createMockNetworkArgs()
+	args.P2pConfig.Node.StaticPeers = []string{mes2.Addresses()[0]}
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	_ = netw.LinkAll()
+
+	assert.True(t, mes1.TrustedPeers().IsTrusted(mes2.ID()))
+	assert.False(t, mes1.IsConnected(mes2.ID()))
+
+	_ = mes1.Bootstrap()
+	time.Sleep(time.Second)
+
+	assert.True(t, mes1.IsConnected(mes2.ID()))
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_SentryModeShouldAcceptOnlyTrustedPeers(t *testing.T) {
+	netw := mocknet.New(context.Background())
+
+	sentry, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	args := createMockNetworkArgs()
+	args.P2pConfig.Node.SentryMode = true
+	args.P2pConfig.Node.StaticPeers = []string{sentry.Addresses()[0]}
+	validator, _ := libp2p.NewMockMessenger(args, netw)
+	untrusted, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	_ = validator.Bootstrap()
+	_ = untrusted.ConnectToPeer(validator.Addresses()[0])
+	time.Sleep(time.Second)
+
+	assert.True(t, validator.IsConnected(sentry.ID()))
+	assert.False(t, validator.IsConnected(untrusted.ID()))
+
+	_ = validator.Close()
+	_ = sentry.Close()
+	_ = untrusted.Close()
+}
+
+func TestLibp2pMessenger_SentryShouldRelayTheProtectedValidatorTopics(t *testing.T) {
+	msg := []byte("consensus message")
+	netw := mocknet.New(context.Background())
+
+	validatorHost, _ := netw.GenPeer()
+	sentryArgs := createMockNetworkArgs()
+	sentryArgs.P2pConfig.Node.ProtectedPeers = []string{validatorHost.ID().Pretty()}
+	sentry, _ := libp2p.NewMockMessenger(sentryArgs, netw)
+
+	validatorArgs := createMockNetworkArgs()
+	validatorArgs.P2pConfig.Node.SentryMode = true
+	validatorArgs.P2pConfig.Node.StaticPeers = []string{sentry.Addresses()[0]}
+	validator, err := libp2p.NewMockMessengerOnHost(validatorArgs, validatorHost)
+	require.Nil(t, err)
+	outsider, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	_ = validator.Bootstrap()
+	_ = outsider.ConnectToPeer(sentry.Addresses()[0])
+
+	// the sentry node does not create the topic, it only relays it for the protected validator
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(1)
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+	prepareMessengerForMatchDataReceive(validator, msg, wg)
+	_ = outsider.CreateTopic("test", true)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second * 2)
+
+	assert.False(t, outsider.IsConnected(validator.ID()))
+	assert.False(t, sentry.HasTopic("test"))
+
+	outsider.Broadcast("test", msg)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	_ = validator.Close()
+	_ = sentry.Close()
+	_ = outsider.Close()
+}
+
 func TestLibp2pMessenger_BroadcastOnChannelBlockingShouldLimitNumberOfGoRoutines(t *testing.T) {
 	if testing.Short() {
 		t.Skip("this test does not perform well in TC with race detector on")
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/networksharding"
 	"github.com/libp2p/go-libp2p-core/peer"
@@ -14,6 +15,7 @@ var log = logger.GetOrCreate("p2p/networksharding/factory")
 // ArgsSharderFactory represents the argument for the sharder factory
 type ArgsSharderFactory struct {
 	PeerShardResolver       p2p.PeerShardResolver
+	TrustedPeers            p2p.TrustedPeersChecker
 	Pid                     peer.ID
 	MaxConnectionCount      int
 	MaxIntraShardValidators int
@@ -23,8 +25,13 @@ type ArgsSharderFactory struct {
 	Type                    string
 }
 
-// NewSharder creates new Sharder instances
+// NewSharder creates new Sharder instances. The lists and one list sharders are wrapped so that the trusted peers
+// are never evicted
 func NewSharder(arg ArgsSharderFactory) (p2p.CommonSharder, error) {
+	if check.IfNil(arg.TrustedPeers) {
+		return nil, p2p.ErrNilTrustedPeersChecker
+	}
+
 	switch arg.Type {
 	case p2p.ListsSharder:
 		log.Debug("using lists sharder",
@@ -34,7 +41,7 @@ func NewSharder(arg ArgsSharderFactory) (p2p.CommonSharder, error) {
 			"MaxIntraShardObservers", arg.MaxIntraShardObservers,
 			"MaxCrossShardObservers", arg.MaxCrossShardObservers,
 		)
-		return networksharding.NewListsSharder(
+		sharder, err := networksharding.NewListsSharder(
 			arg.PeerShardResolver,
 			arg.Pid,
 			arg.MaxConnectionCount,
@@ -43,14 +50,24 @@ func NewSharder(arg ArgsSharderFactory) (p2p.CommonSharder, error) {
 			arg.MaxIntraShardObservers,
 			arg.MaxCrossShardObservers,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		return networksharding.NewTrustedPeersSharder(sharder, arg.TrustedPeers)
 	case p2p.OneListSharder:
 		log.Debug("using one list sharder",
 			"MaxConnectionCount", arg.MaxConnectionCount,
 		)
-		return networksharding.NewOneListSharder(
+		sharder, err := networksharding.NewOneListSharder(
 			arg.Pid,
 			arg.MaxConnectionCount,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		return networksharding.NewTrustedPeersSharder(sharder, arg.TrustedPeers)
 	case p2p.NilListSharder:
 		log.Debug("using nil list sharder")
 		return networksharding.NewNilListSharder(), nil
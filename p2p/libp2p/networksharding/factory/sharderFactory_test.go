@@ -16,6 +16,7 @@ func createMockArg() ArgsSharderFactory {
 	return ArgsSharderFactory{
 		Type:                    "unknown",
 		PeerShardResolver:       &mock.PeerShardResolverStub{},
+		TrustedPeers:            &mock.TrustedPeersCheckerStub{},
 		Pid:                     "",
 		MaxConnectionCount:      5,
 		MaxIntraShardValidators: 1,
@@ -25,6 +26,18 @@ func createMockArg() ArgsSharderFactory {
 	}
 }
 
+func TestNewSharder_NilTrustedPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArg()
+	arg.Type = p2p.ListsSharder
+	arg.TrustedPeers = nil
+	sharder, err := NewSharder(arg)
+
+	assert.True(t, check.IfNil(sharder))
+	assert.Equal(t, p2p.ErrNilTrustedPeersChecker, err)
+}
+
 func TestNewSharder_CreateListsSharderShouldWork(t *testing.T) {
 	t.Parallel()
 
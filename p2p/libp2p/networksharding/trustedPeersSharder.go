@@ -0,0 +1,68 @@
+package networksharding
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var _ p2p.CommonSharder = (*trustedPeersSharder)(nil)
+
+type evictionSharder interface {
+	ComputeEvictionList(pidList []peer.ID) []peer.ID
+	Has(pid peer.ID, list []peer.ID) bool
+	SetPeerShardResolver(psp p2p.PeerShardResolver) error
+	IsInterfaceNil() bool
+}
+
+// trustedPeersSharder wraps a sharder so that the trusted peers are never evicted and do not count against
+// the limits of the wrapped sharder
+type trustedPeersSharder struct {
+	sharder      evictionSharder
+	trustedPeers p2p.TrustedPeersChecker
+}
+
+// NewTrustedPeersSharder creates a new sharder that exempts the trusted peers from the wrapped sharder's eviction
+func NewTrustedPeersSharder(sharder evictionSharder, trustedPeers p2p.TrustedPeersChecker) (*trustedPeersSharder, error) {
+	if check.IfNil(sharder) {
+		return nil, p2p.ErrNilSharder
+	}
+	if check.IfNil(trustedPeers) {
+		return nil, p2p.ErrNilTrustedPeersChecker
+	}
+
+	return &trustedPeersSharder{
+		sharder:      sharder,
+		trustedPeers: trustedPeers,
+	}, nil
+}
+
+// ComputeEvictionList returns the eviction list computed by the wrapped sharder on the untrusted peers
+func (tps *trustedPeersSharder) ComputeEvictionList(pidList []peer.ID) []peer.ID {
+	untrusted := make([]peer.ID, 0, len(pidList))
+	for _, pid := range pidList {
+		if tps.trustedPeers.IsTrusted(core.PeerID(pid)) {
+			continue
+		}
+
+		untrusted = append(untrusted, pid)
+	}
+
+	return tps.sharder.ComputeEvictionList(untrusted)
+}
+
+// Has returns true if provided pid is among the provided list
+func (tps *trustedPeersSharder) Has(pid peer.ID, list []peer.ID) bool {
+	return tps.sharder.Has(pid, list)
+}
+
+// SetPeerShardResolver sets the peer shard resolver for the wrapped sharder
+func (tps *trustedPeersSharder) SetPeerShardResolver(psp p2p.PeerShardResolver) error {
+	return tps.sharder.SetPeerShardResolver(psp)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tps *trustedPeersSharder) IsInterfaceNil() bool {
+	return tps == nil
+}
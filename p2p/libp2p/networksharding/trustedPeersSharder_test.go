@@ -0,0 +1,72 @@
+package networksharding
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrustedPeersSharder_NilSharderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tps, err := NewTrustedPeersSharder(nil, &mock.TrustedPeersCheckerStub{})
+
+	assert.True(t, check.IfNil(tps))
+	assert.Equal(t, p2p.ErrNilSharder, err)
+}
+
+func TestNewTrustedPeersSharder_NilTrustedPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tps, err := NewTrustedPeersSharder(NewNilListSharder(), nil)
+
+	assert.True(t, check.IfNil(tps))
+	assert.Equal(t, p2p.ErrNilTrustedPeersChecker, err)
+}
+
+func TestTrustedPeersSharder_ComputeEvictionListShouldNotEvictNorCountTrustedPeers(t *testing.T) {
+	t.Parallel()
+
+	trusted := map[core.PeerID]struct{}{
+		"trusted1": {},
+		"trusted2": {},
+	}
+	ols, _ := NewOneListSharder("", minAllowedConnectedPeersOneSharder)
+	tps, _ := NewTrustedPeersSharder(
+		ols,
+		&mock.TrustedPeersCheckerStub{
+			IsTrustedCalled: func(pid core.PeerID) bool {
+				_, found := trusted[pid]
+				return found
+			},
+		},
+	)
+
+	pids := []peer.ID{"trusted1", "pid1", "pid2", "trusted2", "pid3"}
+	evicted := tps.ComputeEvictionList(pids)
+	assert.Equal(t, 0, len(evicted))
+
+	pids = append(pids, "pid4")
+	evicted = tps.ComputeEvictionList(pids)
+	assert.Equal(t, 1, len(evicted))
+	assert.False(t, tps.Has("trusted1", evicted))
+	assert.False(t, tps.Has("trusted2", evicted))
+}
+
+func TestTrustedPeersSharder_SetPeerShardResolverShouldCallWrappedSharder(t *testing.T) {
+	t.Parallel()
+
+	ls, _ := NewListsSharder(&mock.PeerShardResolverStub{}, "", 5, 1, 1, 1, 1)
+	tps, _ := NewTrustedPeersSharder(ls, &mock.TrustedPeersCheckerStub{})
+
+	psr := &mock.PeerShardResolverStub{}
+	err := tps.SetPeerShardResolver(psr)
+
+	assert.Nil(t, err)
+	assert.True(t, ls.peerShardResolver == psr)
+}
@@ -41,6 +41,9 @@ func WithAuthentication(
 		if err != nil {
 			return err
 		}
+		if mes.sentryMode {
+			mes.ip.setAuthenticationFilter(mes.trustedPeers)
+		}
 
 		mes.p2pHost.Network().Notify(mes.ip)
 
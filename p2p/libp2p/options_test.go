@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/libp2p/go-libp2p-core/network"
@@ -129,3 +130,43 @@ func TestWithAuthentication_ShouldWork(t *testing.T) {
 	assert.True(t, notifeeCalled)
 	assert.True(t, setStreamHandlerCalled)
 }
+
+func TestWithAuthentication_SentryModeShouldAuthenticateOnlyToTrustedPeers(t *testing.T) {
+	t.Parallel()
+
+	mes := createStubMessengerForDefineOptions(func() {}, func() {})
+	mes.sentryMode = true
+	mes.trustedPeers = &mock.TrustedPeersCheckerStub{
+		IsTrustedCalled: func(pid core.PeerID) bool {
+			return pid == "trusted"
+		},
+	}
+	opt := WithAuthentication(
+		&mock.NetworkShardingCollectorStub{},
+		&mock.SignerVerifierStub{},
+		&mock.MarshalizerStub{},
+	)
+
+	err := opt(mes)
+
+	assert.Nil(t, err)
+	assert.True(t, mes.ip.shouldAuthenticateTo("trusted"))
+	assert.False(t, mes.ip.shouldAuthenticateTo("untrusted"))
+}
+
+func TestWithAuthentication_NotInSentryModeShouldAuthenticateToAllPeers(t *testing.T) {
+	t.Parallel()
+
+	mes := createStubMessengerForDefineOptions(func() {}, func() {})
+	mes.trustedPeers = &mock.TrustedPeersCheckerStub{}
+	opt := WithAuthentication(
+		&mock.NetworkShardingCollectorStub{},
+		&mock.SignerVerifierStub{},
+		&mock.MarshalizerStub{},
+	)
+
+	err := opt(mes)
+
+	assert.Nil(t, err)
+	assert.True(t, mes.ip.shouldAuthenticateTo("untrusted"))
+}
@@ -0,0 +1,57 @@
+package libp2p
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// sentryConnectionsFilter is used by the nodes running in sentry mode. It closes all the connections to the peers
+// that are not trusted, so a validator protected by sentries will only talk to its own sentry observers
+type sentryConnectionsFilter struct {
+	trustedPeers p2p.TrustedPeersChecker
+}
+
+func newSentryConnectionsFilter(trustedPeers p2p.TrustedPeersChecker) (*sentryConnectionsFilter, error) {
+	if check.IfNil(trustedPeers) {
+		return nil, p2p.ErrNilTrustedPeersChecker
+	}
+
+	return &sentryConnectionsFilter{
+		trustedPeers: trustedPeers,
+	}, nil
+}
+
+// Listen is called when network starts listening on an addr
+func (scf *sentryConnectionsFilter) Listen(network.Network, multiaddr.Multiaddr) {}
+
+// ListenClose is called when network stops listening on an addr
+func (scf *sentryConnectionsFilter) ListenClose(network.Network, multiaddr.Multiaddr) {}
+
+// Connected is called when a connection opened. It closes the connection if the remote peer is not trusted
+func (scf *sentryConnectionsFilter) Connected(netw network.Network, conn network.Conn) {
+	pid := conn.RemotePeer()
+	if scf.trustedPeers.IsTrusted(core.PeerID(pid)) {
+		return
+	}
+
+	log.Trace("sentry mode: closing connection with untrusted peer", "pid", pid.Pretty())
+	_ = netw.ClosePeer(pid)
+}
+
+// Disconnected is called when a connection closed
+func (scf *sentryConnectionsFilter) Disconnected(network.Network, network.Conn) {}
+
+// OpenedStream is called when a stream opened
+func (scf *sentryConnectionsFilter) OpenedStream(network.Network, network.Stream) {}
+
+// ClosedStream is called when a stream closed
+func (scf *sentryConnectionsFilter) ClosedStream(network.Network, network.Stream) {}
+
+// hideAllAddresses is used as the host's addresses factory in sentry mode so the node's listening addresses are
+// never advertised to the connected peers through the identify protocol nor through the kad-dht records
+func hideAllAddresses(_ []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	return make([]multiaddr.Multiaddr, 0)
+}
@@ -0,0 +1,62 @@
+package libp2p
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSentryConnectionsFilter_NilTrustedPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scf, err := newSentryConnectionsFilter(nil)
+
+	assert.Nil(t, scf)
+	assert.Equal(t, p2p.ErrNilTrustedPeersChecker, err)
+}
+
+func TestSentryConnectionsFilter_ConnectedShouldCloseOnlyUntrustedPeers(t *testing.T) {
+	t.Parallel()
+
+	trustedPid := peer.ID("trusted")
+	untrustedPid := peer.ID("untrusted")
+	scf, _ := newSentryConnectionsFilter(&mock.TrustedPeersCheckerStub{
+		IsTrustedCalled: func(pid core.PeerID) bool {
+			return pid == core.PeerID(trustedPid)
+		},
+	})
+
+	closedPeers := make([]peer.ID, 0)
+	netw := &mock.NetworkStub{
+		ClosePeerCall: func(pid peer.ID) error {
+			closedPeers = append(closedPeers, pid)
+			return nil
+		},
+	}
+	createConn := func(pid peer.ID) *mock.ConnStub {
+		return &mock.ConnStub{
+			RemotePeerCalled: func() peer.ID {
+				return pid
+			},
+		}
+	}
+
+	scf.Connected(netw, createConn(trustedPid))
+	scf.Connected(netw, createConn(untrustedPid))
+
+	assert.Equal(t, []peer.ID{untrustedPid}, closedPeers)
+}
+
+func TestHideAllAddresses_ShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	addresses := hideAllAddresses(nil)
+
+	assert.False(t, check.IfNilReflect(addresses))
+	assert.Equal(t, 0, len(addresses))
+}
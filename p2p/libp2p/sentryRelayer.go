@@ -0,0 +1,79 @@
+package libp2p
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsubPb "github.com/libp2p/go-libp2p-pubsub/pb"
+)
+
+const relayedTopicsChanSize = 100
+
+// sentryRelayer is used by the sentry observers of the validators running in sentry mode. It is registered as a
+// pubsub event tracer and follows the topic subscriptions announced by the protected validators, so the sentry
+// can relay those topics even if its own node did not create them
+type sentryRelayer struct {
+	protectedPeers map[peer.ID]struct{}
+	chTopics       chan string
+}
+
+func newSentryRelayer(protectedPeers []string) (*sentryRelayer, error) {
+	sr := &sentryRelayer{
+		protectedPeers: make(map[peer.ID]struct{}),
+		chTopics:       make(chan string, relayedTopicsChanSize),
+	}
+
+	for _, protectedPeer := range protectedPeers {
+		pid, err := peer.Decode(protectedPeer)
+		if err != nil {
+			return nil, fmt.Errorf("%w for protected peer %s: %s", p2p.ErrInvalidValue, protectedPeer, err.Error())
+		}
+
+		sr.protectedPeers[pid] = struct{}{}
+	}
+
+	return sr, nil
+}
+
+// Trace is called by the pubsub instance on each event. The topics subscribed by a protected peer are sent on
+// the topics channel. This function is called from the pubsub event loop so it should not block
+func (sr *sentryRelayer) Trace(evt *pubsubPb.TraceEvent) {
+	if evt.GetType() != pubsubPb.TraceEvent_RECV_RPC {
+		return
+	}
+
+	recvRPC := evt.GetRecvRPC()
+	_, isProtected := sr.protectedPeers[peer.ID(recvRPC.GetReceivedFrom())]
+	if !isProtected {
+		return
+	}
+
+	for _, sub := range recvRPC.GetMeta().GetSubscription() {
+		if !sub.GetSubscribe() {
+			continue
+		}
+
+		select {
+		case sr.chTopics <- sub.GetTopic():
+		default:
+			log.Warn("sentry relayer: topics channel is full, topic will not be relayed", "topic", sub.GetTopic())
+		}
+	}
+}
+
+// protectedAddrInfos returns the address infos of the protected peers. As the protected peers do not advertise
+// their addresses, only the peer IDs are set
+func (sr *sentryRelayer) protectedAddrInfos() []peer.AddrInfo {
+	addrInfos := make([]peer.AddrInfo, 0, len(sr.protectedPeers))
+	for pid := range sr.protectedPeers {
+		addrInfos = append(addrInfos, peer.AddrInfo{ID: pid})
+	}
+
+	return addrInfos
+}
+
+// topics returns the channel on which the topics subscribed by the protected peers are sent
+func (sr *sentryRelayer) topics() <-chan string {
+	return sr.chTopics
+}
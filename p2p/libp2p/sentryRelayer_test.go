@@ -0,0 +1,78 @@
+package libp2p
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsubPb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const protectedPeer = "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+
+func createRecvRPCEvent(from peer.ID, topic string, subscribe bool) *pubsubPb.TraceEvent {
+	return &pubsubPb.TraceEvent{
+		Type: pubsubPb.TraceEvent_RECV_RPC.Enum(),
+		RecvRPC: &pubsubPb.TraceEvent_RecvRPC{
+			ReceivedFrom: []byte(from),
+			Meta: &pubsubPb.TraceEvent_RPCMeta{
+				Subscription: []*pubsubPb.TraceEvent_SubMeta{
+					{
+						Subscribe: &subscribe,
+						Topic:     &topic,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewSentryRelayer_InvalidProtectedPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr, err := newSentryRelayer([]string{"invalid peer ID"})
+
+	assert.Nil(t, sr)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestSentryRelayer_ProtectedAddrInfos(t *testing.T) {
+	t.Parallel()
+
+	sr, err := newSentryRelayer([]string{protectedPeer})
+	require.Nil(t, err)
+
+	pid, _ := peer.Decode(protectedPeer)
+	assert.Equal(t, []peer.AddrInfo{{ID: pid}}, sr.protectedAddrInfos())
+}
+
+func TestSentryRelayer_TraceShouldSendOnlyTheTopicsSubscribedByProtectedPeers(t *testing.T) {
+	t.Parallel()
+
+	sr, _ := newSentryRelayer([]string{protectedPeer})
+	pid, _ := peer.Decode(protectedPeer)
+
+	sr.Trace(createRecvRPCEvent("other peer", "other topic", true))
+	sr.Trace(createRecvRPCEvent(pid, "unsubscribed topic", false))
+	sr.Trace(&pubsubPb.TraceEvent{Type: pubsubPb.TraceEvent_JOIN.Enum()})
+	sr.Trace(createRecvRPCEvent(pid, "consensus_0", true))
+
+	require.Equal(t, 1, len(sr.topics()))
+	assert.Equal(t, "consensus_0", <-sr.topics())
+}
+
+func TestSentryRelayer_TraceWithFullChannelShouldNotBlock(t *testing.T) {
+	t.Parallel()
+
+	sr, _ := newSentryRelayer([]string{protectedPeer})
+	pid, _ := peer.Decode(protectedPeer)
+
+	for i := 0; i < relayedTopicsChanSize+1; i++ {
+		sr.Trace(createRecvRPCEvent(pid, "topic", true))
+	}
+
+	assert.Equal(t, relayedTopicsChanSize, len(sr.topics()))
+}
@@ -0,0 +1,96 @@
+package libp2p
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const durationCheckStaticPeers = time.Second
+const minStaticPeerReconnectBackoff = time.Second
+const maxStaticPeerReconnectBackoff = time.Minute
+
+type staticPeerState struct {
+	addrInfo    peer.AddrInfo
+	backoff     time.Duration
+	nextAttempt time.Time
+}
+
+// staticPeersKeeper keeps the connections to the configured static peers alive, redialing a disconnected
+// static peer with an exponential backoff
+type staticPeersKeeper struct {
+	host      ConnectableHost
+	peers     []*staticPeerState
+	startOnce sync.Once
+}
+
+func newStaticPeersKeeper(host ConnectableHost, addrInfos []peer.AddrInfo) *staticPeersKeeper {
+	spk := &staticPeersKeeper{
+		host:  host,
+		peers: make([]*staticPeerState, 0, len(addrInfos)),
+	}
+	for _, addrInfo := range addrInfos {
+		spk.peers = append(spk.peers, &staticPeerState{
+			addrInfo: addrInfo,
+			backoff:  minStaticPeerReconnectBackoff,
+		})
+	}
+
+	return spk
+}
+
+func (spk *staticPeersKeeper) startKeepingConnections(ctx context.Context) {
+	if len(spk.peers) == 0 {
+		return
+	}
+
+	spk.startOnce.Do(func() {
+		go spk.keepConnections(ctx)
+	})
+}
+
+func (spk *staticPeersKeeper) keepConnections(ctx context.Context) {
+	for {
+		spk.connectToDisconnectedPeers(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			log.Debug("closing static peers keeper go routine")
+			return
+		case <-time.After(durationCheckStaticPeers):
+		}
+	}
+}
+
+func (spk *staticPeersKeeper) connectToDisconnectedPeers(ctx context.Context, now time.Time) {
+	for _, state := range spk.peers {
+		if spk.host.Network().Connectedness(state.addrInfo.ID) == network.Connected {
+			state.backoff = minStaticPeerReconnectBackoff
+			continue
+		}
+		if now.Before(state.nextAttempt) {
+			continue
+		}
+
+		err := spk.host.Connect(ctx, state.addrInfo)
+		if err == nil {
+			log.Debug("connected to static peer", "pid", state.addrInfo.ID.Pretty())
+			state.backoff = minStaticPeerReconnectBackoff
+			continue
+		}
+
+		log.Debug("error connecting to static peer",
+			"pid", state.addrInfo.ID.Pretty(),
+			"retry in", state.backoff,
+			"error", err.Error(),
+		)
+		state.nextAttempt = now.Add(state.backoff)
+		state.backoff *= 2
+		if state.backoff > maxStaticPeerReconnectBackoff {
+			state.backoff = maxStaticPeerReconnectBackoff
+		}
+	}
+}
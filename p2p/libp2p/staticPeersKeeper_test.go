@@ -0,0 +1,91 @@
+package libp2p
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func createStaticPeersKeeperWithHost(connected *bool, connectErr *error, numConnectCalls *int) *staticPeersKeeper {
+	host := &mock.ConnectableHostStub{
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{
+				ConnectednessCalled: func(id peer.ID) network.Connectedness {
+					if *connected {
+						return network.Connected
+					}
+
+					return network.NotConnected
+				},
+			}
+		},
+		ConnectCalled: func(ctx context.Context, pi peer.AddrInfo) error {
+			*numConnectCalls++
+			return *connectErr
+		},
+	}
+
+	return newStaticPeersKeeper(host, []peer.AddrInfo{{ID: "static peer"}})
+}
+
+func TestStaticPeersKeeper_ConnectedPeerShouldNotDial(t *testing.T) {
+	t.Parallel()
+
+	connected := true
+	var connectErr error
+	numConnectCalls := 0
+	spk := createStaticPeersKeeperWithHost(&connected, &connectErr, &numConnectCalls)
+
+	spk.connectToDisconnectedPeers(context.Background(), time.Now())
+
+	assert.Equal(t, 0, numConnectCalls)
+}
+
+func TestStaticPeersKeeper_FailedDialsShouldBackoff(t *testing.T) {
+	t.Parallel()
+
+	connected := false
+	connectErr := errors.New("expected error")
+	numConnectCalls := 0
+	spk := createStaticPeersKeeperWithHost(&connected, &connectErr, &numConnectCalls)
+
+	now := time.Now()
+	spk.connectToDisconnectedPeers(context.Background(), now)
+	assert.Equal(t, 1, numConnectCalls)
+
+	spk.connectToDisconnectedPeers(context.Background(), now.Add(minStaticPeerReconnectBackoff/2))
+	assert.Equal(t, 1, numConnectCalls)
+
+	now = now.Add(minStaticPeerReconnectBackoff)
+	spk.connectToDisconnectedPeers(context.Background(), now)
+	assert.Equal(t, 2, numConnectCalls)
+	assert.Equal(t, 4*minStaticPeerReconnectBackoff, spk.peers[0].backoff)
+
+	for i := 0; i < 10; i++ {
+		now = now.Add(maxStaticPeerReconnectBackoff)
+		spk.connectToDisconnectedPeers(context.Background(), now)
+	}
+	assert.Equal(t, maxStaticPeerReconnectBackoff, spk.peers[0].backoff)
+}
+
+func TestStaticPeersKeeper_ReconnectedPeerShouldResetBackoff(t *testing.T) {
+	t.Parallel()
+
+	connected := false
+	connectErr := errors.New("expected error")
+	numConnectCalls := 0
+	spk := createStaticPeersKeeperWithHost(&connected, &connectErr, &numConnectCalls)
+
+	spk.connectToDisconnectedPeers(context.Background(), time.Now())
+	assert.Equal(t, 2*minStaticPeerReconnectBackoff, spk.peers[0].backoff)
+
+	connected = true
+	spk.connectToDisconnectedPeers(context.Background(), time.Now())
+	assert.Equal(t, minStaticPeerReconnectBackoff, spk.peers[0].backoff)
+}
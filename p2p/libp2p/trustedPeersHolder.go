@@ -0,0 +1,74 @@
+package libp2p
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+var _ p2p.TrustedPeersChecker = (*trustedPeersHolder)(nil)
+
+type trustedPeersHolder struct {
+	trustedPids map[core.PeerID]struct{}
+}
+
+// NewTrustedPeersHolder creates a new trusted peers holder from the provided peer IDs and static peer addresses.
+// The peer IDs of the static peers are considered trusted as well
+func NewTrustedPeersHolder(trustedPeers []string, staticPeers []string) (*trustedPeersHolder, error) {
+	tph := &trustedPeersHolder{
+		trustedPids: make(map[core.PeerID]struct{}),
+	}
+
+	for _, trustedPeer := range trustedPeers {
+		pid, err := peer.Decode(trustedPeer)
+		if err != nil {
+			return nil, fmt.Errorf("%w for trusted peer %s: %s", p2p.ErrInvalidValue, trustedPeer, err.Error())
+		}
+
+		tph.trustedPids[core.PeerID(pid)] = struct{}{}
+	}
+
+	staticAddrInfos, err := parseStaticPeers(staticPeers)
+	if err != nil {
+		return nil, err
+	}
+	for _, addrInfo := range staticAddrInfos {
+		tph.trustedPids[core.PeerID(addrInfo.ID)] = struct{}{}
+	}
+
+	return tph, nil
+}
+
+func parseStaticPeers(staticPeers []string) ([]peer.AddrInfo, error) {
+	addrInfos := make([]peer.AddrInfo, 0, len(staticPeers))
+	for _, staticPeer := range staticPeers {
+		multiAddr, err := multiaddr.NewMultiaddr(staticPeer)
+		if err != nil {
+			return nil, fmt.Errorf("%w for static peer %s: %s", p2p.ErrInvalidValue, staticPeer, err.Error())
+		}
+
+		addrInfo, err := peer.AddrInfoFromP2pAddr(multiAddr)
+		if err != nil {
+			return nil, fmt.Errorf("%w for static peer %s: %s", p2p.ErrInvalidValue, staticPeer, err.Error())
+		}
+
+		addrInfos = append(addrInfos, *addrInfo)
+	}
+
+	return addrInfos, nil
+}
+
+// IsTrusted returns true if the provided peer ID was configured as trusted or static peer
+func (tph *trustedPeersHolder) IsTrusted(pid core.PeerID) bool {
+	_, found := tph.trustedPids[pid]
+
+	return found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tph *trustedPeersHolder) IsInterfaceNil() bool {
+	return tph == nil
+}
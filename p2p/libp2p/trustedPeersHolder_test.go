@@ -0,0 +1,50 @@
+package libp2p_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrustedPeersHolder_InvalidTrustedPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tph, err := libp2p.NewTrustedPeersHolder([]string{"invalid pid"}, nil)
+
+	assert.True(t, check.IfNil(tph))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewTrustedPeersHolder_InvalidStaticPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tph, err := libp2p.NewTrustedPeersHolder(nil, []string{"/ip4/127.0.0.1/tcp/9999"})
+
+	assert.True(t, check.IfNil(tph))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestTrustedPeersHolder_IsTrustedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	trustedPid := core.PeerID(getRandomID())
+	staticPid := core.PeerID(getRandomID())
+	untrustedPid := core.PeerID(getRandomID())
+
+	tph, err := libp2p.NewTrustedPeersHolder(
+		[]string{peer.ID(trustedPid).Pretty()},
+		[]string{"/ip4/127.0.0.1/tcp/9999/p2p/" + peer.ID(staticPid).Pretty()},
+	)
+
+	assert.False(t, check.IfNil(tph))
+	assert.Nil(t, err)
+	assert.True(t, tph.IsTrusted(trustedPid))
+	assert.True(t, tph.IsTrusted(staticPid))
+	assert.False(t, tph.IsTrusted(untrustedPid))
+}
@@ -340,6 +340,34 @@ func (messenger *Messenger) processFromQueue() {
 	}
 }
 
+// ProcessMessage synchronously hands the provided message to the processor registered on the message's topic as
+// if it was received from the provided connected peer. It is used to deterministically replay captured messages
+func (messenger *Messenger) ProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return p2p.ErrNilMessage
+	}
+	if len(message.Topics()) == 0 {
+		return p2p.ErrNilTopic
+	}
+
+	topic := message.Topics()[0]
+	messenger.topicsMutex.RLock()
+	_, found := messenger.topics[topic]
+	validator := messenger.topicValidators[topic]
+	messenger.topicsMutex.RUnlock()
+
+	if !found {
+		return fmt.Errorf("%w, topic %s", p2p.ErrNilTopic, topic)
+	}
+
+	atomic.AddUint64(&messenger.numReceived, 1)
+	if check.IfNil(validator) {
+		return fmt.Errorf("%w, topic %s", p2p.ErrNilValidator, topic)
+	}
+
+	return validator.ProcessReceivedMessage(message, fromConnectedPeer)
+}
+
 // SendToConnectedPeer sends a message directly to the peer specified by the ID.
 func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error {
 	if messenger.IsConnectedToNetwork() {
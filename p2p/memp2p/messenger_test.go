@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/stretchr/testify/assert"
 )
@@ -184,3 +186,38 @@ func TestSendingDirectMessages(t *testing.T) {
 	// Peer1 got the message
 	assert.Equal(t, uint64(1), peer1.NumMessagesReceived())
 }
+
+func TestProcessMessage(t *testing.T) {
+	network := memp2p.NewNetwork()
+	messenger, _ := memp2p.NewMessenger(network)
+
+	err := messenger.ProcessMessage(nil, "pid")
+	assert.Equal(t, p2p.ErrNilMessage, err)
+
+	msg := &message.Message{
+		DataField:   []byte("data"),
+		TopicsField: []string{"rocket"},
+	}
+	err = messenger.ProcessMessage(msg, "pid")
+	assert.True(t, errors.Is(err, p2p.ErrNilTopic))
+
+	_ = messenger.CreateTopic("rocket", false)
+	err = messenger.ProcessMessage(msg, "pid")
+	assert.True(t, errors.Is(err, p2p.ErrNilValidator))
+
+	processorErr := errors.New("processor error")
+	var processedMessage p2p.MessageP2P
+	var processedFromPeer core.PeerID
+	_ = messenger.RegisterMessageProcessor("rocket", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			processedMessage = message
+			processedFromPeer = fromConnectedPeer
+			return processorErr
+		},
+	})
+	err = messenger.ProcessMessage(msg, "pid")
+	assert.Equal(t, processorErr, err)
+	assert.Equal(t, msg, processedMessage)
+	assert.Equal(t, core.PeerID("pid"), processedFromPeer)
+	assert.Equal(t, uint64(2), messenger.NumMessagesReceived())
+}
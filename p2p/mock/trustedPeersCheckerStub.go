@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// TrustedPeersCheckerStub -
+type TrustedPeersCheckerStub struct {
+	IsTrustedCalled func(pid core.PeerID) bool
+}
+
+// IsTrusted -
+func (tpcs *TrustedPeersCheckerStub) IsTrusted(pid core.PeerID) bool {
+	if tpcs.IsTrustedCalled != nil {
+		return tpcs.IsTrustedCalled(pid)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (tpcs *TrustedPeersCheckerStub) IsInterfaceNil() bool {
+	return tpcs == nil
+}
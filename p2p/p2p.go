@@ -19,6 +19,13 @@ const (
 	NilListSharder = "NilListSharder"
 )
 
+const (
+	// KadDhtDiscovery is the peer discovery variant that uses a kademlia DHT seeded by the initial peers list
+	KadDhtDiscovery = "kad-dht"
+	// MdnsDiscovery is the peer discovery variant that uses multicast DNS announcements on the local network
+	MdnsDiscovery = "mdns"
+)
+
 // MessageProcessor is the interface used to describe what a receive message processor should do
 // All implementations that will be called from Messenger implementation will need to satisfy this interface
 // If the function returns a non nil value, the received message will not be propagated to its connected peers
@@ -265,6 +272,13 @@ type CommonSharder interface {
 	IsInterfaceNil() bool
 }
 
+// TrustedPeersChecker defines the behavior of a component able to tell if a peer ID was configured as trusted.
+// Trusted peers are never pruned by the sharders and never blacklisted
+type TrustedPeersChecker interface {
+	IsTrusted(pid core.PeerID) bool
+	IsInterfaceNil() bool
+}
+
 // PeerDenialEvaluator defines the behavior of a component that is able to decide if a peer ID is black listed or not
 //TODO merge this interface with the PeerShardResolver => P2PProtocolHandler ?
 //TODO move antiflooding inside network messenger
@@ -297,6 +311,14 @@ type Debugger interface {
 	IsInterfaceNil() bool
 }
 
+// MessageCapturer represents an entity able to record the sent and received messages so they can be replayed later
+type MessageCapturer interface {
+	CaptureReceivedMessage(message MessageP2P, fromConnectedPeer core.PeerID)
+	CaptureSentMessage(topic string, buff []byte, toPeer core.PeerID)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // SyncTimer represent an entity able to tell the current time
 type SyncTimer interface {
 	CurrentTime() time.Time
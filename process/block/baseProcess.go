@@ -1232,15 +1232,28 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 }
 
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	txsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	for _, blockType := range []block.Type{block.RewardsBlock, block.InvalidBlock} {
+		for txHash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			txsFromPool[txHash] = tx
+		}
+	}
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, txsFromPool, scrResultsFromPool, receiptsFromPool)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
 }
 
+func (bp *baseProcessor) revertBlockInHistory(blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	err := bp.historyRepo.RevertBlock(blockHeader, blockBody)
+	if err != nil {
+		log.Error("historyRepo.RevertBlock()", "nonce", blockHeader.GetNonce(), "error", err.Error())
+	}
+}
+
 func (bp *baseProcessor) addHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	headersPool := bp.dataPool.Headers()
 	headers, hashes, err := headersPool.GetHeadersByNonceAndShardId(nonce, shardID)
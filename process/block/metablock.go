@@ -643,6 +643,7 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 	}
 
 	mp.restoreBlockBody(bodyHandler)
+	mp.revertBlockInHistory(headerHandler, bodyHandler)
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
@@ -612,6 +612,7 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 	}
 
 	sp.restoreBlockBody(bodyHandler)
+	sp.revertBlockInHistory(headerHandler, bodyHandler)
 
 	sp.blockTracker.RemoveLastNotarizedHeaders()
 
@@ -2,6 +2,7 @@ package coordinator
 
 import (
 	"bytes"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -122,14 +123,35 @@ func (tth *txTypeHandler) isSCCallAfterBuiltIn(function string, args [][]byte, t
 	if !core.IsSmartContractAddress(tx.GetRcvAddr()) {
 		return false
 	}
-	if len(args) <= 2 {
+
+	switch function {
+	case core.BuiltInFunctionESDTTransfer:
+		return len(args) > 2
+	case core.BuiltInFunctionESDTNFTTransfer:
+		return len(args) > 4
+	case core.BuiltInFunctionESDTMultiTransfer:
+		return isSCCallAfterESDTMultiTransfer(args)
+	default:
+		return false
+	}
+}
+
+func isSCCallAfterESDTMultiTransfer(args [][]byte) bool {
+	if len(args) == 0 {
 		return false
 	}
-	if function != core.BuiltInFunctionESDTTransfer {
+
+	numOfTransfers := big.NewInt(0).SetBytes(args[0])
+	if !numOfTransfers.IsUint64() {
+		return false
+	}
+
+	numArgsAfterTransfersCount := uint64(len(args) - 1)
+	if numOfTransfers.Uint64() > numArgsAfterTransfersCount/2 {
 		return false
 	}
 
-	return true
+	return numArgsAfterTransfersCount > 2*numOfTransfers.Uint64()
 }
 
 func (tth *txTypeHandler) getFunctionFromArguments(txData []byte) (string, [][]byte) {
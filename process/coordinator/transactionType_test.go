@@ -306,3 +306,13 @@ func TestTxTypeHandler_ComputeTransactionTypeForSCRCallBack(t *testing.T) {
 	assert.Equal(t, process.SCInvoking, txTypeIn)
 	assert.Equal(t, process.SCInvoking, txTypeCross)
 }
+
+func TestIsSCCallAfterESDTMultiTransfer(t *testing.T) {
+	t.Parallel()
+
+	transfers := [][]byte{big.NewInt(2).Bytes(), []byte("tokenA"), {10}, []byte("tokenB"), {20}}
+	assert.False(t, isSCCallAfterESDTMultiTransfer(nil))
+	assert.False(t, isSCCallAfterESDTMultiTransfer(transfers))
+	assert.False(t, isSCCallAfterESDTMultiTransfer(append([][]byte{big.NewInt(3).Bytes()}, transfers[1:]...)))
+	assert.True(t, isSCCallAfterESDTMultiTransfer(append(transfers, []byte("function"))))
+}
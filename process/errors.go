@@ -890,6 +890,15 @@ var ErrOnlySystemAccountAccepted = errors.New("only system account is accepted")
 // ErrNilPauseHandler signals that nil pause handler has been provided
 var ErrNilPauseHandler = errors.New("nil pause handler")
 
+// ErrNilLimitedTransferHandler signals that nil limited transfer handler has been provided
+var ErrNilLimitedTransferHandler = errors.New("nil limited transfer handler")
+
+// ErrNilTokenTypeHandler signals that nil token type handler has been provided
+var ErrNilTokenTypeHandler = errors.New("nil token type handler")
+
+// ErrESDTTransferIsRestricted signals that the esdt token transfer is restricted for the account
+var ErrESDTTransferIsRestricted = errors.New("esdt token transfer is restricted for account")
+
 // ErrESDTTokenIsPaused signals that esdt token is paused
 var ErrESDTTokenIsPaused = errors.New("esdt token is paused")
 
@@ -928,3 +937,75 @@ var ErrMoreGasThanGasLimitPerBlock = errors.New("more gas was provided than gas
 
 // ErrNotEnoughGasInUserTx signals that not enough gas was provided in user tx
 var ErrNotEnoughGasInUserTx = errors.New("not enough gas provided in user tx")
+
+// ErrNilHistoricalStateHandler signals that a nil historical state handler has been provided
+var ErrNilHistoricalStateHandler = errors.New("nil historical state handler")
+
+// ErrStatePruned signals that the requested state has been pruned or is otherwise not available
+var ErrStatePruned = errors.New("state pruned or not available")
+
+// ErrHistoricalStateNotSupported signals that queries against historical states are not supported
+var ErrHistoricalStateNotSupported = errors.New("historical state queries are not supported")
+
+// ErrBlockNonceAndRootHashProvided signals that both a block nonce and a root hash have been provided
+var ErrBlockNonceAndRootHashProvided = errors.New("only one of block nonce and root hash can be provided")
+
+// ErrNilTrie signals that a nil trie has been provided
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrActionNotAllowed signals that action is not allowed
+var ErrActionNotAllowed = errors.New("action is not allowed")
+
+// ErrNFTTokenDoesNotExist signals that the NFT token with the given nonce does not exist
+var ErrNFTTokenDoesNotExist = errors.New("NFT token does not exist")
+
+// ErrInvalidNFTQuantity signals that an invalid NFT quantity has been provided
+var ErrInvalidNFTQuantity = errors.New("invalid NFT quantity")
+
+// ErrInvalidESDTTokenType signals that the token type is not a known non fungible or semi fungible type
+var ErrInvalidESDTTokenType = errors.New("invalid esdt token type")
+
+// ErrInvalidRoyalties signals that the provided royalties are invalid
+var ErrInvalidRoyalties = errors.New("invalid royalties")
+
+// ErrInvalidRcvAddr signals that an invalid receiver address has been provided
+var ErrInvalidRcvAddr = errors.New("invalid receiver address")
+
+// ErrGuardianFieldsOnUnguardedTx signals that guardian fields were provided on a transaction without the guarded option
+var ErrGuardianFieldsOnUnguardedTx = errors.New("guardian fields provided on a transaction that is not guarded")
+
+// ErrNilGuardianAddress signals that a guarded transaction does not hold a guardian address
+var ErrNilGuardianAddress = errors.New("nil guardian address")
+
+// ErrNilGuardianSignature signals that a guarded transaction does not hold a guardian signature
+var ErrNilGuardianSignature = errors.New("nil guardian signature")
+
+// ErrGuardianSameAsSender signals that the guardian address is the same as the sender address
+var ErrGuardianSameAsSender = errors.New("guardian address is the same as the sender address")
+
+// ErrInvalidGuardianSignature signals that the guardian signature is not valid
+var ErrInvalidGuardianSignature = errors.New("invalid guardian signature")
+
+// ErrNilGuardianChecker signals that a nil guardian checker has been provided
+var ErrNilGuardianChecker = errors.New("nil guardian checker")
+
+// ErrNilGuardedAccountHandler signals that a nil guarded account handler has been provided
+var ErrNilGuardedAccountHandler = errors.New("nil guarded account handler")
+
+// ErrGuardedTransactionRequired signals that a transaction from a guarded account is not co-signed by the guardian
+var ErrGuardedTransactionRequired = errors.New("account is guarded, transaction must be co-signed by the guardian")
+
+// ErrGuardianMismatch signals that the transaction guardian is not the active guardian of the sender
+var ErrGuardianMismatch = errors.New("transaction guardian does not match the active guardian of the account")
+
+// ErrAccountNotGuarded signals that a guarded transaction was sent from an account without an active guardian
+var ErrAccountNotGuarded = errors.New("account is not guarded")
+
+// ErrGuardedAccountsNotEnabled signals that a guardian operation was attempted before the guarded accounts were enabled
+var ErrGuardedAccountsNotEnabled = errors.New("guarded accounts are not enabled")
+
+// ErrNoGuardianToRemove signals that the account does not have a guardian to be removed
+var ErrNoGuardianToRemove = errors.New("account does not have a guardian to remove")
+
+// ErrBuiltInFunctionNotEnabled signals that a built in function was called before its activation epoch
+var ErrBuiltInFunctionNotEnabled = errors.New("built in function is not enabled")
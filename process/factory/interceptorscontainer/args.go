@@ -43,6 +43,7 @@ type ShardInterceptorsContainerFactoryArgs struct {
 	SizeCheckDelta            uint32
 	MinTransactionVersion     uint32
 	EnableSignTxWithHashEpoch uint32
+	GuardianEnableEpoch       uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
 }
@@ -79,6 +80,7 @@ type MetaInterceptorsContainerFactoryArgs struct {
 	MinTransactionVersion     uint32
 	SizeCheckDelta            uint32
 	EnableSignTxWithHashEpoch uint32
+	GuardianEnableEpoch       uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
 }
@@ -111,6 +111,7 @@ func NewMetaInterceptorsContainerFactory(
 		ChainID:                   args.ChainID,
 		MinTransactionVersion:     args.MinTransactionVersion,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		GuardianEnableEpoch:       args.GuardianEnableEpoch,
 		TxSignHasher:              args.TxSignHasher,
 		EpochNotifier:             args.EpochNotifier,
 	}
@@ -18,6 +18,15 @@ type BuiltInCost struct {
 	SaveKeyValue          uint64
 	ESDTTransfer          uint64
 	ESDTBurn              uint64
+	ESDTNFTCreate         uint64
+	ESDTNFTAddQuantity    uint64
+	ESDTNFTBurn           uint64
+	ESDTNFTTransfer       uint64
+	ESDTLocalMint         uint64
+	ESDTLocalBurn         uint64
+	ESDTMultiTransfer     uint64
+	SetGuardian           uint64
+	RemoveGuardian        uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
@@ -0,0 +1,195 @@
+package guardian
+
+import (
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/guardians"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("process/guardian")
+
+var _ process.GuardedAccountHandler = (*guardedAccount)(nil)
+
+var guardiansKey = []byte(core.ElrondProtectedKeyPrefix + core.GuardiansKeyIdentifier)
+
+// ArgsGuardedAccount defines the arguments needed for a new guarded account handler
+type ArgsGuardedAccount struct {
+	Marshalizer                   marshal.Marshalizer
+	EpochNotifier                 process.EpochNotifier
+	GuardianActivationEpochsDelay uint32
+}
+
+type guardedAccount struct {
+	marshalizer                   marshal.Marshalizer
+	guardianActivationEpochsDelay uint32
+	currentEpoch                  atomic.Uint32
+}
+
+// NewGuardedAccount creates a new handler for the guardians of user accounts. A guardian change, either a new
+// guardian or the removal of the current one, becomes active only after the configured number of epochs
+func NewGuardedAccount(args ArgsGuardedAccount) (*guardedAccount, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	ga := &guardedAccount{
+		marshalizer:                   args.Marshalizer,
+		guardianActivationEpochsDelay: args.GuardianActivationEpochsDelay,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(ga)
+
+	return ga, nil
+}
+
+// GetActiveGuardian returns the address of the guardian active in the current epoch or nil if the account is not guarded
+func (ga *guardedAccount) GetActiveGuardian(account state.UserAccountHandler) ([]byte, error) {
+	if check.IfNil(account) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	configured, err := ga.getGuardians(account)
+	if err != nil {
+		return nil, err
+	}
+
+	active := ga.getActiveGuardian(configured)
+	if active == nil {
+		return nil, nil
+	}
+
+	return active.Address, nil
+}
+
+// SetGuardian schedules the provided address as the account guardian, replacing any pending guardian change
+func (ga *guardedAccount) SetGuardian(account state.UserAccountHandler, guardianAddress []byte) error {
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+	if len(guardianAddress) == 0 {
+		return process.ErrNilGuardianAddress
+	}
+
+	configured, err := ga.getGuardians(account)
+	if err != nil {
+		return err
+	}
+
+	return ga.scheduleGuardianChange(account, configured, guardianAddress)
+}
+
+// RemoveGuardian schedules the removal of the account guardian, replacing any pending guardian change
+func (ga *guardedAccount) RemoveGuardian(account state.UserAccountHandler) error {
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+
+	configured, err := ga.getGuardians(account)
+	if err != nil {
+		return err
+	}
+
+	if !ga.hasActiveOrPendingGuardian(configured) {
+		return process.ErrNoGuardianToRemove
+	}
+
+	return ga.scheduleGuardianChange(account, configured, nil)
+}
+
+func (ga *guardedAccount) scheduleGuardianChange(
+	account state.UserAccountHandler,
+	configured *guardians.Guardians,
+	guardianAddress []byte,
+) error {
+	updated := &guardians.Guardians{Slice: make([]*guardians.Guardian, 0, 2)}
+	active := ga.getActiveGuardian(configured)
+	if active != nil && len(active.Address) > 0 {
+		updated.Slice = append(updated.Slice, active)
+	}
+	updated.Slice = append(updated.Slice, &guardians.Guardian{
+		Address:         guardianAddress,
+		ActivationEpoch: ga.currentEpoch.Get() + ga.guardianActivationEpochsDelay,
+	})
+
+	log.Trace("guardedAccount.scheduleGuardianChange",
+		"account", account.AddressBytes(),
+		"guardian", guardianAddress,
+		"activation epoch", updated.Slice[len(updated.Slice)-1].ActivationEpoch,
+	)
+
+	return ga.saveGuardians(account, updated)
+}
+
+func (ga *guardedAccount) getActiveGuardian(configured *guardians.Guardians) *guardians.Guardian {
+	currentEpoch := ga.currentEpoch.Get()
+
+	var active *guardians.Guardian
+	for _, g := range configured.Slice {
+		if g.ActivationEpoch > currentEpoch {
+			continue
+		}
+		if active == nil || g.ActivationEpoch >= active.ActivationEpoch {
+			active = g
+		}
+	}
+
+	return active
+}
+
+func (ga *guardedAccount) hasActiveOrPendingGuardian(configured *guardians.Guardians) bool {
+	active := ga.getActiveGuardian(configured)
+	if active != nil && len(active.Address) > 0 {
+		return true
+	}
+
+	currentEpoch := ga.currentEpoch.Get()
+	for _, g := range configured.Slice {
+		isPending := g.ActivationEpoch > currentEpoch
+		if isPending && len(g.Address) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ga *guardedAccount) getGuardians(account state.UserAccountHandler) (*guardians.Guardians, error) {
+	configured := &guardians.Guardians{}
+	marshaledData, err := account.DataTrieTracker().RetrieveValue(guardiansKey)
+	if err != nil || len(marshaledData) == 0 {
+		return configured, nil
+	}
+
+	err = ga.marshalizer.Unmarshal(configured, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return configured, nil
+}
+
+func (ga *guardedAccount) saveGuardians(account state.UserAccountHandler, configured *guardians.Guardians) error {
+	marshaledData, err := ga.marshalizer.Marshal(configured)
+	if err != nil {
+		return err
+	}
+
+	return account.DataTrieTracker().SaveKeyValue(guardiansKey, marshaledData)
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (ga *guardedAccount) EpochConfirmed(epoch uint32) {
+	ga.currentEpoch.Set(epoch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ga *guardedAccount) IsInterfaceNil() bool {
+	return ga == nil
+}
@@ -0,0 +1,183 @@
+package guardian
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/guardians"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsGuardedAccount() ArgsGuardedAccount {
+	return ArgsGuardedAccount{
+		Marshalizer:                   &mock.MarshalizerMock{},
+		EpochNotifier:                 &mock.EpochNotifierStub{},
+		GuardianActivationEpochsDelay: 10,
+	}
+}
+
+func createGuardedAccountHandler(t *testing.T) *guardedAccount {
+	ga, err := NewGuardedAccount(createMockArgsGuardedAccount())
+	require.Nil(t, err)
+
+	return ga
+}
+
+func TestNewGuardedAccount_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGuardedAccount()
+	args.Marshalizer = nil
+	ga, err := NewGuardedAccount(args)
+
+	assert.True(t, check.IfNil(ga))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewGuardedAccount_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGuardedAccount()
+	args.EpochNotifier = nil
+	ga, err := NewGuardedAccount(args)
+
+	assert.True(t, check.IfNil(ga))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestNewGuardedAccount_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	registerWasCalled := false
+	args := createMockArgsGuardedAccount()
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler core.EpochSubscriberHandler) {
+			registerWasCalled = true
+		},
+	}
+	ga, err := NewGuardedAccount(args)
+
+	assert.False(t, check.IfNil(ga))
+	assert.Nil(t, err)
+	assert.True(t, registerWasCalled)
+}
+
+func TestGuardedAccount_NilAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ga := createGuardedAccountHandler(t)
+
+	guardian, err := ga.GetActiveGuardian(nil)
+	assert.Nil(t, guardian)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+	assert.Equal(t, process.ErrNilUserAccount, ga.SetGuardian(nil, []byte("guardian")))
+	assert.Equal(t, process.ErrNilUserAccount, ga.RemoveGuardian(nil))
+}
+
+func TestGuardedAccount_GetActiveGuardianUnguardedAccount(t *testing.T) {
+	t.Parallel()
+
+	ga := createGuardedAccountHandler(t)
+	account, _ := state.NewUserAccount([]byte("account"))
+
+	guardian, err := ga.GetActiveGuardian(account)
+	assert.Nil(t, err)
+	assert.Nil(t, guardian)
+}
+
+func TestGuardedAccount_SetGuardianEmptyAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ga := createGuardedAccountHandler(t)
+	account, _ := state.NewUserAccount([]byte("account"))
+
+	err := ga.SetGuardian(account, nil)
+	assert.Equal(t, process.ErrNilGuardianAddress, err)
+}
+
+func TestGuardedAccount_SetGuardianShouldActivateAfterDelay(t *testing.T) {
+	t.Parallel()
+
+	ga := createGuardedAccountHandler(t)
+	account, _ := state.NewUserAccount([]byte("account"))
+	ga.EpochConfirmed(5)
+
+	err := ga.SetGuardian(account, []byte("guardian"))
+	require.Nil(t, err)
+
+	ga.EpochConfirmed(14)
+	guardian, _ := ga.GetActiveGuardian(account)
+	assert.Nil(t, guardian)
+
+	ga.EpochConfirmed(15)
+	guardian, _ = ga.GetActiveGuardian(account)
+	assert.Equal(t, []byte("guardian"), guardian)
+}
+
+func TestGuardedAccount_SetGuardianShouldReplacePendingGuardian(t *testing.T) {
+	t.Parallel()
+
+	ga := createGuardedAccountHandler(t)
+	account, _ := state.NewUserAccount([]byte("account"))
+
+	_ = ga.SetGuardian(account, []byte("guardian1"))
+	ga.EpochConfirmed(10)
+	_ = ga.SetGuardian(account, []byte("guardian2"))
+	ga.EpochConfirmed(12)
+	_ = ga.SetGuardian(account, []byte("guardian3"))
+
+	guardian, _ := ga.GetActiveGuardian(account)
+	assert.Equal(t, []byte("guardian1"), guardian)
+
+	configured, _ := ga.getGuardians(account)
+	expected := &guardians.Guardians{
+		Slice: []*guardians.Guardian{
+			{Address: []byte("guardian1"), ActivationEpoch: 10},
+			{Address: []byte("guardian3"), ActivationEpoch: 22},
+		},
+	}
+	assert.Equal(t, expected, configured)
+
+	ga.EpochConfirmed(22)
+	guardian, _ = ga.GetActiveGuardian(account)
+	assert.Equal(t, []byte("guardian3"), guardian)
+}
+
+func TestGuardedAccount_RemoveGuardianWithoutGuardianShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ga := createGuardedAccountHandler(t)
+	account, _ := state.NewUserAccount([]byte("account"))
+
+	err := ga.RemoveGuardian(account)
+	assert.Equal(t, process.ErrNoGuardianToRemove, err)
+}
+
+func TestGuardedAccount_RemoveGuardianShouldApplyAfterCooldown(t *testing.T) {
+	t.Parallel()
+
+	ga := createGuardedAccountHandler(t)
+	account, _ := state.NewUserAccount([]byte("account"))
+
+	_ = ga.SetGuardian(account, []byte("guardian"))
+	ga.EpochConfirmed(10)
+
+	err := ga.RemoveGuardian(account)
+	require.Nil(t, err)
+
+	ga.EpochConfirmed(19)
+	guardian, _ := ga.GetActiveGuardian(account)
+	assert.Equal(t, []byte("guardian"), guardian)
+
+	ga.EpochConfirmed(20)
+	guardian, _ = ga.GetActiveGuardian(account)
+	assert.Empty(t, guardian)
+
+	err = ga.RemoveGuardian(account)
+	assert.Equal(t, process.ErrNoGuardianToRemove, err)
+}
@@ -33,6 +33,7 @@ type ArgInterceptedDataFactory struct {
 	ChainID                   []byte
 	MinTransactionVersion     uint32
 	EnableSignTxWithHashEpoch uint32
+	GuardianEnableEpoch       uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
 }
@@ -32,10 +32,12 @@ type interceptedTxDataFactory struct {
 	chainID                     []byte
 	minTransactionVersion       uint32
 	enableSignedTxWithHashEpoch uint32
+	enableGuardedTxEpoch        uint32
 	epochStartTrigger           process.EpochStartTriggerHandler
 	txSignHasher                hashing.Hasher
 	txVersionChecker            process.TxVersionCheckerHandler
 	flagEnableSignedTxWithHash  atomic.Flag
+	flagEnableGuardedTx         atomic.Flag
 }
 
 // NewInterceptedTxDataFactory creates an instance of interceptedTxDataFactory
@@ -104,6 +106,7 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 		minTransactionVersion:       argument.MinTransactionVersion,
 		epochStartTrigger:           argument.EpochStartTrigger,
 		enableSignedTxWithHashEpoch: argument.EnableSignTxWithHashEpoch,
+		enableGuardedTxEpoch:        argument.GuardianEnableEpoch,
 		txSignHasher:                argument.TxSignHasher,
 		txVersionChecker:            versioning.NewTxVersionChecker(argument.MinTransactionVersion),
 	}
@@ -129,6 +132,7 @@ func (itdf *interceptedTxDataFactory) Create(buff []byte) (process.InterceptedDa
 		itdf.argsParser,
 		itdf.chainID,
 		itdf.flagEnableSignedTxWithHash.IsSet(),
+		itdf.flagEnableGuardedTx.IsSet(),
 		itdf.txSignHasher,
 		itdf.txVersionChecker,
 	)
@@ -143,4 +147,7 @@ func (itdf *interceptedTxDataFactory) IsInterfaceNil() bool {
 func (itdf *interceptedTxDataFactory) EpochConfirmed(epoch uint32) {
 	itdf.flagEnableSignedTxWithHash.Toggle(epoch >= itdf.enableSignedTxWithHashEpoch)
 	log.Debug("interceptors: transaction signed with hash", "enabled", itdf.flagEnableSignedTxWithHash.IsSet())
+
+	itdf.flagEnableGuardedTx.Toggle(epoch >= itdf.enableGuardedTxEpoch)
+	log.Debug("interceptors: guarded transaction", "enabled", itdf.flagEnableGuardedTx.IsSet())
 }
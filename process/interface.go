@@ -70,10 +70,19 @@ type TxValidatorHandler interface {
 // TxVersionCheckerHandler defines the functionality that is needed for a TxVersionChecker to validate transaction version
 type TxVersionCheckerHandler interface {
 	IsSignedWithHash(tx *transaction.Transaction) bool
+	IsGuardedTransaction(tx *transaction.Transaction) bool
 	CheckTxVersion(tx *transaction.Transaction) error
 	IsInterfaceNil() bool
 }
 
+// GuardedAccountHandler can read and schedule changes of the guardian protecting a user account
+type GuardedAccountHandler interface {
+	GetActiveGuardian(account state.UserAccountHandler) ([]byte, error)
+	SetGuardian(account state.UserAccountHandler, guardianAddress []byte) error
+	RemoveGuardian(account state.UserAccountHandler) error
+	IsInterfaceNil() bool
+}
+
 // HdrValidatorHandler defines the functionality that is needed for a HdrValidator to validate a header
 type HdrValidatorHandler interface {
 	Hash() []byte
@@ -659,13 +668,30 @@ type NetworkConnectionWatcher interface {
 	IsInterfaceNil() bool
 }
 
-// SCQuery represents a prepared query for executing a function of the smart contract
+// SCQuery represents a prepared query for executing a function of the smart contract. The query is run against
+// the latest state unless a block nonce or a state root hash is provided
 type SCQuery struct {
-	ScAddress  []byte
-	FuncName   string
-	CallerAddr []byte
-	CallValue  *big.Int
-	Arguments  [][]byte
+	ScAddress     []byte
+	FuncName      string
+	CallerAddr    []byte
+	CallValue     *big.Int
+	Arguments     [][]byte
+	BlockNonce    uint64
+	HasBlockNonce bool
+	RootHash      []byte
+}
+
+// IsHistorical returns true if the query should be run against the state of a past block
+func (query *SCQuery) IsHistorical() bool {
+	return query.HasBlockNonce || len(query.RootHash) > 0
+}
+
+// HistoricalStateHandler is able to switch the state read by the SC query service to the state of a past block
+type HistoricalStateHandler interface {
+	SetStateForBlockNonce(nonce uint64) (data.HeaderHandler, error)
+	SetStateForRootHash(rootHash []byte) error
+	ResetState()
+	IsInterfaceNil() bool
 }
 
 // GasHandler is able to perform some gas calculation
@@ -1000,6 +1026,18 @@ type ESDTPauseHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ESDTLimitedTransferHandler provides IsLimitedTransfer function for an ESDT token
+type ESDTLimitedTransferHandler interface {
+	IsLimitedTransfer(token []byte) bool
+	IsInterfaceNil() bool
+}
+
+// ESDTTokenTypeHandler provides GetTokenType function for an ESDT token
+type ESDTTokenTypeHandler interface {
+	GetTokenType(token []byte) core.ESDTType
+	IsInterfaceNil() bool
+}
+
 // PayableHandler provides IsPayable function which returns if an account is payable or not
 type PayableHandler interface {
 	IsPayable(address []byte) (bool, error)
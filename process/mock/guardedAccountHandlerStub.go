@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GuardedAccountHandlerStub -
+type GuardedAccountHandlerStub struct {
+	GetActiveGuardianCalled func(account state.UserAccountHandler) ([]byte, error)
+	SetGuardianCalled       func(account state.UserAccountHandler, guardianAddress []byte) error
+	RemoveGuardianCalled    func(account state.UserAccountHandler) error
+}
+
+// GetActiveGuardian -
+func (gahs *GuardedAccountHandlerStub) GetActiveGuardian(account state.UserAccountHandler) ([]byte, error) {
+	if gahs.GetActiveGuardianCalled != nil {
+		return gahs.GetActiveGuardianCalled(account)
+	}
+
+	return nil, nil
+}
+
+// SetGuardian -
+func (gahs *GuardedAccountHandlerStub) SetGuardian(account state.UserAccountHandler, guardianAddress []byte) error {
+	if gahs.SetGuardianCalled != nil {
+		return gahs.SetGuardianCalled(account, guardianAddress)
+	}
+
+	return nil
+}
+
+// RemoveGuardian -
+func (gahs *GuardedAccountHandlerStub) RemoveGuardian(account state.UserAccountHandler) error {
+	if gahs.RemoveGuardianCalled != nil {
+		return gahs.RemoveGuardianCalled(account)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (gahs *GuardedAccountHandlerStub) IsInterfaceNil() bool {
+	return gahs == nil
+}
@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// HistoricalStateHandlerStub -
+type HistoricalStateHandlerStub struct {
+	SetStateForBlockNonceCalled func(nonce uint64) (data.HeaderHandler, error)
+	SetStateForRootHashCalled   func(rootHash []byte) error
+	ResetStateCalled            func()
+}
+
+// SetStateForBlockNonce -
+func (h *HistoricalStateHandlerStub) SetStateForBlockNonce(nonce uint64) (data.HeaderHandler, error) {
+	if h.SetStateForBlockNonceCalled != nil {
+		return h.SetStateForBlockNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// SetStateForRootHash -
+func (h *HistoricalStateHandlerStub) SetStateForRootHash(rootHash []byte) error {
+	if h.SetStateForRootHashCalled != nil {
+		return h.SetStateForRootHashCalled(rootHash)
+	}
+
+	return nil
+}
+
+// ResetState -
+func (h *HistoricalStateHandlerStub) ResetState() {
+	if h.ResetStateCalled != nil {
+		h.ResetStateCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (h *HistoricalStateHandlerStub) IsInterfaceNil() bool {
+	return h == nil
+}
@@ -0,0 +1,19 @@
+package mock
+
+// LimitedTransferHandlerStub -
+type LimitedTransferHandlerStub struct {
+	IsLimitedTransferCalled func(token []byte) bool
+}
+
+// IsLimitedTransfer -
+func (l *LimitedTransferHandlerStub) IsLimitedTransfer(token []byte) bool {
+	if l.IsLimitedTransferCalled != nil {
+		return l.IsLimitedTransferCalled(token)
+	}
+	return false
+}
+
+// IsInterfaceNil -
+func (l *LimitedTransferHandlerStub) IsInterfaceNil() bool {
+	return l == nil
+}
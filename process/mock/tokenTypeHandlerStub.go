@@ -0,0 +1,21 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// TokenTypeHandlerStub -
+type TokenTypeHandlerStub struct {
+	GetTokenTypeCalled func(token []byte) core.ESDTType
+}
+
+// GetTokenType -
+func (t *TokenTypeHandlerStub) GetTokenType(token []byte) core.ESDTType {
+	if t.GetTokenTypeCalled != nil {
+		return t.GetTokenTypeCalled(token)
+	}
+	return core.NonFungible
+}
+
+// IsInterfaceNil -
+func (t *TokenTypeHandlerStub) IsInterfaceNil() bool {
+	return t == nil
+}
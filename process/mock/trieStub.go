@@ -10,6 +10,7 @@ import (
 // TrieStub -
 type TrieStub struct {
 	GetCalled                   func(key []byte) ([]byte, error)
+	GetProofCalled              func(key []byte) ([][]byte, error)
 	UpdateCalled                func(key, value []byte) error
 	DeleteCalled                func(key []byte) error
 	RootCalled                  func() ([]byte, error)
@@ -24,6 +25,7 @@ type TrieStub struct {
 	GetAllHashesCalled          func() ([][]byte, error)
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
+	GetDiffCalled               func(fromRootHash []byte, toRootHash []byte, handler func(entry *data.TrieDiffEntry) bool) error
 }
 
 // EnterPruningBufferingMode -
@@ -54,6 +56,15 @@ func (ts *TrieStub) GetAllLeavesOnChannel(rootHash []byte, _ context.Context) (c
 	return ch, nil
 }
 
+// GetDiff -
+func (ts *TrieStub) GetDiff(fromRootHash []byte, toRootHash []byte, handler func(entry *data.TrieDiffEntry) bool) error {
+	if ts.GetDiffCalled != nil {
+		return ts.GetDiffCalled(fromRootHash, toRootHash, handler)
+	}
+
+	return nil
+}
+
 // IsPruningEnabled -
 func (ts *TrieStub) IsPruningEnabled() bool {
 	return false
@@ -73,6 +84,15 @@ func (ts *TrieStub) Get(key []byte) ([]byte, error) {
 	return nil, errNotImplemented
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, errNotImplemented
+}
+
 // Update -
 func (ts *TrieStub) Update(key, value []byte) error {
 	if ts.UpdateCalled != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -68,7 +69,17 @@ func (c *changeOwnerAddress) ProcessBuiltinFunction(
 		return nil, err
 	}
 
-	return &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}, nil
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(core.BuiltInFunctionChangeOwnerAddress),
+		Address:    acntDst.AddressBytes(),
+		Topics:     [][]byte{vmInput.CallerAddr, vmInput.Arguments[0]},
+	}
+
+	return &vmcommon.VMOutput{
+		GasRemaining: gasRemaining,
+		ReturnCode:   vmcommon.Ok,
+		Logs:         []*vmcommon.LogEntry{logEntry},
+	}, nil
 }
 
 func computeGasRemaining(snd state.UserAccountHandler, gasProvided uint64, gasToUse uint64) uint64 {
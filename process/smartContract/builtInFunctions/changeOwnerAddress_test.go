@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/data/state"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,10 @@ func TestChangeOwnerAddress_ProcessBuiltinFunction(t *testing.T) {
 	vmOutput, err = coa.ProcessBuiltinFunction(nil, acc, vmInput)
 	require.Nil(t, err)
 	require.Equal(t, vmOutput.GasRemaining, uint64(0))
+	require.Equal(t, 1, len(vmOutput.Logs))
+	require.Equal(t, []byte(core.BuiltInFunctionChangeOwnerAddress), vmOutput.Logs[0].Identifier)
+	require.Equal(t, addr, vmOutput.Logs[0].Address)
+	require.Equal(t, [][]byte{owner, newAddr}, vmOutput.Logs[0].Topics)
 
 	coa.gasCost = 1
 	vmInput.GasProvided = 10
@@ -63,7 +63,17 @@ func (c *claimDeveloperRewards) ProcessBuiltinFunction(
 		return nil, err
 	}
 
-	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(core.BuiltInFunctionClaimDeveloperRewards),
+		Address:    acntDst.AddressBytes(),
+		Topics:     [][]byte{vmInput.CallerAddr, value.Bytes()},
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining: gasRemaining,
+		ReturnCode:   vmcommon.Ok,
+		Logs:         []*vmcommon.LogEntry{logEntry},
+	}
 	outTransfer := vmcommon.OutputTransfer{
 		Value:    big.NewInt(0).Set(value),
 		GasLimit: 0,
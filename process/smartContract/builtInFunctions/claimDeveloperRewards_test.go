@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -44,6 +45,9 @@ func TestClaimDeveloperRewards_ProcessBuiltinFunction(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, value, vmOutput.OutputAccounts[string(vmInput.CallerAddr)].BalanceDelta)
 	require.Equal(t, uint64(0), vmOutput.GasRemaining)
+	require.Equal(t, 1, len(vmOutput.Logs))
+	require.Equal(t, []byte(core.BuiltInFunctionClaimDeveloperRewards), vmOutput.Logs[0].Identifier)
+	require.Equal(t, [][]byte{sender, value.Bytes()}, vmOutput.Logs[0].Topics)
 
 	acc.OwnerAddress = sender
 	acc.AddToDeveloperReward(value)
@@ -0,0 +1,134 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtLimitedTransfer)(nil)
+
+type esdtLimitedTransfer struct {
+	keyPrefix   []byte
+	set         bool
+	accounts    state.AccountsAdapter
+	enableEpoch uint32
+	flagEnabled atomic.Flag
+}
+
+// NewESDTLimitedTransferFunc returns the esdt set/unset limited transfer built-in function component
+func NewESDTLimitedTransferFunc(
+	accounts state.AccountsAdapter,
+	set bool,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtLimitedTransfer, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtLimitedTransfer{
+		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		set:         set,
+		accounts:    accounts,
+		enableEpoch: enableEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtLimitedTransfer) SetNewGasConfig(_ *process.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves ESDT set/unset limited transfer function call
+func (e *esdtLimitedTransfer) ProcessBuiltinFunction(
+	_, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionNotEnabled
+	}
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if !core.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, process.ErrOnlySystemAccountAccepted
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	log.Trace(vmInput.Function, "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "token", esdtTokenKey)
+
+	err := e.setLimitedTransfer(esdtTokenKey)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func (e *esdtLimitedTransfer) setLimitedTransfer(token []byte) error {
+	systemSCAccount, err := getSystemAccount(e.accounts)
+	if err != nil {
+		return err
+	}
+
+	val, _ := systemSCAccount.DataTrieTracker().RetrieveValue(token)
+	esdtMetaData := ESDTGlobalMetadataFromBytes(val)
+	esdtMetaData.LimitedTransfer = e.set
+	err = systemSCAccount.DataTrieTracker().SaveKeyValue(token, esdtMetaData.ToBytes())
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+// IsLimitedTransfer returns true if the transfer of the token is restricted to the addresses holding the transfer role
+func (e *esdtLimitedTransfer) IsLimitedTransfer(tokenKey []byte) bool {
+	systemSCAccount, err := getSystemAccount(e.accounts)
+	if err != nil {
+		return false
+	}
+
+	val, _ := systemSCAccount.DataTrieTracker().RetrieveValue(tokenKey)
+	if len(val) != lengthOfESDTMetadata {
+		return false
+	}
+	esdtMetaData := ESDTGlobalMetadataFromBytes(val)
+
+	return esdtMetaData.LimitedTransfer
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtLimitedTransfer) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("built in function: esdt set/unset limited transfer", "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtLimitedTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,84 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTLimitedTransferFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	limitedTransferFunc, err := NewESDTLimitedTransferFunc(nil, true, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, limitedTransferFunc)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewESDTLimitedTransferFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	limitedTransferFunc, err := NewESDTLimitedTransferFunc(&mock.AccountsStub{}, true, 0, nil)
+	assert.Nil(t, limitedTransferFunc)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestESDTLimitedTransfer_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt, _ := state.NewUserAccount(core.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+	setFunc, _ := NewESDTLimitedTransferFunc(accounts, true, 0, &mock.EpochNotifierStub{})
+	_, err := setFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	key := []byte("key")
+	input.Arguments = [][]byte{key}
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vm.ESDTSCAddress
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrOnlySystemAccountAccepted, err)
+
+	input.RecipientAddr = core.SystemAccountAddress
+	_, err = setFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+
+	tokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(key))
+	assert.True(t, setFunc.IsLimitedTransfer(tokenKey))
+
+	pauseFunc, _ := NewESDTPauseFunc(accounts, true)
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.True(t, pauseFunc.IsPaused(tokenKey))
+	assert.True(t, setFunc.IsLimitedTransfer(tokenKey))
+
+	unSetFunc, _ := NewESDTLimitedTransferFunc(accounts, false, 0, &mock.EpochNotifierStub{})
+	_, err = unSetFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.False(t, setFunc.IsLimitedTransfer(tokenKey))
+	assert.True(t, pauseFunc.IsPaused(tokenKey))
+}
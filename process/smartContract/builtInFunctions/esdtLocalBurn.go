@@ -0,0 +1,123 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtLocalBurn)(nil)
+
+type esdtLocalBurn struct {
+	keyPrefix    []byte
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	funcGasCost  uint64
+	mutExecution sync.RWMutex
+	enableEpoch  uint32
+	flagEnabled  atomic.Flag
+}
+
+// NewESDTLocalBurnFunc returns the esdt local burn built-in function component
+func NewESDTLocalBurnFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtLocalBurn, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtLocalBurn{
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		funcGasCost:  funcGasCost,
+		enableEpoch:  enableEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtLocalBurn) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTLocalBurn
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT local burn function call
+// Requires 2 arguments:
+// arg0 - token identifier
+// arg1 - value to burn
+func (e *esdtLocalBurn) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionNotEnabled
+	}
+
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkInputArgumentsForLocalAction(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 2 {
+		return nil, fmt.Errorf("%w, wrong number of arguments", process.ErrInvalidArguments)
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkAllowedToExecute(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleLocalBurn))
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	return vmOutput, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtLocalBurn) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("built in function: esdt local burn", "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtLocalBurn) IsInterfaceNil() bool {
+	return e == nil
+}
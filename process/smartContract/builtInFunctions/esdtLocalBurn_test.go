@@ -0,0 +1,59 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTLocalBurnFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	localBurn, err := NewESDTLocalBurnFunc(0, nil, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localBurn)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	localBurn, err = NewESDTLocalBurnFunc(0, &mock.MarshalizerMock{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localBurn)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+}
+
+func TestEsdtLocalBurn_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	pauseHandler := &mock.PauseHandlerStub{}
+	localBurn, _ := NewESDTLocalBurnFunc(10, marshalizer, pauseHandler, 0, &mock.EpochNotifierStub{})
+	localMint, _ := NewESDTLocalMintFunc(10, marshalizer, pauseHandler, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	acnt := createUserAccountWithEmptyDataTrie([]byte("caller"))
+
+	input := createLocalRoleInput(acnt.AddressBytes(), tokenID, 10)
+	_, err := localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn)
+	_, err = localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, createLocalRoleInput(acnt.AddressBytes(), tokenID, 30))
+	require.Nil(t, err)
+
+	pauseHandler.IsPausedCalled = func(_ []byte) bool {
+		return true
+	}
+	_, err = localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+
+	pauseHandler.IsPausedCalled = nil
+	_, err = localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+
+	esdtData, _ := getESDTDataFromKey(acnt, append(localBurn.keyPrefix, tokenID...), marshalizer)
+	assert.Equal(t, big.NewInt(20), esdtData.Value)
+}
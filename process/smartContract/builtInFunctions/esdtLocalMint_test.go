@@ -0,0 +1,104 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLocalRoleInput(caller []byte, tokenID []byte, value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments:   [][]byte{tokenID, big.NewInt(value).Bytes()},
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func TestNewESDTLocalMintFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	localMint, err := NewESDTLocalMintFunc(0, nil, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localMint)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	localMint, err = NewESDTLocalMintFunc(0, &mock.MarshalizerMock{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, localMint)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	localMint, err = NewESDTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 0, nil)
+	assert.Nil(t, localMint)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestEsdtLocalMint_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	localMint, _ := NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 1, &mock.EpochNotifierStub{})
+	acnt := createUserAccountWithEmptyDataTrie([]byte("caller"))
+
+	output, err := localMint.ProcessBuiltinFunction(acnt, nil, createLocalRoleInput(acnt.AddressBytes(), []byte("token"), 10))
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrBuiltInFunctionNotEnabled, err)
+}
+
+func TestEsdtLocalMint_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	localMint, _ := NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	acnt := createUserAccountWithEmptyDataTrie([]byte("caller"))
+
+	_, err := localMint.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createLocalRoleInput(acnt.AddressBytes(), tokenID, 10)
+	input.RecipientAddr = []byte("other")
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createLocalRoleInput(acnt.AddressBytes(), tokenID, 10)
+	input.GasProvided = localMint.funcGasCost - 1
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input = createLocalRoleInput(acnt.AddressBytes(), tokenID, 10)
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleLocalBurn)
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleLocalMint)
+	input = createLocalRoleInput(acnt.AddressBytes(), tokenID, 0)
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+}
+
+func TestEsdtLocalMint_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	localMint, _ := NewESDTLocalMintFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	acnt := createUserAccountWithEmptyDataTrie([]byte("caller"))
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleLocalMint)
+
+	input := createLocalRoleInput(acnt.AddressBytes(), tokenID, 100)
+	vmOutput, err := localMint.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-localMint.funcGasCost, vmOutput.GasRemaining)
+
+	esdtData, _ := getESDTDataFromKey(acnt, append(localMint.keyPrefix, tokenID...), marshalizer)
+	assert.Equal(t, big.NewInt(100), esdtData.Value)
+}
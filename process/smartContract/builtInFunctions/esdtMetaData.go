@@ -1,10 +1,14 @@
 package builtInFunctions
 
+import "github.com/ElrondNetwork/elrond-go/core"
+
 const lengthOfESDTMetadata = 2
 
 const (
 	// MetadataPaused is the location of paused flag in the esdt global meta data
 	MetadataPaused = 1
+	// MetadataLimitedTransfer is the location of limited transfer flag in the esdt global meta data
+	MetadataLimitedTransfer = 2
 )
 
 const (
@@ -14,7 +18,9 @@ const (
 
 // ESDTGlobalMetadata represents esdt global metadata saved on system account
 type ESDTGlobalMetadata struct {
-	Paused bool
+	Paused          bool
+	LimitedTransfer bool
+	TokenType       core.ESDTType
 }
 
 // ESDTGlobalMetadataFromBytes creates a metadata object from bytes
@@ -24,7 +30,9 @@ func ESDTGlobalMetadataFromBytes(bytes []byte) ESDTGlobalMetadata {
 	}
 
 	return ESDTGlobalMetadata{
-		Paused: (bytes[0] & MetadataPaused) != 0,
+		Paused:          (bytes[0] & MetadataPaused) != 0,
+		LimitedTransfer: (bytes[0] & MetadataLimitedTransfer) != 0,
+		TokenType:       core.ESDTType(bytes[1]),
 	}
 }
 
@@ -35,6 +43,10 @@ func (metadata *ESDTGlobalMetadata) ToBytes() []byte {
 	if metadata.Paused {
 		bytes[0] |= MetadataPaused
 	}
+	if metadata.LimitedTransfer {
+		bytes[0] |= MetadataLimitedTransfer
+	}
+	bytes[1] = byte(metadata.TokenType)
 
 	return bytes
 }
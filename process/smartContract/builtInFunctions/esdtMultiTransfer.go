@@ -0,0 +1,358 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtMultiTransfer)(nil)
+
+type esdtMultiTransfer struct {
+	funcGasCost            uint64
+	marshalizer            marshal.Marshalizer
+	keyPrefix              []byte
+	pauseHandler           process.ESDTPauseHandler
+	limitedTransferHandler process.ESDTLimitedTransferHandler
+	payableHandler         process.PayableHandler
+	mutExecution           sync.RWMutex
+	enableEpoch            uint32
+	flagEnabled            atomic.Flag
+
+	limitedTransferEnableEpoch uint32
+	flagLimitedTransfer        atomic.Flag
+}
+
+type esdtTransferLeg struct {
+	tokenID  []byte
+	tokenKey []byte
+	value    *big.Int
+}
+
+type esdtTokenBalance struct {
+	tokenKey []byte
+	esdtData *esdt.ESDigitalToken
+}
+
+// NewESDTMultiTransferFunc returns the esdt multi transfer built-in function component
+func NewESDTMultiTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	limitedTransferHandler process.ESDTLimitedTransferHandler,
+	enableEpoch uint32,
+	limitedTransferEnableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtMultiTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(limitedTransferHandler) {
+		return nil, process.ErrNilLimitedTransferHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtMultiTransfer{
+		funcGasCost:            funcGasCost,
+		marshalizer:            marshalizer,
+		keyPrefix:              []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		pauseHandler:           pauseHandler,
+		limitedTransferHandler: limitedTransferHandler,
+		payableHandler:         &disabledPayableHandler{},
+		enableEpoch:            enableEpoch,
+
+		limitedTransferEnableEpoch: limitedTransferEnableEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtMultiTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTMultiTransfer
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT multi transfer function calls
+// Requires at least 3 arguments:
+// arg0 - number of transfers
+// arg1, arg2 - token identifier and value of the first transfer, followed by the rest of the transfers
+// after the transfers - optional function to call on the destination smart contract and its arguments
+// All transfers are executed atomically - if one of them fails, none of them is applied
+func (e *esdtMultiTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionNotEnabled
+	}
+
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+
+	legs, err := e.parseTransferLegs(vmInput.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	numArgsForTransfers := 1 + 2*len(legs)
+	gasToUse := e.funcGasCost * uint64(len(legs))
+	gasRemaining := computeGasRemaining(acntSnd, vmInput.GasProvided, gasToUse)
+
+	isSCCallAfter := core.IsSmartContractAddress(vmInput.RecipientAddr) && len(vmInput.Arguments) > numArgsForTransfers
+
+	// all the legs are validated on both accounts before any balance is changed so a failing leg leaves no
+	// partial transfer in the accounts' data tries
+	var senderTokens, destinationTokens []*esdtTokenBalance
+	if !check.IfNil(acntSnd) {
+		// gas is paid only by sender
+		if vmInput.GasProvided < gasToUse {
+			return nil, process.ErrNotEnoughGas
+		}
+
+		senderTokens, err = e.computeNewBalances(vmInput, acntSnd, legs, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !check.IfNil(acntDst) {
+		mustVerifyPayable := vmInput.CallType != vmcommon.AsynchronousCallBack && !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress)
+		if mustVerifyPayable && !isSCCallAfter {
+			isPayable, errPayable := e.payableHandler.IsPayable(vmInput.RecipientAddr)
+			if errPayable != nil {
+				return nil, errPayable
+			}
+			if !isPayable {
+				return nil, process.ErrAccountNotPayable
+			}
+		}
+
+		destinationTokens, err = e.computeNewBalances(vmInput, acntDst, legs, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// both balances were computed from the same data trie in the case of a transfer to self, which leaves it unchanged
+	isTransferToSelf := !check.IfNil(acntSnd) && !check.IfNil(acntDst) && bytes.Equal(acntSnd.AddressBytes(), acntDst.AddressBytes())
+	if !isTransferToSelf {
+		err = e.saveBalances(acntSnd, senderTokens)
+		if err != nil {
+			return nil, err
+		}
+		err = e.saveBalances(acntDst, destinationTokens)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}
+	if !check.IfNil(acntDst) {
+		if isSCCallAfter {
+			var callArgs [][]byte
+			if len(vmInput.Arguments) > numArgsForTransfers+1 {
+				callArgs = vmInput.Arguments[numArgsForTransfers+1:]
+			}
+
+			addOutPutTransferToVMOutput(
+				string(vmInput.Arguments[numArgsForTransfers]),
+				callArgs,
+				vmInput.RecipientAddr,
+				vmInput.GasLocked,
+				vmOutput)
+		}
+
+		return vmOutput, nil
+	}
+
+	// cross-shard ESDT multi transfer call through a smart contract
+	if core.IsSmartContractAddress(vmInput.CallerAddr) {
+		addOutPutTransferToVMOutput(
+			core.BuiltInFunctionESDTMultiTransfer,
+			vmInput.Arguments,
+			vmInput.RecipientAddr,
+			vmInput.GasLocked,
+			vmOutput)
+	}
+
+	if isSCCallAfter {
+		vmOutput.GasRemaining = 0
+	}
+
+	return vmOutput, nil
+}
+
+// computeNewBalances checks all the legs against the provided account and returns the resulting balances,
+// one for each distinct token, without saving them
+func (e *esdtMultiTransfer) computeNewBalances(
+	vmInput *vmcommon.ContractCallInput,
+	userAcnt state.UserAccountHandler,
+	legs []*esdtTransferLeg,
+	isSender bool,
+) ([]*esdtTokenBalance, error) {
+	tokens := make([]*esdtTokenBalance, 0, len(legs))
+	tokensByKey := make(map[string]*esdtTokenBalance)
+	for _, leg := range legs {
+		if isSender {
+			log.Trace("esdtMultiTransfer", "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "value", leg.value, "token", leg.tokenKey)
+		}
+
+		err := e.checkTransferRole(vmInput.CallerAddr, userAcnt, leg.tokenID, leg.tokenKey)
+		if err != nil {
+			return nil, err
+		}
+
+		token, found := tokensByKey[string(leg.tokenKey)]
+		if !found {
+			token, err = e.getTransferableESDTData(vmInput.CallerAddr, userAcnt, leg.tokenKey)
+			if err != nil {
+				return nil, err
+			}
+
+			tokensByKey[string(leg.tokenKey)] = token
+			tokens = append(tokens, token)
+		}
+
+		if !isSender {
+			token.esdtData.Value.Add(token.esdtData.Value, leg.value)
+			continue
+		}
+
+		token.esdtData.Value.Sub(token.esdtData.Value, leg.value)
+		if token.esdtData.Value.Cmp(zero) < 0 {
+			return nil, process.ErrInsufficientFunds
+		}
+	}
+
+	return tokens, nil
+}
+
+func (e *esdtMultiTransfer) getTransferableESDTData(
+	senderAddr []byte,
+	userAcnt state.UserAccountHandler,
+	tokenKey []byte,
+) (*esdtTokenBalance, error) {
+	esdtData, err := getESDTDataFromKey(userAcnt, tokenKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(senderAddr, vm.ESDTSCAddress) {
+		esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
+		if esdtUserMetaData.Frozen {
+			return nil, process.ErrESDTIsFrozenForAccount
+		}
+
+		if e.pauseHandler.IsPaused(tokenKey) {
+			return nil, process.ErrESDTTokenIsPaused
+		}
+	}
+
+	return &esdtTokenBalance{tokenKey: tokenKey, esdtData: esdtData}, nil
+}
+
+func (e *esdtMultiTransfer) saveBalances(userAcnt state.UserAccountHandler, tokens []*esdtTokenBalance) error {
+	for _, token := range tokens {
+		err := saveESDTData(userAcnt, token.esdtData, token.tokenKey, e.marshalizer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *esdtMultiTransfer) parseTransferLegs(arguments [][]byte) ([]*esdtTransferLeg, error) {
+	numOfTransfers, err := getNumOfMultiTransfers(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	legs := make([]*esdtTransferLeg, 0, numOfTransfers)
+	for i := 0; i < numOfTransfers; i++ {
+		tokenID := arguments[1+2*i]
+		value := big.NewInt(0).SetBytes(arguments[2+2*i])
+		if value.Cmp(zero) <= 0 {
+			return nil, process.ErrNegativeValue
+		}
+
+		legs = append(legs, &esdtTransferLeg{
+			tokenID:  tokenID,
+			tokenKey: append(append([]byte{}, e.keyPrefix...), tokenID...),
+			value:    value,
+		})
+	}
+
+	return legs, nil
+}
+
+func getNumOfMultiTransfers(arguments [][]byte) (int, error) {
+	if len(arguments) < 3 {
+		return 0, process.ErrInvalidArguments
+	}
+
+	numOfTransfers := big.NewInt(0).SetBytes(arguments[0])
+	maxNumOfTransfers := big.NewInt(int64(len(arguments)-1) / 2)
+	if numOfTransfers.Cmp(zero) <= 0 || numOfTransfers.Cmp(maxNumOfTransfers) > 0 {
+		return 0, fmt.Errorf("%w, invalid number of transfers", process.ErrInvalidArguments)
+	}
+
+	return int(numOfTransfers.Int64()), nil
+}
+
+func (e *esdtMultiTransfer) setPayableHandler(payableHandler process.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return process.ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+func (e *esdtMultiTransfer) checkTransferRole(senderAddr []byte, userAcnt state.UserAccountHandler, tokenID []byte, key []byte) error {
+	if !e.flagLimitedTransfer.IsSet() {
+		return nil
+	}
+
+	return checkTransferRole(senderAddr, userAcnt, tokenID, key, e.marshalizer, e.limitedTransferHandler)
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtMultiTransfer) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("built in function: esdt multi transfer", "enabled", e.flagEnabled.IsSet())
+
+	e.flagLimitedTransfer.Toggle(epoch >= e.limitedTransferEnableEpoch)
+	log.Debug("built in function: esdt multi transfer limited transfer check", "enabled", e.flagLimitedTransfer.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtMultiTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
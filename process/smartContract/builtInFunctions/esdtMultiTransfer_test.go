@@ -0,0 +1,319 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMultiTransferInput(callArgs ...[]byte) *vmcommon.ContractCallInput {
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments: [][]byte{
+				big.NewInt(2).Bytes(),
+				[]byte("tokenA"), big.NewInt(10).Bytes(),
+				[]byte("tokenB"), big.NewInt(20).Bytes(),
+			},
+		},
+	}
+	input.Arguments = append(input.Arguments, callArgs...)
+
+	return input
+}
+
+func setESDTBalance(acnt state.UserAccountHandler, keyPrefix []byte, tokenID string, value int64) {
+	esdtToken := &esdt.ESDigitalToken{Value: big.NewInt(value)}
+	marshaledData, _ := (&mock.MarshalizerMock{}).Marshal(esdtToken)
+	_ = acnt.DataTrieTracker().SaveKeyValue(append(append([]byte{}, keyPrefix...), tokenID...), marshaledData)
+}
+
+func getESDTBalance(acnt state.UserAccountHandler, keyPrefix []byte, tokenID string) *big.Int {
+	esdtData, _ := getESDTDataFromKey(acnt, append(append([]byte{}, keyPrefix...), tokenID...), &mock.MarshalizerMock{})
+	return esdtData.Value
+}
+
+func TestNewESDTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, err := NewESDTMultiTransferFunc(0, nil, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, multiTransfer)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	multiTransfer, err = NewESDTMultiTransferFunc(0, &mock.MarshalizerMock{}, nil, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, multiTransfer)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	multiTransfer, err = NewESDTMultiTransferFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, 0, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, multiTransfer)
+	assert.Equal(t, process.ErrNilLimitedTransferHandler, err)
+
+	multiTransfer, err = NewESDTMultiTransferFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, nil)
+	assert.Nil(t, multiTransfer)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 1, 0, &mock.EpochNotifierStub{})
+
+	output, err := multiTransfer.ProcessBuiltinFunction(nil, nil, createMultiTransferInput())
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrBuiltInFunctionNotEnabled, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+
+	_, err := multiTransfer.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createMultiTransferInput()
+	input.CallValue = big.NewInt(1)
+	_, err = multiTransfer.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createMultiTransferInput()
+	input.Arguments = input.Arguments[:2]
+	_, err = multiTransfer.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createMultiTransferInput()
+	input.Arguments[0] = big.NewInt(3).Bytes()
+	_, err = multiTransfer.ProcessBuiltinFunction(nil, nil, input)
+	assert.True(t, errors.Is(err, process.ErrInvalidArguments))
+
+	input = createMultiTransferInput()
+	input.Arguments[4] = big.NewInt(0).Bytes()
+	_, err = multiTransfer.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	input = createMultiTransferInput()
+	input.GasProvided = 19
+	_, err = multiTransfer.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	_ = multiTransfer.setPayableHandler(&mock.PayableHandlerStub{})
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 100)
+
+	input := createMultiTransferInput()
+	_, err := multiTransfer.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 100)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB", 100)
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(accSnd, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(30), vmOutput.GasRemaining)
+
+	assert.Equal(t, big.NewInt(90), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA"))
+	assert.Equal(t, big.NewInt(80), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB"))
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, multiTransfer.keyPrefix, "tokenA"))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, multiTransfer.keyPrefix, "tokenB"))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionFailingLegShouldNotApplyPreviousLegs(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	_ = multiTransfer.setPayableHandler(&mock.PayableHandlerStub{})
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 100)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB", 5)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenC", 100)
+
+	input := createMultiTransferInput()
+	input.Arguments = [][]byte{
+		big.NewInt(3).Bytes(),
+		[]byte("tokenA"), big.NewInt(10).Bytes(),
+		[]byte("tokenB"), big.NewInt(20).Bytes(),
+		[]byte("tokenC"), big.NewInt(30).Bytes(),
+	}
+	_, err := multiTransfer.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA"))
+	assert.Equal(t, big.NewInt(5), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB"))
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenC"))
+	assert.Equal(t, big.NewInt(0), getESDTBalance(accDst, multiTransfer.keyPrefix, "tokenA"))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionFrozenDestinationShouldNotDebitSender(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	_ = multiTransfer.setPayableHandler(&mock.PayableHandlerStub{})
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 100)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB", 100)
+
+	esdtFrozen := ESDTUserMetadata{Frozen: true}
+	marshaledData, _ := (&mock.MarshalizerMock{}).Marshal(&esdt.ESDigitalToken{Value: big.NewInt(0), Properties: esdtFrozen.ToBytes()})
+	_ = accDst.DataTrieTracker().SaveKeyValue(append(append([]byte{}, multiTransfer.keyPrefix...), "tokenB"...), marshaledData)
+
+	_, err := multiTransfer.ProcessBuiltinFunction(accSnd, accDst, createMultiTransferInput())
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA"))
+	assert.Equal(t, big.NewInt(100), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB"))
+	assert.Equal(t, big.NewInt(0), getESDTBalance(accDst, multiTransfer.keyPrefix, "tokenA"))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionSameTokenInMoreLegs(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	_ = multiTransfer.setPayableHandler(&mock.PayableHandlerStub{})
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 25)
+
+	input := createMultiTransferInput()
+	input.Arguments[3] = []byte("tokenA")
+	_, err := multiTransfer.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+	assert.Equal(t, big.NewInt(25), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA"))
+
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 30)
+	_, err = multiTransfer.ProcessBuiltinFunction(accSnd, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA"))
+	assert.Equal(t, big.NewInt(30), getESDTBalance(accDst, multiTransfer.keyPrefix, "tokenA"))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionToSelfShouldNotChangeBalances(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	_ = multiTransfer.setPayableHandler(&mock.PayableHandlerStub{})
+
+	acc, _ := state.NewUserAccount([]byte("snd"))
+	setESDTBalance(acc, multiTransfer.keyPrefix, "tokenA", 100)
+	setESDTBalance(acc, multiTransfer.keyPrefix, "tokenB", 100)
+
+	_, err := multiTransfer.ProcessBuiltinFunction(acc, acc, createMultiTransferInput())
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(acc, multiTransfer.keyPrefix, "tokenA"))
+	assert.Equal(t, big.NewInt(100), getESDTBalance(acc, multiTransfer.keyPrefix, "tokenB"))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionNotPayableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	_ = multiTransfer.setPayableHandler(&mock.PayableHandlerStub{
+		IsPayableCalled: func(_ []byte) (bool, error) {
+			return false, nil
+		},
+	})
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 100)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB", 100)
+
+	_, err := multiTransfer.ProcessBuiltinFunction(accSnd, accDst, createMultiTransferInput())
+	assert.Equal(t, process.ErrAccountNotPayable, err)
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionWithSCCallInShard(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+
+	scAddress := bytes.Repeat([]byte{0}, 32)
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount(scAddress)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 100)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB", 100)
+
+	input := createMultiTransferInput([]byte("addLiquidity"), []byte("arg"))
+	input.RecipientAddr = scAddress
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(accSnd, accDst, input)
+	require.Nil(t, err)
+
+	outAcc := vmOutput.OutputAccounts[string(scAddress)]
+	require.NotNil(t, outAcc)
+	function, args, err := parsers.NewCallArgsParser().ParseData(string(outAcc.OutputTransfers[0].Data))
+	require.Nil(t, err)
+	assert.Equal(t, "addLiquidity", function)
+	assert.Equal(t, [][]byte{[]byte("arg")}, args)
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, multiTransfer.keyPrefix, "tokenB"))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionCrossShard(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+	_ = multiTransfer.setPayableHandler(&mock.PayableHandlerStub{})
+
+	accSnd, _ := state.NewUserAccount([]byte("snd"))
+	accDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 100)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB", 100)
+
+	input := createMultiTransferInput()
+	_, err := multiTransfer.ProcessBuiltinFunction(accSnd, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(90), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA"))
+	assert.Equal(t, big.NewInt(80), getESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB"))
+
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(nil, accDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, multiTransfer.keyPrefix, "tokenA"))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(accDst, multiTransfer.keyPrefix, "tokenB"))
+}
+
+func TestESDTMultiTransfer_ProcessBuiltInFunctionCrossShardFromSmartContract(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, 0, &mock.EpochNotifierStub{})
+
+	scAddress := bytes.Repeat([]byte{0}, 32)
+	accSnd, _ := state.NewUserAccount(scAddress)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenA", 100)
+	setESDTBalance(accSnd, multiTransfer.keyPrefix, "tokenB", 100)
+
+	input := createMultiTransferInput()
+	input.CallerAddr = scAddress
+	input.RecipientAddr = []byte("dst")
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(accSnd, nil, input)
+	require.Nil(t, err)
+
+	outAcc := vmOutput.OutputAccounts["dst"]
+	require.NotNil(t, outAcc)
+	function, args, err := parsers.NewCallArgsParser().ParseData(string(outAcc.OutputTransfers[0].Data))
+	require.Nil(t, err)
+	assert.Equal(t, core.BuiltInFunctionESDTMultiTransfer, function)
+	assert.Equal(t, input.Arguments, args)
+}
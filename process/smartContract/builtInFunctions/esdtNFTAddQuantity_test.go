@@ -0,0 +1,74 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTQuantityInput(caller []byte, tokenID []byte, nonce uint64, quantity int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments:   [][]byte{tokenID, big.NewInt(0).SetUint64(nonce).Bytes(), big.NewInt(quantity).Bytes()},
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func createNFTOnAccount(t *testing.T, acnt state.UserAccountHandler, tokenID []byte, quantity int64) {
+	nftCreate, _ := NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.TokenTypeHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	_, err := nftCreate.ProcessBuiltinFunction(acnt, nil, createNFTCreateInput(acnt.AddressBytes(), tokenID, quantity))
+	require.Nil(t, err)
+}
+
+func TestNewESDTNFTAddQuantityFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftAdd, err := NewESDTNFTAddQuantityFunc(0, nil, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftAdd)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftAdd, err = NewESDTNFTAddQuantityFunc(0, &mock.MarshalizerMock{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftAdd)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+}
+
+func TestEsdtNFTAddQuantity_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftAdd, _ := NewESDTNFTAddQuantityFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	acnt := createUserAccountWithEmptyDataTrie([]byte("caller"))
+
+	input := createNFTQuantityInput(acnt.AddressBytes(), tokenID, 1, 10)
+	_, err := nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	_, err = nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	createNFTOnAccount(t, acnt, tokenID, 1)
+	input = createNFTQuantityInput(acnt.AddressBytes(), tokenID, 1, 0)
+	_, err = nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input = createNFTQuantityInput(acnt.AddressBytes(), tokenID, 1, 10)
+	vmOutput, err := nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-nftAdd.funcGasCost, vmOutput.GasRemaining)
+
+	esdtData, _ := getESDTNFTToken(acnt, append(nftAdd.keyPrefix, tokenID...), 1, marshalizer)
+	assert.Equal(t, big.NewInt(11), esdtData.Value)
+}
@@ -0,0 +1,59 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTNFTBurnFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftBurn, err := NewESDTNFTBurnFunc(0, nil, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftBurn)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftBurn, err = NewESDTNFTBurnFunc(0, &mock.MarshalizerMock{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftBurn)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+}
+
+func TestEsdtNFTBurn_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftBurn, _ := NewESDTNFTBurnFunc(10, marshalizer, &mock.PauseHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	acnt := createUserAccountWithEmptyDataTrie([]byte("caller"))
+
+	input := createNFTQuantityInput(acnt.AddressBytes(), tokenID, 1, 2)
+	_, err := nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity, core.ESDTRoleNFTBurn)
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	createNFTOnAccount(t, acnt, tokenID, 3)
+	input = createNFTQuantityInput(acnt.AddressBytes(), tokenID, 1, 4)
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	esdtTokenKey := append(nftBurn.keyPrefix, tokenID...)
+	input = createNFTQuantityInput(acnt.AddressBytes(), tokenID, 1, 2)
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	esdtData, _ := getESDTNFTToken(acnt, esdtTokenKey, 1, marshalizer)
+	assert.Equal(t, big.NewInt(1), esdtData.Value)
+
+	input = createNFTQuantityInput(acnt.AddressBytes(), tokenID, 1, 1)
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	esdtData, _ = getESDTNFTToken(acnt, esdtTokenKey, 1, marshalizer)
+	assert.Nil(t, esdtData.TokenMetaData)
+}
@@ -0,0 +1,289 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const minNumOfArgsForNFTCreate = 7
+
+var _ process.BuiltinFunction = (*esdtNFTCreate)(nil)
+
+type esdtNFTCreate struct {
+	keyPrefix        []byte
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	tokenTypeHandler process.ESDTTokenTypeHandler
+	funcGasCost      uint64
+	gasConfig        process.BaseOperationCost
+	mutExecution     sync.RWMutex
+	enableEpoch      uint32
+	flagEnabled      atomic.Flag
+}
+
+// NewESDTNFTCreateFunc returns the esdt NFT create built-in function component
+func NewESDTNFTCreateFunc(
+	funcGasCost uint64,
+	gasConfig process.BaseOperationCost,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	tokenTypeHandler process.ESDTTokenTypeHandler,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTCreate, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(tokenTypeHandler) {
+		return nil, process.ErrNilTokenTypeHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTCreate{
+		keyPrefix:        []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		tokenTypeHandler: tokenTypeHandler,
+		funcGasCost:      funcGasCost,
+		gasConfig:        gasConfig,
+		enableEpoch:      enableEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTCreate) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTCreate
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT create function call
+// Requires at least 7 arguments:
+// arg0 - token identifier
+// arg1 - initial quantity
+// arg2 - NFT name
+// arg3 - royalties
+// arg4 - hash
+// arg5 - attributes
+// arg6+ - multiple entries of URI (minimum 1)
+func (e *esdtNFTCreate) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionNotEnabled
+	}
+
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkInputArgumentsForLocalAction(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < minNumOfArgsForNFTCreate {
+		return nil, fmt.Errorf("%w, wrong number of arguments", process.ErrInvalidArguments)
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkAllowedToExecute(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleNFTCreate))
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	if quantity.Cmp(big.NewInt(1)) > 0 {
+		err = checkAllowedToExecute(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleNFTAddQuantity))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	royalties := big.NewInt(0).SetBytes(vmInput.Arguments[3])
+	if !royalties.IsUint64() || royalties.Uint64() > uint64(core.MaxRoyalty) {
+		return nil, process.ErrInvalidRoyalties
+	}
+
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	tokenType := e.tokenTypeHandler.GetTokenType(esdtTokenKey)
+	if tokenType != core.NonFungible && tokenType != core.SemiFungible {
+		return nil, process.ErrInvalidESDTTokenType
+	}
+
+	nonce, err := getLatestNonce(acntSnd, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	nonce++
+
+	esdtData := &esdt.ESDigitalToken{
+		Type:  uint32(tokenType),
+		Value: quantity,
+		TokenMetaData: &esdt.MetaData{
+			Nonce:      nonce,
+			Name:       vmInput.Arguments[2],
+			Creator:    vmInput.CallerAddr,
+			Royalties:  uint32(royalties.Uint64()),
+			Hash:       vmInput.Arguments[4],
+			Attributes: vmInput.Arguments[5],
+			URIs:       vmInput.Arguments[6:],
+		},
+	}
+
+	marshaledData, err := saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = saveLatestNonce(acntSnd, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	gasToUse := e.funcGasCost + e.gasConfig.StorePerByte*uint64(len(marshaledData))
+	if vmInput.GasProvided < gasToUse {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		ReturnData:   [][]byte{big.NewInt(0).SetUint64(nonce).Bytes()},
+	}
+	return vmOutput, nil
+}
+
+func getLatestNonce(acnt state.UserAccountHandler, tokenID []byte) (uint64, error) {
+	nonceKey := getNonceKey(tokenID)
+	nonceData, err := acnt.DataTrieTracker().RetrieveValue(nonceKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(nonceData) == 0 {
+		return 0, nil
+	}
+
+	return big.NewInt(0).SetBytes(nonceData).Uint64(), nil
+}
+
+func saveLatestNonce(acnt state.UserAccountHandler, tokenID []byte, nonce uint64) error {
+	nonceKey := getNonceKey(tokenID)
+	return acnt.DataTrieTracker().SaveKeyValue(nonceKey, big.NewInt(0).SetUint64(nonce).Bytes())
+}
+
+func getNonceKey(tokenID []byte) []byte {
+	return append([]byte(core.ElrondProtectedKeyPrefix+core.ESDTNFTLatestNonceIdentifier), tokenID...)
+}
+
+func checkInputArgumentsForLocalAction(
+	account state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	funcGasCost uint64,
+) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return process.ErrInvalidRcvAddr
+	}
+	if vmInput.GasProvided < funcGasCost {
+		return process.ErrNotEnoughGas
+	}
+
+	return nil
+}
+
+func computeESDTNFTTokenKey(esdtTokenKey []byte, nonce uint64) []byte {
+	return append(append([]byte{}, esdtTokenKey...), big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+func getESDTNFTToken(
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	nonce uint64,
+	marshalizer marshal.Marshalizer,
+) (*esdt.ESDigitalToken, error) {
+	esdtNFTTokenKey := computeESDTNFTTokenKey(esdtTokenKey, nonce)
+	esdtData := &esdt.ESDigitalToken{Value: big.NewInt(0), Type: uint32(core.NonFungible)}
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(esdtNFTTokenKey)
+	if err != nil || len(marshaledData) == 0 {
+		return esdtData, nil
+	}
+
+	err = marshalizer.Unmarshal(esdtData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return esdtData, nil
+}
+
+func saveESDTNFTToken(
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	esdtData *esdt.ESDigitalToken,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) ([]byte, error) {
+	if esdtData.TokenMetaData == nil {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+	if pauseHandler.IsPaused(esdtTokenKey) {
+		return nil, process.ErrESDTTokenIsPaused
+	}
+
+	esdtNFTTokenKey := computeESDTNFTTokenKey(esdtTokenKey, esdtData.TokenMetaData.Nonce)
+	if esdtData.Value.Cmp(zero) <= 0 {
+		return nil, acnt.DataTrieTracker().SaveKeyValue(esdtNFTTokenKey, nil)
+	}
+
+	marshaledData, err := marshalizer.Marshal(esdtData)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("esdt NFT after change", "addr", acnt.AddressBytes(), "value", esdtData.Value, "tokenKey", esdtNFTTokenKey)
+	return marshaledData, acnt.DataTrieTracker().SaveKeyValue(esdtNFTTokenKey, marshaledData)
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtNFTCreate) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("built in function: esdt NFT create", "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTCreate) IsInterfaceNil() bool {
+	return e == nil
+}
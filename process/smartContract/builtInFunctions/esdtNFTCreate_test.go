@@ -0,0 +1,188 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTCreateInput(caller []byte, tokenID []byte, quantity int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments: [][]byte{
+				tokenID,
+				big.NewInt(quantity).Bytes(),
+				[]byte("name"),
+				big.NewInt(500).Bytes(),
+				[]byte("hash"),
+				[]byte("attributes"),
+				[]byte("uri"),
+			},
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func createUserAccountWithEmptyDataTrie(address []byte) state.UserAccountHandler {
+	acnt, _ := state.NewUserAccount(address)
+	acnt.SetDataTrie(&mock.TrieStub{
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return nil, nil
+		},
+	})
+
+	return acnt
+}
+
+func setRolesOnAccount(t *testing.T, acnt state.UserAccountHandler, tokenID []byte, roles ...string) {
+	setRolesF, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vm.ESDTSCAddress,
+			Arguments:  [][]byte{tokenID},
+		},
+	}
+	for _, role := range roles {
+		input.Arguments = append(input.Arguments, []byte(role))
+	}
+
+	_, err := setRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+}
+
+func TestNewESDTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreate, err := NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, nil, &mock.PauseHandlerStub{}, &mock.TokenTypeHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftCreate)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftCreate, err = NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, &mock.TokenTypeHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftCreate)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	nftCreate, err = NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftCreate)
+	assert.Equal(t, process.ErrNilTokenTypeHandler, err)
+
+	nftCreate, err = NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.TokenTypeHandlerStub{}, 0, nil)
+	assert.Nil(t, nftCreate)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestEsdtNFTCreate_ProcessBuiltinFunctionBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreate, _ := NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.TokenTypeHandlerStub{}, 1, &mock.EpochNotifierStub{})
+
+	caller := []byte("caller")
+	tokenID := []byte("NFT-ABCDEF")
+	acnt := createUserAccountWithEmptyDataTrie(caller)
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleNFTCreate)
+
+	output, err := nftCreate.ProcessBuiltinFunction(acnt, nil, createNFTCreateInput(caller, tokenID, 1))
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrBuiltInFunctionNotEnabled, err)
+
+	nftCreate.EpochConfirmed(1)
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, createNFTCreateInput(caller, tokenID, 1))
+	assert.Nil(t, err)
+}
+
+func TestEsdtNFTCreate_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	nftCreate, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.TokenTypeHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	acnt := createUserAccountWithEmptyDataTrie([]byte("caller"))
+
+	_, err := nftCreate.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createNFTCreateInput(acnt.AddressBytes(), tokenID, 1)
+	_, err = nftCreate.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	input.RecipientAddr = []byte("other")
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createNFTCreateInput(acnt.AddressBytes(), tokenID, 1)
+	input.Arguments = input.Arguments[:minNumOfArgsForNFTCreate-1]
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.True(t, errors.Is(err, process.ErrInvalidArguments))
+
+	input = createNFTCreateInput(acnt.AddressBytes(), tokenID, 1)
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleNFTCreate)
+	input = createNFTCreateInput(acnt.AddressBytes(), tokenID, 2)
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	input = createNFTCreateInput(acnt.AddressBytes(), tokenID, 1)
+	input.Arguments[3] = big.NewInt(int64(core.MaxRoyalty) + 1).Bytes()
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRoyalties, err)
+
+	nftCreate.tokenTypeHandler = &mock.TokenTypeHandlerStub{
+		GetTokenTypeCalled: func(_ []byte) core.ESDTType {
+			return core.Fungible
+		},
+	}
+	input = createNFTCreateInput(acnt.AddressBytes(), tokenID, 1)
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidESDTTokenType, err)
+}
+
+func TestEsdtNFTCreate_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenTypeHandler := &mock.TokenTypeHandlerStub{
+		GetTokenTypeCalled: func(_ []byte) core.ESDTType {
+			return core.SemiFungible
+		},
+	}
+	nftCreate, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, &mock.PauseHandlerStub{}, tokenTypeHandler, 0, &mock.EpochNotifierStub{})
+	tokenID := []byte("token")
+	acnt := createUserAccountWithEmptyDataTrie([]byte("caller"))
+	setRolesOnAccount(t, acnt, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+
+	input := createNFTCreateInput(acnt.AddressBytes(), tokenID, 5)
+	vmOutput, err := nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(1).Bytes()}, vmOutput.ReturnData)
+	assert.True(t, vmOutput.GasRemaining < input.GasProvided-nftCreate.funcGasCost)
+
+	vmOutput, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(2).Bytes()}, vmOutput.ReturnData)
+
+	esdtTokenKey := append(nftCreate.keyPrefix, tokenID...)
+	esdtData, err := getESDTNFTToken(acnt, esdtTokenKey, 2, marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(5), esdtData.Value)
+	assert.Equal(t, uint32(core.SemiFungible), esdtData.Type)
+	assert.Equal(t, uint64(2), esdtData.TokenMetaData.Nonce)
+	assert.Equal(t, acnt.AddressBytes(), esdtData.TokenMetaData.Creator)
+	assert.Equal(t, uint32(500), esdtData.TokenMetaData.Royalties)
+	assert.Equal(t, [][]byte{[]byte("uri")}, esdtData.TokenMetaData.URIs)
+
+	latestNonce, _ := getLatestNonce(acnt, tokenID)
+	assert.Equal(t, uint64(2), latestNonce)
+}
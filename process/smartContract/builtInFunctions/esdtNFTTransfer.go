@@ -0,0 +1,353 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTTransfer)(nil)
+
+type esdtNFTTransfer struct {
+	keyPrefix        []byte
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	funcGasCost      uint64
+	gasConfig        process.BaseOperationCost
+	mutExecution     sync.RWMutex
+	enableEpoch      uint32
+	flagEnabled      atomic.Flag
+}
+
+// NewESDTNFTTransferFunc returns the esdt NFT transfer built-in function component
+func NewESDTNFTTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	gasConfig process.BaseOperationCost,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtNFTTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtNFTTransfer{
+		keyPrefix:        []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		accounts:         accounts,
+		shardCoordinator: shardCoordinator,
+		funcGasCost:      funcGasCost,
+		gasConfig:        gasConfig,
+		enableEpoch:      enableEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTTransfer
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT transfer function call
+// Requires 4 arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - quantity to transfer
+// arg3 - destination address
+// if cross-shard, the rest of arguments will be filled inside the SCR
+// arg4 - marshaled token data
+// arg5 - optional function to call on the destination smart contract
+// arg6+ - optional arguments for the function call
+func (e *esdtNFTTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionNotEnabled
+	}
+
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < 4 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	if bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return e.processNFTTransferOnSenderShard(acntSnd, vmInput)
+	}
+
+	// in cross shard NFT transfer the sender account must be nil
+	if !check.IfNil(acntSnd) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+
+	tokenID := vmInput.Arguments[0]
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+
+	esdtTransferData := &esdt.ESDigitalToken{}
+	err := e.marshalizer.Unmarshal(esdtTransferData, vmInput.Arguments[3])
+	if err != nil {
+		return nil, err
+	}
+	if esdtTransferData.TokenMetaData == nil || esdtTransferData.TokenMetaData.Nonce != nonce {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+	esdtTransferData.Value = value
+
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	err = e.addNFTToDestination(vmInput.CallerAddr, acntDst, esdtTransferData, esdtTokenKey)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided,
+	}
+	if core.IsSmartContractAddress(vmInput.RecipientAddr) && len(vmInput.Arguments) > 4 {
+		var callArgs [][]byte
+		if len(vmInput.Arguments) > 5 {
+			callArgs = vmInput.Arguments[5:]
+		}
+
+		addOutPutTransferToVMOutput(
+			string(vmInput.Arguments[4]),
+			callArgs,
+			vmInput.RecipientAddr,
+			vmInput.GasLocked,
+			vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) processNFTTransferOnSenderShard(
+	acntSnd state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntSnd) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	dstAddress := vmInput.Arguments[3]
+	if len(dstAddress) != len(vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, not a valid destination address", process.ErrInvalidArguments)
+	}
+	if bytes.Equal(dstAddress, vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, can not transfer to self", process.ErrInvalidArguments)
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTToken(acntSnd, esdtTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.TokenMetaData == nil {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+
+	quantityToTransfer := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if quantityToTransfer.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	if esdtData.Value.Cmp(quantityToTransfer) < 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	err = e.checkFrozen(acntSnd, esdtTokenKey)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value.Sub(esdtData.Value, quantityToTransfer)
+	_, err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value.Set(quantityToTransfer)
+
+	isSameShard := e.shardCoordinator.SameShard(vmInput.CallerAddr, dstAddress)
+	if isSameShard {
+		err = e.transferToDestinationInShard(vmInput.CallerAddr, dstAddress, esdtData, esdtTokenKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	err = e.createNFTOutputTransfer(vmInput, vmOutput, esdtData, dstAddress, isSameShard)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) transferToDestinationInShard(
+	sndAddress []byte,
+	dstAddress []byte,
+	esdtData *esdt.ESDigitalToken,
+	esdtTokenKey []byte,
+) error {
+	accountHandler, err := e.accounts.LoadAccount(dstAddress)
+	if err != nil {
+		return err
+	}
+	userAccount, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = e.addNFTToDestination(sndAddress, userAccount, esdtData, esdtTokenKey)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(userAccount)
+}
+
+func (e *esdtNFTTransfer) createNFTOutputTransfer(
+	vmInput *vmcommon.ContractCallInput,
+	vmOutput *vmcommon.VMOutput,
+	esdtData *esdt.ESDigitalToken,
+	dstAddress []byte,
+	isSameShard bool,
+) error {
+	var callArgs [][]byte
+	if len(vmInput.Arguments) > 4 {
+		callArgs = vmInput.Arguments[4:]
+	}
+
+	if isSameShard {
+		if !core.IsSmartContractAddress(dstAddress) || len(callArgs) == 0 {
+			return nil
+		}
+
+		addOutPutTransferToVMOutput(string(callArgs[0]), callArgs[1:], dstAddress, vmInput.GasLocked, vmOutput)
+		return nil
+	}
+
+	marshaledData, err := e.marshalizer.Marshal(esdtData)
+	if err != nil {
+		return err
+	}
+
+	gasForStorage := e.gasConfig.StorePerByte * uint64(len(marshaledData))
+	if vmOutput.GasRemaining < gasForStorage {
+		return process.ErrNotEnoughGas
+	}
+	vmOutput.GasRemaining -= gasForStorage
+
+	nftTransferArgs := [][]byte{vmInput.Arguments[0], vmInput.Arguments[1], vmInput.Arguments[2], marshaledData}
+	nftTransferArgs = append(nftTransferArgs, callArgs...)
+	addOutPutTransferToVMOutput(core.BuiltInFunctionESDTNFTTransfer, nftTransferArgs, dstAddress, vmInput.GasLocked, vmOutput)
+
+	return nil
+}
+
+func (e *esdtNFTTransfer) addNFTToDestination(
+	sndAddress []byte,
+	userAccount state.UserAccountHandler,
+	esdtDataToTransfer *esdt.ESDigitalToken,
+	esdtTokenKey []byte,
+) error {
+	if !bytes.Equal(sndAddress, vm.ESDTSCAddress) {
+		err := e.checkFrozen(userAccount, esdtTokenKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	currentESDTData, err := getESDTNFTToken(userAccount, esdtTokenKey, esdtDataToTransfer.TokenMetaData.Nonce, e.marshalizer)
+	if err != nil {
+		return err
+	}
+	if currentESDTData.TokenMetaData == nil {
+		currentESDTData.TokenMetaData = esdtDataToTransfer.TokenMetaData
+	}
+	currentESDTData.Value.Add(currentESDTData.Value, esdtDataToTransfer.Value)
+
+	_, err = saveESDTNFTToken(userAccount, esdtTokenKey, currentESDTData, e.marshalizer, e.pauseHandler)
+	return err
+}
+
+func (e *esdtNFTTransfer) checkFrozen(userAccount state.UserAccountHandler, esdtTokenKey []byte) error {
+	esdtData, err := getESDTDataFromKey(userAccount, esdtTokenKey, e.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
+	if esdtUserMetaData.Frozen {
+		return process.ErrESDTIsFrozenForAccount
+	}
+
+	return nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtNFTTransfer) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("built in function: esdt NFT transfer", "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,177 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTTransferAndAccounts(
+	t *testing.T,
+	sameShard bool,
+) (*esdtNFTTransfer, state.UserAccountHandler, state.UserAccountHandler) {
+	tokenID := []byte("token")
+	sender := createUserAccountWithEmptyDataTrie(bytes.Repeat([]byte{1}, 32))
+	destination := createUserAccountWithEmptyDataTrie(bytes.Repeat([]byte{2}, 32))
+	setRolesOnAccount(t, sender, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	createNFTOnAccount(t, sender, tokenID, 10)
+
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			if bytes.Equal(address, destination.AddressBytes()) {
+				return destination, nil
+			}
+			return sender, nil
+		},
+		SaveAccountCalled: func(_ state.AccountHandler) error {
+			return nil
+		},
+	}
+	shardCoordinator := &mock.CoordinatorStub{
+		SameShardCalled: func(_, _ []byte) bool {
+			return sameShard
+		},
+	}
+
+	nftTransfer, err := NewESDTNFTTransferFunc(
+		10,
+		&mock.MarshalizerMock{},
+		&mock.PauseHandlerStub{},
+		accounts,
+		shardCoordinator,
+		process.BaseOperationCost{StorePerByte: 1},
+		0,
+		&mock.EpochNotifierStub{},
+	)
+	require.Nil(t, err)
+
+	return nftTransfer, sender, destination
+}
+
+func createNFTTransferInput(sender []byte, destination []byte, quantity int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  sender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100000,
+			Arguments:   [][]byte{[]byte("token"), big.NewInt(1).Bytes(), big.NewInt(quantity).Bytes(), destination},
+		},
+		RecipientAddr: sender,
+	}
+}
+
+func getNFTValue(t *testing.T, nftTransfer *esdtNFTTransfer, acnt state.UserAccountHandler) *big.Int {
+	esdtData, err := getESDTNFTToken(acnt, append(nftTransfer.keyPrefix, []byte("token")...), 1, nftTransfer.marshalizer)
+	require.Nil(t, err)
+
+	return esdtData.Value
+}
+
+func TestNewESDTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftTransfer, err := NewESDTNFTTransferFunc(0, nil, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, &mock.CoordinatorStub{}, process.BaseOperationCost{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftTransfer)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftTransfer, err = NewESDTNFTTransferFunc(0, &mock.MarshalizerMock{}, nil, &mock.AccountsStub{}, &mock.CoordinatorStub{}, process.BaseOperationCost{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftTransfer)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	nftTransfer, err = NewESDTNFTTransferFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, &mock.CoordinatorStub{}, process.BaseOperationCost{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftTransfer)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	nftTransfer, err = NewESDTNFTTransferFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, nil, process.BaseOperationCost{}, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, nftTransfer)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestEsdtNFTTransfer_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	nftTransfer, sender, destination := createNFTTransferAndAccounts(t, true)
+
+	_, err := nftTransfer.ProcessBuiltinFunction(sender, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createNFTTransferInput(sender.AddressBytes(), destination.AddressBytes(), 1)
+	input.Arguments = input.Arguments[:3]
+	_, err = nftTransfer.ProcessBuiltinFunction(sender, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createNFTTransferInput(sender.AddressBytes(), sender.AddressBytes(), 1)
+	_, err = nftTransfer.ProcessBuiltinFunction(sender, sender, input)
+	assert.NotNil(t, err)
+
+	input = createNFTTransferInput(sender.AddressBytes(), destination.AddressBytes(), 11)
+	_, err = nftTransfer.ProcessBuiltinFunction(sender, sender, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	input = createNFTTransferInput(sender.AddressBytes(), destination.AddressBytes(), 1)
+	input.RecipientAddr = destination.AddressBytes()
+	_, err = nftTransfer.ProcessBuiltinFunction(sender, destination, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+}
+
+func TestEsdtNFTTransfer_ProcessBuiltinFunctionOnSameShard(t *testing.T) {
+	t.Parallel()
+
+	nftTransfer, sender, destination := createNFTTransferAndAccounts(t, true)
+
+	input := createNFTTransferInput(sender.AddressBytes(), destination.AddressBytes(), 4)
+	vmOutput, err := nftTransfer.ProcessBuiltinFunction(sender, sender, input)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+
+	assert.Equal(t, big.NewInt(6), getNFTValue(t, nftTransfer, sender))
+	assert.Equal(t, big.NewInt(4), getNFTValue(t, nftTransfer, destination))
+}
+
+func TestEsdtNFTTransfer_ProcessBuiltinFunctionCrossShard(t *testing.T) {
+	t.Parallel()
+
+	nftTransfer, sender, destination := createNFTTransferAndAccounts(t, false)
+
+	input := createNFTTransferInput(sender.AddressBytes(), destination.AddressBytes(), 4)
+	vmOutput, err := nftTransfer.ProcessBuiltinFunction(sender, sender, input)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(6), getNFTValue(t, nftTransfer, sender))
+	assert.Equal(t, big.NewInt(0), getNFTValue(t, nftTransfer, destination))
+
+	outAcc := vmOutput.OutputAccounts[string(destination.AddressBytes())]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+
+	function, args, err := parsers.NewCallArgsParser().ParseData(string(outAcc.OutputTransfers[0].Data))
+	require.Nil(t, err)
+	assert.Equal(t, core.BuiltInFunctionESDTNFTTransfer, function)
+	require.Equal(t, 4, len(args))
+
+	transferredData := &esdt.ESDigitalToken{}
+	err = nftTransfer.marshalizer.Unmarshal(transferredData, args[3])
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(4), transferredData.Value)
+
+	destInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: sender.AddressBytes(),
+			CallValue:  big.NewInt(0),
+			Arguments:  args,
+		},
+		RecipientAddr: destination.AddressBytes(),
+	}
+	_, err = nftTransfer.ProcessBuiltinFunction(nil, destination, destInput)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(4), getNFTValue(t, nftTransfer, destination))
+}
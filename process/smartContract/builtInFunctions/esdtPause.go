@@ -75,7 +75,7 @@ func (e *esdtPause) ProcessBuiltinFunction(
 }
 
 func (e *esdtPause) togglePause(token []byte) error {
-	systemSCAccount, err := e.getSystemAccount()
+	systemSCAccount, err := getSystemAccount(e.accounts)
 	if err != nil {
 		return err
 	}
@@ -91,8 +91,8 @@ func (e *esdtPause) togglePause(token []byte) error {
 	return e.accounts.SaveAccount(systemSCAccount)
 }
 
-func (e *esdtPause) getSystemAccount() (state.UserAccountHandler, error) {
-	systemSCAccount, err := e.accounts.LoadAccount(core.SystemAccountAddress)
+func getSystemAccount(accounts state.AccountsAdapter) (state.UserAccountHandler, error) {
+	systemSCAccount, err := accounts.LoadAccount(core.SystemAccountAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +107,7 @@ func (e *esdtPause) getSystemAccount() (state.UserAccountHandler, error) {
 
 // IsPaused returns true if the token is paused
 func (e *esdtPause) IsPaused(pauseKey []byte) bool {
-	systemSCAccount, err := e.getSystemAccount()
+	systemSCAccount, err := getSystemAccount(e.accounts)
 	if err != nil {
 		return false
 	}
@@ -0,0 +1,216 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const roleKeyPrefix = core.ElrondProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier
+
+var _ process.BuiltinFunction = (*esdtRoles)(nil)
+
+type esdtRoles struct {
+	set         bool
+	marshalizer marshal.Marshalizer
+	enableEpoch uint32
+	flagEnabled atomic.Flag
+}
+
+// NewESDTRolesFunc returns the esdt set/unset role built-in function component
+func NewESDTRolesFunc(
+	marshalizer marshal.Marshalizer,
+	set bool,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*esdtRoles, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtRoles{
+		set:         set,
+		marshalizer: marshalizer,
+		enableEpoch: enableEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtRoles) SetNewGasConfig(_ *process.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves ESDT set/unset role function calls
+func (e *esdtRoles) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionNotEnabled
+	}
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := computeESDTRoleKey(vmInput.Arguments[0])
+	log.Trace(vmInput.Function, "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "token", esdtTokenRoleKey)
+
+	roles, _, err := getESDTRolesForAcnt(e.marshalizer, acntDst, esdtTokenRoleKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.set {
+		addRoles(roles, vmInput.Arguments[1:])
+	} else {
+		deleteRoles(roles, vmInput.Arguments[1:])
+	}
+
+	err = saveRolesToAccount(acntDst, esdtTokenRoleKey, roles, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func computeESDTRoleKey(tokenID []byte) []byte {
+	return append([]byte(roleKeyPrefix), tokenID...)
+}
+
+func addRoles(roles *esdt.ESDTRoles, rolesToAdd [][]byte) {
+	for _, role := range rolesToAdd {
+		_, exist := doesRoleExist(roles, role)
+		if exist {
+			continue
+		}
+
+		roles.Roles = append(roles.Roles, role)
+	}
+}
+
+func deleteRoles(roles *esdt.ESDTRoles, rolesToDelete [][]byte) {
+	for _, role := range rolesToDelete {
+		index, exist := doesRoleExist(roles, role)
+		if !exist {
+			continue
+		}
+
+		copy(roles.Roles[index:], roles.Roles[index+1:])
+		roles.Roles[len(roles.Roles)-1] = nil
+		roles.Roles = roles.Roles[:len(roles.Roles)-1]
+	}
+}
+
+func doesRoleExist(roles *esdt.ESDTRoles, role []byte) (int, bool) {
+	for i, currentRole := range roles.Roles {
+		if bytes.Equal(currentRole, role) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func getESDTRolesForAcnt(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	key []byte,
+) (*esdt.ESDTRoles, bool, error) {
+	roles := &esdt.ESDTRoles{
+		Roles: make([][]byte, 0),
+	}
+
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(key)
+	if err != nil || len(marshaledData) == 0 {
+		return roles, true, nil
+	}
+
+	err = marshalizer.Unmarshal(roles, marshaledData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return roles, false, nil
+}
+
+func saveRolesToAccount(
+	acnt state.UserAccountHandler,
+	key []byte,
+	roles *esdt.ESDTRoles,
+	marshalizer marshal.Marshalizer,
+) error {
+	if len(roles.Roles) == 0 {
+		return acnt.DataTrieTracker().SaveKeyValue(key, nil)
+	}
+
+	marshaledData, err := marshalizer.Marshal(roles)
+	if err != nil {
+		return err
+	}
+
+	return acnt.DataTrieTracker().SaveKeyValue(key, marshaledData)
+}
+
+// checkAllowedToExecute returns an error if the account does not have the given role for the token
+func checkAllowedToExecute(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	action []byte,
+) error {
+	esdtTokenRoleKey := computeESDTRoleKey(tokenID)
+	roles, isNew, err := getESDTRolesForAcnt(marshalizer, acnt, esdtTokenRoleKey)
+	if err != nil {
+		return err
+	}
+	if isNew {
+		return process.ErrActionNotAllowed
+	}
+
+	_, exist := doesRoleExist(roles, action)
+	if !exist {
+		return process.ErrActionNotAllowed
+	}
+
+	return nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtRoles) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("built in function: esdt set/unset role", "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtRoles) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,95 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTRolesFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtRolesF, err := NewESDTRolesFunc(nil, false, 0, &mock.EpochNotifierStub{})
+
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.Nil(t, esdtRolesF)
+}
+
+func TestEsdtRoles_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	esdtRolesF, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true, 0, &mock.EpochNotifierStub{})
+
+	_, err := esdtRolesF.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: nil,
+		},
+	}
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(1)
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), []byte(core.ESDTRoleNFTCreate)}
+	input.CallerAddr = []byte("caller")
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vm.ESDTSCAddress
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+}
+
+func TestEsdtRoles_SetAndUnSetRoles(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	setRolesF, _ := NewESDTRolesFunc(marshalizer, true, 0, &mock.EpochNotifierStub{})
+	unSetRolesF, _ := NewESDTRolesFunc(marshalizer, false, 0, &mock.EpochNotifierStub{})
+
+	tokenID := []byte("token")
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vm.ESDTSCAddress,
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn), []byte(core.ESDTRoleNFTCreate)},
+		},
+	}
+	_, err := setRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+
+	roles, _, _ := getESDTRolesForAcnt(marshalizer, acnt, computeESDTRoleKey(tokenID))
+	assert.Equal(t, 2, len(roles.Roles))
+	assert.Nil(t, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTBurn)))
+	assert.Equal(t, process.ErrActionNotAllowed, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTAddQuantity)))
+
+	input.Arguments = [][]byte{tokenID, []byte(core.ESDTRoleNFTBurn)}
+	_, err = unSetRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+	assert.Equal(t, process.ErrActionNotAllowed, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTBurn)))
+	assert.Nil(t, checkAllowedToExecute(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTCreate)))
+
+	input.Arguments = [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate)}
+	_, err = unSetRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	assert.Nil(t, err)
+
+	val, _ := acnt.DataTrieTracker().RetrieveValue(computeESDTRoleKey(tokenID))
+	assert.Equal(t, 0, len(val))
+}
@@ -0,0 +1,142 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtSetTokenType)(nil)
+
+type esdtSetTokenType struct {
+	keyPrefix   []byte
+	accounts    state.AccountsAdapter
+	enableEpoch uint32
+	flagEnabled atomic.Flag
+}
+
+// NewESDTSetTokenTypeFunc returns the esdt set token type built-in function component
+func NewESDTSetTokenTypeFunc(accounts state.AccountsAdapter, enableEpoch uint32, epochNotifier process.EpochNotifier) (*esdtSetTokenType, error) {
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtSetTokenType{
+		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		accounts:    accounts,
+		enableEpoch: enableEpoch,
+	}
+
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtSetTokenType) SetNewGasConfig(_ *process.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves ESDT set token type function call
+// arg0 - token identifier
+// arg1 - token type as issued by the ESDT system smart contract
+func (e *esdtSetTokenType) ProcessBuiltinFunction(
+	_, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrBuiltInFunctionNotEnabled
+	}
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if !core.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, process.ErrOnlySystemAccountAccepted
+	}
+
+	tokenType, err := convertToESDTTokenType(vmInput.Arguments[1])
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	log.Trace(vmInput.Function, "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "token", esdtTokenKey)
+
+	err = e.setTokenType(esdtTokenKey, tokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func convertToESDTTokenType(tokenType []byte) (core.ESDTType, error) {
+	switch string(tokenType) {
+	case core.NonFungibleESDT:
+		return core.NonFungible, nil
+	case core.SemiFungibleESDT:
+		return core.SemiFungible, nil
+	}
+
+	return core.Fungible, process.ErrInvalidESDTTokenType
+}
+
+func (e *esdtSetTokenType) setTokenType(token []byte, tokenType core.ESDTType) error {
+	systemSCAccount, err := getSystemAccount(e.accounts)
+	if err != nil {
+		return err
+	}
+
+	val, _ := systemSCAccount.DataTrieTracker().RetrieveValue(token)
+	esdtMetaData := ESDTGlobalMetadataFromBytes(val)
+	esdtMetaData.TokenType = tokenType
+	err = systemSCAccount.DataTrieTracker().SaveKeyValue(token, esdtMetaData.ToBytes())
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(systemSCAccount)
+}
+
+// GetTokenType returns the type saved for the token, fungible if no type was set
+func (e *esdtSetTokenType) GetTokenType(tokenKey []byte) core.ESDTType {
+	systemSCAccount, err := getSystemAccount(e.accounts)
+	if err != nil {
+		return core.Fungible
+	}
+
+	val, _ := systemSCAccount.DataTrieTracker().RetrieveValue(tokenKey)
+	esdtMetaData := ESDTGlobalMetadataFromBytes(val)
+
+	return esdtMetaData.TokenType
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtSetTokenType) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("built in function: esdt set token type", "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtSetTokenType) IsInterfaceNil() bool {
+	return e == nil
+}
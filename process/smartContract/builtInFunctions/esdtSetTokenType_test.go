@@ -0,0 +1,82 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTSetTokenTypeFunc_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setTokenTypeFunc, err := NewESDTSetTokenTypeFunc(nil, 0, &mock.EpochNotifierStub{})
+	assert.Nil(t, setTokenTypeFunc)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestESDTSetTokenType_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	acnt, _ := state.NewUserAccount(core.SystemAccountAddress)
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return acnt, nil
+		},
+	}
+	setTokenTypeFunc, _ := NewESDTSetTokenTypeFunc(accounts, 0, &mock.EpochNotifierStub{})
+	_, err := setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	key := []byte("key")
+	input.Arguments = [][]byte{key, []byte(core.FungibleESDT)}
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vm.ESDTSCAddress
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrOnlySystemAccountAccepted, err)
+
+	input.RecipientAddr = core.SystemAccountAddress
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidESDTTokenType, err)
+
+	tokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(key))
+	assert.Equal(t, core.Fungible, setTokenTypeFunc.GetTokenType(tokenKey))
+
+	input.Arguments = [][]byte{key, []byte(core.SemiFungibleESDT)}
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Nil(t, err)
+	assert.Equal(t, core.SemiFungible, setTokenTypeFunc.GetTokenType(tokenKey))
+
+	pauseFunc, _ := NewESDTPauseFunc(accounts, true)
+	_, err = pauseFunc.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue:  big.NewInt(0),
+			CallerAddr: vm.ESDTSCAddress,
+			Arguments:  [][]byte{key},
+		},
+		RecipientAddr: core.SystemAccountAddress,
+	})
+	assert.Nil(t, err)
+	assert.True(t, pauseFunc.IsPaused(tokenKey))
+	assert.Equal(t, core.SemiFungible, setTokenTypeFunc.GetTokenType(tokenKey))
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
@@ -21,12 +22,16 @@ var _ process.BuiltinFunction = (*esdtTransfer)(nil)
 var zero = big.NewInt(0)
 
 type esdtTransfer struct {
-	funcGasCost    uint64
-	marshalizer    marshal.Marshalizer
-	keyPrefix      []byte
-	pauseHandler   process.ESDTPauseHandler
-	payableHandler process.PayableHandler
-	mutExecution   sync.RWMutex
+	funcGasCost            uint64
+	marshalizer            marshal.Marshalizer
+	keyPrefix              []byte
+	pauseHandler           process.ESDTPauseHandler
+	limitedTransferHandler process.ESDTLimitedTransferHandler
+	payableHandler         process.PayableHandler
+	mutExecution           sync.RWMutex
+
+	limitedTransferEnableEpoch uint32
+	flagLimitedTransfer        atomic.Flag
 }
 
 // NewESDTTransferFunc returns the esdt transfer built-in function component
@@ -34,6 +39,9 @@ func NewESDTTransferFunc(
 	funcGasCost uint64,
 	marshalizer marshal.Marshalizer,
 	pauseHandler process.ESDTPauseHandler,
+	limitedTransferHandler process.ESDTLimitedTransferHandler,
+	limitedTransferEnableEpoch uint32,
+	epochNotifier process.EpochNotifier,
 ) (*esdtTransfer, error) {
 	if check.IfNil(marshalizer) {
 		return nil, process.ErrNilMarshalizer
@@ -41,14 +49,24 @@ func NewESDTTransferFunc(
 	if check.IfNil(pauseHandler) {
 		return nil, process.ErrNilPauseHandler
 	}
+	if check.IfNil(limitedTransferHandler) {
+		return nil, process.ErrNilLimitedTransferHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	e := &esdtTransfer{
-		funcGasCost:    funcGasCost,
-		marshalizer:    marshalizer,
-		keyPrefix:      []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
-		pauseHandler:   pauseHandler,
-		payableHandler: &disabledPayableHandler{},
+		funcGasCost:            funcGasCost,
+		marshalizer:            marshalizer,
+		keyPrefix:              []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		pauseHandler:           pauseHandler,
+		limitedTransferHandler: limitedTransferHandler,
+		payableHandler:         &disabledPayableHandler{},
+
+		limitedTransferEnableEpoch: limitedTransferEnableEpoch,
 	}
+	epochNotifier.RegisterNotifyHandler(e)
 
 	return e, nil
 }
@@ -93,7 +111,12 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 			return nil, process.ErrNotEnoughGas
 		}
 
-		err := addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler)
+		err := e.checkTransferRole(vmInput.CallerAddr, acntSnd, vmInput.Arguments[0], esdtTokenKey)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler)
 		if err != nil {
 			return nil, err
 		}
@@ -114,7 +137,12 @@ func (e *esdtTransfer) ProcessBuiltinFunction(
 			}
 		}
 
-		err := addToESDTBalance(vmInput.CallerAddr, acntDst, esdtTokenKey, value, e.marshalizer, e.pauseHandler)
+		err := e.checkTransferRole(vmInput.CallerAddr, acntDst, vmInput.Arguments[0], esdtTokenKey)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addToESDTBalance(vmInput.CallerAddr, acntDst, esdtTokenKey, value, e.marshalizer, e.pauseHandler)
 		if err != nil {
 			return nil, err
 		}
@@ -216,6 +244,38 @@ func addToESDTBalance(
 	return nil
 }
 
+func (e *esdtTransfer) checkTransferRole(senderAddr []byte, userAcnt state.UserAccountHandler, tokenID []byte, key []byte) error {
+	if !e.flagLimitedTransfer.IsSet() {
+		return nil
+	}
+
+	return checkTransferRole(senderAddr, userAcnt, tokenID, key, e.marshalizer, e.limitedTransferHandler)
+}
+
+// checkTransferRole verifies that the account holds the transfer role, if the token transfer is restricted
+func checkTransferRole(
+	senderAddr []byte,
+	userAcnt state.UserAccountHandler,
+	tokenID []byte,
+	key []byte,
+	marshalizer marshal.Marshalizer,
+	limitedTransferHandler process.ESDTLimitedTransferHandler,
+) error {
+	if bytes.Equal(senderAddr, vm.ESDTSCAddress) {
+		return nil
+	}
+	if !limitedTransferHandler.IsLimitedTransfer(key) {
+		return nil
+	}
+
+	err := checkAllowedToExecute(marshalizer, userAcnt, tokenID, []byte(core.ESDTRoleTransfer))
+	if err == process.ErrActionNotAllowed {
+		return process.ErrESDTTransferIsRestricted
+	}
+
+	return err
+}
+
 func saveESDTData(
 	userAcnt state.UserAccountHandler,
 	esdtData *esdt.ESDigitalToken,
@@ -259,6 +319,12 @@ func (e *esdtTransfer) setPayableHandler(payableHandler process.PayableHandler)
 	return nil
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtTransfer) EpochConfirmed(epoch uint32) {
+	e.flagLimitedTransfer.Toggle(epoch >= e.limitedTransferEnableEpoch)
+	log.Debug("built in function: esdt transfer limited transfer check", "enabled", e.flagLimitedTransfer.IsSet())
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *esdtTransfer) IsInterfaceNil() bool {
 	return e == nil
@@ -17,7 +17,7 @@ import (
 func TestESDTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
 	t.Parallel()
 
-	transferFunc, _ := NewESDTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{})
+	transferFunc, _ := NewESDTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, &mock.EpochNotifierStub{})
 	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
 	_, err := transferFunc.ProcessBuiltinFunction(nil, nil, nil)
 	assert.Equal(t, err, process.ErrNilVmInput)
@@ -52,7 +52,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSingleShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, &mock.EpochNotifierStub{})
 	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -90,7 +90,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionSenderInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, &mock.EpochNotifierStub{})
 	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -120,7 +120,7 @@ func TestESDTTransfer_ProcessBuiltInFunctionDestInShard(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &mock.MarshalizerMock{}
-	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{}, 0, &mock.EpochNotifierStub{})
 	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -150,7 +150,7 @@ func TestESDTTransfer_SndDstFrozen(t *testing.T) {
 	marshalizer := &mock.MarshalizerMock{}
 	accountStub := &mock.AccountsStub{}
 	esdtPauseFunc, _ := NewESDTPauseFunc(accountStub, true)
-	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, esdtPauseFunc)
+	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, esdtPauseFunc, &mock.LimitedTransferHandlerStub{}, 0, &mock.EpochNotifierStub{})
 	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
 
 	input := &vmcommon.ContractCallInput{
@@ -210,3 +210,83 @@ func TestESDTTransfer_SndDstFrozen(t *testing.T) {
 	_, err = transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
 	assert.Equal(t, err, process.ErrESDTTokenIsPaused)
 }
+
+func TestESDTTransfer_LimitedTransferRequiresTransferRole(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{
+		IsLimitedTransferCalled: func(_ []byte) bool {
+			return true
+		},
+	}, 0, &mock.EpochNotifierStub{})
+	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{key, big.NewInt(10).Bytes()},
+		},
+	}
+	accSnd := createUserAccountWithEmptyDataTrie([]byte("snd"))
+	accDst := createUserAccountWithEmptyDataTrie([]byte("dst"))
+
+	esdtKey := append(transferFunc.keyPrefix, key...)
+	esdtToken := &esdt.ESDigitalToken{Value: big.NewInt(100)}
+	marshaledData, _ := marshalizer.Marshal(esdtToken)
+	_ = accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshaledData)
+
+	_, err := transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrESDTTransferIsRestricted, err)
+
+	setRolesOnAccount(t, accSnd, key, core.ESDTRoleTransfer)
+	_, err = transferFunc.ProcessBuiltinFunction(accSnd, nil, input)
+	assert.Nil(t, err)
+
+	_, err = transferFunc.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Equal(t, process.ErrESDTTransferIsRestricted, err)
+
+	setRolesOnAccount(t, accDst, key, core.ESDTRoleTransfer)
+	_, err = transferFunc.ProcessBuiltinFunction(nil, accDst, input)
+	assert.Nil(t, err)
+
+	assert.Equal(t, big.NewInt(90), getESDTBalance(accSnd, transferFunc.keyPrefix, string(key)))
+	assert.Equal(t, big.NewInt(10), getESDTBalance(accDst, transferFunc.keyPrefix, string(key)))
+}
+
+func TestESDTTransfer_LimitedTransferNotCheckedBeforeEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	transferFunc, _ := NewESDTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.LimitedTransferHandlerStub{
+		IsLimitedTransferCalled: func(_ []byte) bool {
+			return true
+		},
+	}, 1, &mock.EpochNotifierStub{})
+	_ = transferFunc.setPayableHandler(&mock.PayableHandlerStub{})
+
+	key := []byte("key")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			GasProvided: 50,
+			CallValue:   big.NewInt(0),
+			Arguments:   [][]byte{key, big.NewInt(10).Bytes()},
+		},
+	}
+	accSnd := createUserAccountWithEmptyDataTrie([]byte("snd"))
+	accDst := createUserAccountWithEmptyDataTrie([]byte("dst"))
+
+	esdtKey := append(transferFunc.keyPrefix, key...)
+	esdtToken := &esdt.ESDigitalToken{Value: big.NewInt(100)}
+	marshaledData, _ := marshalizer.Marshal(esdtToken)
+	_ = accSnd.DataTrieTracker().SaveKeyValue(esdtKey, marshaledData)
+
+	_, err := transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Nil(t, err)
+
+	transferFunc.EpochConfirmed(1)
+	_, err = transferFunc.ProcessBuiltinFunction(accSnd, accDst, input)
+	assert.Equal(t, process.ErrESDTTransferIsRestricted, err)
+}
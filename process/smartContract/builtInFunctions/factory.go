@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -14,20 +15,36 @@ var log = logger.GetOrCreate("process/smartContract/builtInFunctions")
 
 // ArgsCreateBuiltInFunctionContainer -
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasSchedule          core.GasScheduleNotifier
-	MapDNSAddresses      map[string]struct{}
-	EnableUserNameChange bool
-	Marshalizer          marshal.Marshalizer
-	Accounts             state.AccountsAdapter
+	GasSchedule                    core.GasScheduleNotifier
+	MapDNSAddresses                map[string]struct{}
+	EnableUserNameChange           bool
+	Marshalizer                    marshal.Marshalizer
+	Accounts                       state.AccountsAdapter
+	ShardCoordinator               sharding.Coordinator
+	GuardedAccounts                process.GuardedAccountHandler
+	GuardianEnableEpoch            uint32
+	ESDTNFTEnableEpoch             uint32
+	ESDTRolesEnableEpoch           uint32
+	ESDTMultiTransferEnableEpoch   uint32
+	ESDTLimitedTransferEnableEpoch uint32
+	EpochNotifier                  process.EpochNotifier
 }
 
 type builtInFuncFactory struct {
-	mapDNSAddresses      map[string]struct{}
-	enableUserNameChange bool
-	marshalizer          marshal.Marshalizer
-	accounts             state.AccountsAdapter
-	builtInFunctions     process.BuiltInFunctionContainer
-	gasConfig            *process.GasCost
+	mapDNSAddresses                map[string]struct{}
+	enableUserNameChange           bool
+	marshalizer                    marshal.Marshalizer
+	accounts                       state.AccountsAdapter
+	shardCoordinator               sharding.Coordinator
+	guardedAccounts                process.GuardedAccountHandler
+	guardianEnableEpoch            uint32
+	esdtNFTEnableEpoch             uint32
+	esdtRolesEnableEpoch           uint32
+	esdtMultiTransferEnableEpoch   uint32
+	esdtLimitedTransferEnableEpoch uint32
+	epochNotifier                  process.EpochNotifier
+	builtInFunctions               process.BuiltInFunctionContainer
+	gasConfig                      *process.GasCost
 }
 
 // NewBuiltInFunctionsFactory creates a factory which will instantiate the built in functions contracts
@@ -44,12 +61,29 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	if args.MapDNSAddresses == nil {
 		return nil, process.ErrNilDnsAddresses
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.GuardedAccounts) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	b := &builtInFuncFactory{
-		mapDNSAddresses:      args.MapDNSAddresses,
-		enableUserNameChange: args.EnableUserNameChange,
-		marshalizer:          args.Marshalizer,
-		accounts:             args.Accounts,
+		mapDNSAddresses:                args.MapDNSAddresses,
+		enableUserNameChange:           args.EnableUserNameChange,
+		marshalizer:                    args.Marshalizer,
+		accounts:                       args.Accounts,
+		shardCoordinator:               args.ShardCoordinator,
+		guardedAccounts:                args.GuardedAccounts,
+		guardianEnableEpoch:            args.GuardianEnableEpoch,
+		esdtNFTEnableEpoch:             args.ESDTNFTEnableEpoch,
+		esdtRolesEnableEpoch:           args.ESDTRolesEnableEpoch,
+		esdtMultiTransferEnableEpoch:   args.ESDTMultiTransferEnableEpoch,
+		esdtLimitedTransferEnableEpoch: args.ESDTLimitedTransferEnableEpoch,
+		epochNotifier:                  args.EpochNotifier,
 	}
 
 	var err error
@@ -126,7 +160,32 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
-	newFunc, err = NewESDTTransferFunc(b.gasConfig.BuiltInCost.ESDTTransfer, b.marshalizer, pauseFunc)
+	limitedTransferFunc, err := NewESDTLimitedTransferFunc(b.accounts, true, b.esdtLimitedTransferEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTSetLimitedTransfer, limitedTransferFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTLimitedTransferFunc(b.accounts, false, b.esdtLimitedTransferEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTUnSetLimitedTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTTransferFunc(
+		b.gasConfig.BuiltInCost.ESDTTransfer,
+		b.marshalizer,
+		pauseFunc,
+		limitedTransferFunc,
+		b.esdtLimitedTransferEnableEpoch,
+		b.epochNotifier,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +239,157 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
+	newFunc, err = NewESDTRolesFunc(b.marshalizer, true, b.esdtRolesEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionSetESDTRole, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTRolesFunc(b.marshalizer, false, b.esdtRolesEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionUnSetESDTRole, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	setTokenTypeFunc, err := NewESDTSetTokenTypeFunc(b.accounts, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTSetTokenType, setTokenTypeFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTCreateFunc(
+		b.gasConfig.BuiltInCost.ESDTNFTCreate,
+		b.gasConfig.BaseOperationCost,
+		b.marshalizer,
+		pauseFunc,
+		setTokenTypeFunc,
+		b.esdtNFTEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTCreate, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTAddQuantityFunc(
+		b.gasConfig.BuiltInCost.ESDTNFTAddQuantity,
+		b.marshalizer,
+		pauseFunc,
+		b.esdtNFTEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTAddQuantity, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTBurnFunc(b.gasConfig.BuiltInCost.ESDTNFTBurn, b.marshalizer, pauseFunc, b.esdtNFTEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTTransferFunc(
+		b.gasConfig.BuiltInCost.ESDTNFTTransfer,
+		b.marshalizer,
+		pauseFunc,
+		b.accounts,
+		b.shardCoordinator,
+		b.gasConfig.BaseOperationCost,
+		b.esdtNFTEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTLocalMintFunc(
+		b.gasConfig.BuiltInCost.ESDTLocalMint,
+		b.marshalizer,
+		pauseFunc,
+		b.esdtRolesEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTLocalMint, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTLocalBurnFunc(
+		b.gasConfig.BuiltInCost.ESDTLocalBurn,
+		b.marshalizer,
+		pauseFunc,
+		b.esdtRolesEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTLocalBurn, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTMultiTransferFunc(
+		b.gasConfig.BuiltInCost.ESDTMultiTransfer,
+		b.marshalizer,
+		pauseFunc,
+		limitedTransferFunc,
+		b.esdtMultiTransferEnableEpoch,
+		b.esdtLimitedTransferEnableEpoch,
+		b.epochNotifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTMultiTransfer, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewSetGuardianFunc(b.gasConfig.BuiltInCost.SetGuardian, b.guardedAccounts, b.guardianEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionSetGuardian, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewRemoveGuardianFunc(b.gasConfig.BuiltInCost.RemoveGuardian, b.guardedAccounts, b.guardianEnableEpoch, b.epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+	err = b.builtInFunctions.Add(core.BuiltInFunctionRemoveGuardian, newFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return b.builtInFunctions, nil
 }
 
@@ -228,7 +438,24 @@ func SetPayableHandler(container process.BuiltInFunctionContainer, payableHandle
 		return process.ErrWrongTypeAssertion
 	}
 
-	return esdtTransferFunc.setPayableHandler(payableHandler)
+	err = esdtTransferFunc.setPayableHandler(payableHandler)
+	if err != nil {
+		return err
+	}
+
+	builtInFunc, err = container.Get(core.BuiltInFunctionESDTMultiTransfer)
+	if err != nil {
+		log.Warn("SetIsPayable", "error", err.Error())
+		return err
+	}
+
+	esdtMultiTransferFunc, ok := builtInFunc.(*esdtMultiTransfer)
+	if !ok {
+		log.Warn("SetIsPayable", "error", process.ErrWrongTypeAssertion)
+		return process.ErrWrongTypeAssertion
+	}
+
+	return esdtMultiTransferFunc.setPayableHandler(payableHandler)
 }
 
 // IsInterfaceNil returns true if underlying object is nil
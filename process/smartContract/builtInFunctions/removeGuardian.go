@@ -0,0 +1,101 @@
+package builtInFunctions
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*removeGuardian)(nil)
+
+type removeGuardian struct {
+	gasCost               uint64
+	guardedAccountHandler process.GuardedAccountHandler
+	guardianEnableEpoch   uint32
+	flagEnabled           atomic.Flag
+	mutExecution          sync.RWMutex
+}
+
+// NewRemoveGuardianFunc creates a new remove guardian built-in function
+func NewRemoveGuardianFunc(
+	gasCost uint64,
+	guardedAccountHandler process.GuardedAccountHandler,
+	guardianEnableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*removeGuardian, error) {
+	if check.IfNil(guardedAccountHandler) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	r := &removeGuardian{
+		gasCost:               gasCost,
+		guardedAccountHandler: guardedAccountHandler,
+		guardianEnableEpoch:   guardianEnableEpoch,
+	}
+	epochNotifier.RegisterNotifyHandler(r)
+
+	return r, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (r *removeGuardian) SetNewGasConfig(gasCost *process.GasCost) {
+	r.mutExecution.Lock()
+	r.gasCost = gasCost.BuiltInCost.RemoveGuardian
+	r.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction schedules the removal of the guardian of the caller account
+func (r *removeGuardian) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !r.flagEnabled.IsSet() {
+		return nil, process.ErrGuardedAccountsNotEnabled
+	}
+
+	r.mutExecution.RLock()
+	defer r.mutExecution.RUnlock()
+
+	err := checkArgumentsForGuardianChange(acntDst, vmInput, r.gasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 0 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	err = r.guardedAccountHandler.RemoveGuardian(acntDst)
+	if err != nil {
+		return nil, err
+	}
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(core.BuiltInFunctionRemoveGuardian),
+		Address:    acntDst.AddressBytes(),
+	}
+
+	return &vmcommon.VMOutput{
+		GasRemaining: computeGasRemaining(acntSnd, vmInput.GasProvided, r.gasCost),
+		ReturnCode:   vmcommon.Ok,
+		Logs:         []*vmcommon.LogEntry{logEntry},
+	}, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (r *removeGuardian) EpochConfirmed(epoch uint32) {
+	r.flagEnabled.Toggle(epoch >= r.guardianEnableEpoch)
+	log.Debug("built in function: remove guardian", "enabled", r.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (r *removeGuardian) IsInterfaceNil() bool {
+	return r == nil
+}
@@ -0,0 +1,106 @@
+package builtInFunctions
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRemoveGuardianFunc_NilGuardedAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rg, err := NewRemoveGuardianFunc(10, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(rg))
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+}
+
+func TestNewRemoveGuardianFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rg, err := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(rg))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestRemoveGuardian_ProcessBuiltinFunctionNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	removeGuardianCalled := false
+	rg, _ := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		RemoveGuardianCalled: func(account state.UserAccountHandler) error {
+			removeGuardianCalled = true
+			return nil
+		},
+	}, 1, &mock.EpochNotifierStub{})
+	owner := []byte("owner-address")
+	acc, _ := state.NewUserAccount(owner)
+	vmInput := createGuardianChangeInput(owner, nil)
+
+	_, err := rg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, err)
+	assert.False(t, removeGuardianCalled)
+
+	rg.EpochConfirmed(1)
+	_, err = rg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Nil(t, err)
+	assert.True(t, removeGuardianCalled)
+}
+
+func TestRemoveGuardian_ProcessBuiltinFunctionWithArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rg, _ := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	owner := []byte("owner-address")
+	acc, _ := state.NewUserAccount(owner)
+
+	vmInput := createGuardianChangeInput(owner, [][]byte{[]byte("argument")})
+	_, err := rg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
+
+func TestRemoveGuardian_ProcessBuiltinFunctionNoGuardianShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rg, _ := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		RemoveGuardianCalled: func(account state.UserAccountHandler) error {
+			return process.ErrNoGuardianToRemove
+		},
+	}, 0, &mock.EpochNotifierStub{})
+	owner := []byte("owner-address")
+	acc, _ := state.NewUserAccount(owner)
+
+	vmInput := createGuardianChangeInput(owner, nil)
+	_, err := rg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrNoGuardianToRemove, err)
+}
+
+func TestRemoveGuardian_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	removeGuardianCalled := false
+	rg, _ := NewRemoveGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		RemoveGuardianCalled: func(account state.UserAccountHandler) error {
+			removeGuardianCalled = true
+			return nil
+		},
+	}, 0, &mock.EpochNotifierStub{})
+	owner := []byte("owner-address")
+	acc, _ := state.NewUserAccount(owner)
+
+	vmInput := createGuardianChangeInput(owner, nil)
+	vmOutput, err := rg.ProcessBuiltinFunction(acc, acc, vmInput)
+	require.Nil(t, err)
+	assert.True(t, removeGuardianCalled)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(core.BuiltInFunctionRemoveGuardian), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, owner, vmOutput.Logs[0].Address)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+}
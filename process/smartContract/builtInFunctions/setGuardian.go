@@ -0,0 +1,135 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*setGuardian)(nil)
+
+type setGuardian struct {
+	gasCost               uint64
+	guardedAccountHandler process.GuardedAccountHandler
+	guardianEnableEpoch   uint32
+	flagEnabled           atomic.Flag
+	mutExecution          sync.RWMutex
+}
+
+// NewSetGuardianFunc creates a new set guardian built-in function
+func NewSetGuardianFunc(
+	gasCost uint64,
+	guardedAccountHandler process.GuardedAccountHandler,
+	guardianEnableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*setGuardian, error) {
+	if check.IfNil(guardedAccountHandler) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	s := &setGuardian{
+		gasCost:               gasCost,
+		guardedAccountHandler: guardedAccountHandler,
+		guardianEnableEpoch:   guardianEnableEpoch,
+	}
+	epochNotifier.RegisterNotifyHandler(s)
+
+	return s, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (s *setGuardian) SetNewGasConfig(gasCost *process.GasCost) {
+	s.mutExecution.Lock()
+	s.gasCost = gasCost.BuiltInCost.SetGuardian
+	s.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction schedules the provided address as the guardian of the caller account
+func (s *setGuardian) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !s.flagEnabled.IsSet() {
+		return nil, process.ErrGuardedAccountsNotEnabled
+	}
+
+	s.mutExecution.RLock()
+	defer s.mutExecution.RUnlock()
+
+	err := checkArgumentsForGuardianChange(acntDst, vmInput, s.gasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	guardianAddress := vmInput.Arguments[0]
+	if len(guardianAddress) != len(vmInput.CallerAddr) {
+		return nil, process.ErrInvalidAddressLength
+	}
+	if bytes.Equal(guardianAddress, vmInput.CallerAddr) {
+		return nil, process.ErrGuardianSameAsSender
+	}
+
+	err = s.guardedAccountHandler.SetGuardian(acntDst, guardianAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(core.BuiltInFunctionSetGuardian),
+		Address:    acntDst.AddressBytes(),
+		Topics:     [][]byte{guardianAddress},
+	}
+
+	return &vmcommon.VMOutput{
+		GasRemaining: computeGasRemaining(acntSnd, vmInput.GasProvided, s.gasCost),
+		ReturnCode:   vmcommon.Ok,
+		Logs:         []*vmcommon.LogEntry{logEntry},
+	}, nil
+}
+
+func checkArgumentsForGuardianChange(acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput, gasCost uint64) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if check.IfNil(acntDst) {
+		return process.ErrNilSCDestAccount
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return fmt.Errorf("%w not the owner of the account", process.ErrOperationNotPermitted)
+	}
+	if core.IsSmartContractAddress(vmInput.CallerAddr) {
+		return fmt.Errorf("%w guardians are not allowed for smart contracts", process.ErrOperationNotPermitted)
+	}
+	if vmInput.GasProvided < gasCost {
+		return process.ErrNotEnoughGas
+	}
+
+	return nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (s *setGuardian) EpochConfirmed(epoch uint32) {
+	s.flagEnabled.Toggle(epoch >= s.guardianEnableEpoch)
+	log.Debug("built in function: set guardian", "enabled", s.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (s *setGuardian) IsInterfaceNil() bool {
+	return s == nil
+}
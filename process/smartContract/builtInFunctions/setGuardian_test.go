@@ -0,0 +1,156 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGuardianChangeInput(caller []byte, arguments [][]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			Arguments:   arguments,
+			GasProvided: 100,
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func TestNewSetGuardianFunc_NilGuardedAccountHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sg, err := NewSetGuardianFunc(10, nil, 0, &mock.EpochNotifierStub{})
+	assert.True(t, check.IfNil(sg))
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+}
+
+func TestNewSetGuardianFunc_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sg, err := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, nil)
+	assert.True(t, check.IfNil(sg))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setGuardianCalled := false
+	sg, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		SetGuardianCalled: func(account state.UserAccountHandler, guardianAddress []byte) error {
+			setGuardianCalled = true
+			return nil
+		},
+	}, 1, &mock.EpochNotifierStub{})
+	owner := []byte("owner-address")
+	acc, _ := state.NewUserAccount(owner)
+	vmInput := createGuardianChangeInput(owner, [][]byte{[]byte("guard-address")})
+
+	_, err := sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, err)
+	assert.False(t, setGuardianCalled)
+
+	sg.EpochConfirmed(1)
+	_, err = sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Nil(t, err)
+	assert.True(t, setGuardianCalled)
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionInvalidInputShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sg, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{}, 0, &mock.EpochNotifierStub{})
+	owner := []byte("owner-address")
+	guardian := []byte("guard-address")
+	acc, _ := state.NewUserAccount(owner)
+
+	_, err := sg.ProcessBuiltinFunction(acc, acc, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	vmInput := createGuardianChangeInput(owner, [][]byte{guardian})
+	vmInput.CallValue = big.NewInt(1)
+	_, err = sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	vmInput = createGuardianChangeInput(owner, [][]byte{guardian})
+	_, err = sg.ProcessBuiltinFunction(acc, nil, vmInput)
+	assert.Equal(t, process.ErrNilSCDestAccount, err)
+
+	vmInput = createGuardianChangeInput(owner, [][]byte{guardian})
+	vmInput.RecipientAddr = guardian
+	_, err = sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.True(t, errors.Is(err, process.ErrOperationNotPermitted))
+
+	vmInput = createGuardianChangeInput(owner, [][]byte{guardian})
+	vmInput.GasProvided = 1
+	_, err = sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	vmInput = createGuardianChangeInput(owner, nil)
+	_, err = sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	vmInput = createGuardianChangeInput(owner, [][]byte{[]byte("short")})
+	_, err = sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrInvalidAddressLength, err)
+
+	vmInput = createGuardianChangeInput(owner, [][]byte{owner})
+	_, err = sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, process.ErrGuardianSameAsSender, err)
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionHandlerErrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	sg, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		SetGuardianCalled: func(account state.UserAccountHandler, guardianAddress []byte) error {
+			return expectedErr
+		},
+	}, 0, &mock.EpochNotifierStub{})
+	owner := []byte("owner-address")
+	acc, _ := state.NewUserAccount(owner)
+
+	vmInput := createGuardianChangeInput(owner, [][]byte{[]byte("guard-address")})
+	_, err := sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestSetGuardian_ProcessBuiltinFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner-address")
+	guardian := []byte("guard-address")
+	setGuardianCalled := false
+	sg, _ := NewSetGuardianFunc(10, &mock.GuardedAccountHandlerStub{
+		SetGuardianCalled: func(account state.UserAccountHandler, guardianAddress []byte) error {
+			setGuardianCalled = true
+			assert.Equal(t, owner, account.AddressBytes())
+			assert.Equal(t, guardian, guardianAddress)
+			return nil
+		},
+	}, 0, &mock.EpochNotifierStub{})
+	acc, _ := state.NewUserAccount(owner)
+
+	vmInput := createGuardianChangeInput(owner, [][]byte{guardian})
+	vmOutput, err := sg.ProcessBuiltinFunction(acc, acc, vmInput)
+	require.Nil(t, err)
+	assert.True(t, setGuardianCalled)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(core.BuiltInFunctionSetGuardian), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, owner, vmOutput.Logs[0].Address)
+	assert.Equal(t, [][]byte{guardian}, vmOutput.Logs[0].Topics)
+}
@@ -0,0 +1,33 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type disabledHistoricalStateHandler struct {
+}
+
+// NewDisabledHistoricalStateHandler returns a historical state handler which rejects all historical queries
+func NewDisabledHistoricalStateHandler() *disabledHistoricalStateHandler {
+	return &disabledHistoricalStateHandler{}
+}
+
+// SetStateForBlockNonce returns ErrHistoricalStateNotSupported
+func (d *disabledHistoricalStateHandler) SetStateForBlockNonce(_ uint64) (data.HeaderHandler, error) {
+	return nil, process.ErrHistoricalStateNotSupported
+}
+
+// SetStateForRootHash returns ErrHistoricalStateNotSupported
+func (d *disabledHistoricalStateHandler) SetStateForRootHash(_ []byte) error {
+	return process.ErrHistoricalStateNotSupported
+}
+
+// ResetState does nothing
+func (d *disabledHistoricalStateHandler) ResetState() {
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (d *disabledHistoricalStateHandler) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,242 @@
+package smartContract
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ state.AccountsAdapter = (*historicalAccountsDB)(nil)
+var _ process.HistoricalStateHandler = (*historicalAccountsDB)(nil)
+
+// ArgsHistoricalAccountsDB represents the arguments structure for the historical accounts db
+type ArgsHistoricalAccountsDB struct {
+	Accounts         state.AccountsAdapter
+	Hasher           hashing.Hasher
+	Marshalizer      marshal.Marshalizer
+	StorageService   dataRetriever.StorageService
+	Uint64Converter  typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator sharding.Coordinator
+}
+
+// historicalAccountsDB is a wrapper over an accounts db which can temporarily redirect all the calls towards
+// a separate accounts db instance recreated from the state of a past block. It is meant to be used by the
+// blockchain hook of the SC query service, so that block processing is never affected by historical queries
+type historicalAccountsDB struct {
+	originalAccounts state.AccountsAdapter
+	hasher           hashing.Hasher
+	marshalizer      marshal.Marshalizer
+	storageService   dataRetriever.StorageService
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	shardCoordinator sharding.Coordinator
+
+	mutAccounts    sync.RWMutex
+	activeAccounts state.AccountsAdapter
+}
+
+// NewHistoricalAccountsDB returns a new instance of historicalAccountsDB
+func NewHistoricalAccountsDB(args ArgsHistoricalAccountsDB) (*historicalAccountsDB, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	return &historicalAccountsDB{
+		originalAccounts: args.Accounts,
+		hasher:           args.Hasher,
+		marshalizer:      args.Marshalizer,
+		storageService:   args.StorageService,
+		uint64Converter:  args.Uint64Converter,
+		shardCoordinator: args.ShardCoordinator,
+		activeAccounts:   args.Accounts,
+	}, nil
+}
+
+// SetStateForBlockNonce redirects all the calls towards the state of the block with the provided nonce
+// and returns the header of that block
+func (h *historicalAccountsDB) SetStateForBlockNonce(nonce uint64) (data.HeaderHandler, error) {
+	header, _, err := process.GetHeaderFromStorageWithNonce(
+		nonce,
+		h.shardCoordinator.SelfId(),
+		h.storageService,
+		h.uint64Converter,
+		h.marshalizer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: block with nonce %d: %v", process.ErrMissingHeader, nonce, err)
+	}
+
+	err = h.SetStateForRootHash(header.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// SetStateForRootHash redirects all the calls towards the state identified by the provided root hash
+func (h *historicalAccountsDB) SetStateForRootHash(rootHash []byte) error {
+	emptyTrie, err := h.originalAccounts.GetTrie(nil)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(emptyTrie) {
+		return process.ErrNilTrie
+	}
+
+	accounts, err := state.NewAccountsDB(emptyTrie, h.hasher, h.marshalizer, factory.NewAccountCreator())
+	if err != nil {
+		return err
+	}
+
+	err = accounts.RecreateTrie(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w: root hash %x: %v", process.ErrStatePruned, rootHash, err)
+	}
+
+	h.mutAccounts.Lock()
+	h.activeAccounts = accounts
+	h.mutAccounts.Unlock()
+
+	return nil
+}
+
+// ResetState redirects all the calls back towards the original accounts db
+func (h *historicalAccountsDB) ResetState() {
+	h.mutAccounts.Lock()
+	h.activeAccounts = h.originalAccounts
+	h.mutAccounts.Unlock()
+}
+
+func (h *historicalAccountsDB) getActiveAccounts() state.AccountsAdapter {
+	h.mutAccounts.RLock()
+	defer h.mutAccounts.RUnlock()
+
+	return h.activeAccounts
+}
+
+// GetExistingAccount will call the active accounts' function with the same name
+func (h *historicalAccountsDB) GetExistingAccount(address []byte) (state.AccountHandler, error) {
+	return h.getActiveAccounts().GetExistingAccount(address)
+}
+
+// LoadAccount will call the active accounts' function with the same name
+func (h *historicalAccountsDB) LoadAccount(address []byte) (state.AccountHandler, error) {
+	return h.getActiveAccounts().LoadAccount(address)
+}
+
+// SaveAccount will call the active accounts' function with the same name
+func (h *historicalAccountsDB) SaveAccount(account state.AccountHandler) error {
+	return h.getActiveAccounts().SaveAccount(account)
+}
+
+// RemoveAccount will call the active accounts' function with the same name
+func (h *historicalAccountsDB) RemoveAccount(address []byte) error {
+	return h.getActiveAccounts().RemoveAccount(address)
+}
+
+// Commit will call the active accounts' function with the same name
+func (h *historicalAccountsDB) Commit() ([]byte, error) {
+	return h.getActiveAccounts().Commit()
+}
+
+// JournalLen will call the active accounts' function with the same name
+func (h *historicalAccountsDB) JournalLen() int {
+	return h.getActiveAccounts().JournalLen()
+}
+
+// RevertToSnapshot will call the active accounts' function with the same name
+func (h *historicalAccountsDB) RevertToSnapshot(snapshot int) error {
+	return h.getActiveAccounts().RevertToSnapshot(snapshot)
+}
+
+// GetNumCheckpoints will call the active accounts' function with the same name
+func (h *historicalAccountsDB) GetNumCheckpoints() uint32 {
+	return h.getActiveAccounts().GetNumCheckpoints()
+}
+
+// RootHash will call the active accounts' function with the same name
+func (h *historicalAccountsDB) RootHash() ([]byte, error) {
+	return h.getActiveAccounts().RootHash()
+}
+
+// RecreateTrie will call the active accounts' function with the same name
+func (h *historicalAccountsDB) RecreateTrie(rootHash []byte) error {
+	return h.getActiveAccounts().RecreateTrie(rootHash)
+}
+
+// PruneTrie will call the active accounts' function with the same name
+func (h *historicalAccountsDB) PruneTrie(rootHash []byte, identifier data.TriePruningIdentifier) {
+	h.getActiveAccounts().PruneTrie(rootHash, identifier)
+}
+
+// CancelPrune will call the active accounts' function with the same name
+func (h *historicalAccountsDB) CancelPrune(rootHash []byte, identifier data.TriePruningIdentifier) {
+	h.getActiveAccounts().CancelPrune(rootHash, identifier)
+}
+
+// SnapshotState will call the active accounts' function with the same name
+func (h *historicalAccountsDB) SnapshotState(rootHash []byte, ctx context.Context) {
+	h.getActiveAccounts().SnapshotState(rootHash, ctx)
+}
+
+// SetStateCheckpoint will call the active accounts' function with the same name
+func (h *historicalAccountsDB) SetStateCheckpoint(rootHash []byte, ctx context.Context) {
+	h.getActiveAccounts().SetStateCheckpoint(rootHash, ctx)
+}
+
+// IsPruningEnabled will call the active accounts' function with the same name
+func (h *historicalAccountsDB) IsPruningEnabled() bool {
+	return h.getActiveAccounts().IsPruningEnabled()
+}
+
+// GetAllLeaves will call the active accounts' function with the same name
+func (h *historicalAccountsDB) GetAllLeaves(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error) {
+	return h.getActiveAccounts().GetAllLeaves(rootHash, ctx)
+}
+
+// RecreateAllTries will call the active accounts' function with the same name
+func (h *historicalAccountsDB) RecreateAllTries(rootHash []byte, ctx context.Context) (map[string]data.Trie, error) {
+	return h.getActiveAccounts().RecreateAllTries(rootHash, ctx)
+}
+
+// GetTrie will call the active accounts' function with the same name
+func (h *historicalAccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	return h.getActiveAccounts().GetTrie(rootHash)
+}
+
+// GetStateDiff will call the active accounts' function with the same name
+func (h *historicalAccountsDB) GetStateDiff(fromRootHash []byte, toRootHash []byte, handler func(entry *state.StateDiffEntry) bool) error {
+	return h.getActiveAccounts().GetStateDiff(fromRootHash, toRootHash, handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (h *historicalAccountsDB) IsInterfaceNil() bool {
+	return h == nil
+}
@@ -0,0 +1,184 @@
+package smartContract
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsHistoricalAccountsDB() ArgsHistoricalAccountsDB {
+	return ArgsHistoricalAccountsDB{
+		Accounts:         &mock.AccountsStub{},
+		Hasher:           &mock.HasherMock{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		StorageService:   &mock.ChainStorerMock{},
+		Uint64Converter:  mock.NewNonceHashConverterMock(),
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+	}
+}
+
+func createInMemoryAccountsDB() state.AccountsAdapter {
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	adb, _ := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+
+	return adb
+}
+
+func setBalance(t *testing.T, adb state.AccountsAdapter, address []byte, balance int64) []byte {
+	account, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	userAccount := account.(state.UserAccountHandler)
+	_ = userAccount.SubFromBalance(userAccount.GetBalance())
+	_ = userAccount.AddToBalance(big.NewInt(balance))
+	require.Nil(t, adb.SaveAccount(userAccount))
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func getBalance(t *testing.T, adb state.AccountsAdapter, address []byte) *big.Int {
+	account, err := adb.GetExistingAccount(address)
+	require.Nil(t, err)
+
+	return account.(state.UserAccountHandler).GetBalance()
+}
+
+func TestNewHistoricalAccountsDB_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalAccountsDB()
+	args.Accounts = nil
+	h, err := NewHistoricalAccountsDB(args)
+
+	assert.True(t, check.IfNil(h))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewHistoricalAccountsDB_NilStorageServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalAccountsDB()
+	args.StorageService = nil
+	h, err := NewHistoricalAccountsDB(args)
+
+	assert.True(t, check.IfNil(h))
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewHistoricalAccountsDB_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewHistoricalAccountsDB(createMockArgsHistoricalAccountsDB())
+
+	assert.False(t, check.IfNil(h))
+	assert.Nil(t, err)
+}
+
+func TestHistoricalAccountsDB_SetStateForRootHashShouldReadOldStateUntilReset(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	adb := createInMemoryAccountsDB()
+	oldRootHash := setBalance(t, adb, address, 10)
+	_ = setBalance(t, adb, address, 20)
+
+	args := createMockArgsHistoricalAccountsDB()
+	args.Accounts = adb
+	h, _ := NewHistoricalAccountsDB(args)
+	assert.Equal(t, big.NewInt(20), getBalance(t, h, address))
+
+	err := h.SetStateForRootHash(oldRootHash)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), getBalance(t, h, address))
+	assert.Equal(t, big.NewInt(20), getBalance(t, adb, address))
+
+	h.ResetState()
+	assert.Equal(t, big.NewInt(20), getBalance(t, h, address))
+}
+
+func TestHistoricalAccountsDB_SetStateForMissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalAccountsDB()
+	args.Accounts = createInMemoryAccountsDB()
+	h, _ := NewHistoricalAccountsDB(args)
+
+	err := h.SetStateForRootHash([]byte("missing root hash"))
+	assert.True(t, errors.Is(err, process.ErrStatePruned))
+}
+
+func TestHistoricalAccountsDB_SetStateForMissingBlockNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHistoricalAccountsDB()
+	args.StorageService = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					return nil, errors.New("key not found")
+				},
+			}
+		},
+	}
+	h, _ := NewHistoricalAccountsDB(args)
+
+	header, err := h.SetStateForBlockNonce(10)
+	assert.Nil(t, header)
+	assert.True(t, errors.Is(err, process.ErrMissingHeader))
+}
+
+func TestHistoricalAccountsDB_SetStateForBlockNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	adb := createInMemoryAccountsDB()
+	oldRootHash := setBalance(t, adb, address, 10)
+	_ = setBalance(t, adb, address, 20)
+
+	marshalizer := &mock.MarshalizerMock{}
+	headerHash := []byte("header hash")
+	oldHeader := &block.Header{Nonce: 10, RootHash: oldRootHash}
+	oldHeaderBytes, _ := marshalizer.Marshal(oldHeader)
+
+	args := createMockArgsHistoricalAccountsDB()
+	args.Accounts = adb
+	args.Marshalizer = marshalizer
+	args.StorageService = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if unitType == dataRetriever.BlockHeaderUnit {
+						return oldHeaderBytes, nil
+					}
+
+					return headerHash, nil
+				},
+			}
+		},
+	}
+	h, _ := NewHistoricalAccountsDB(args)
+
+	header, err := h.SetStateForBlockNonce(oldHeader.Nonce)
+	require.Nil(t, err)
+	assert.Equal(t, oldHeader.Nonce, header.GetNonce())
+	assert.Equal(t, big.NewInt(10), getBalance(t, h, address))
+}
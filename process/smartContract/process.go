@@ -372,11 +372,6 @@ func (sc *scProcessor) executeSmartContractCall(
 		return nil, err
 	}
 
-	ignorableError := sc.txLogsProcessor.SaveLog(txHash, tx, vmOutput.Logs)
-	if ignorableError != nil {
-		log.Debug("txLogsProcessor.SaveLog() error", "error", ignorableError.Error())
-	}
-
 	return vmOutput, nil
 }
 
@@ -403,10 +398,18 @@ func (sc *scProcessor) finishSCExecution(
 	totalConsumedFee, totalDevRwd := sc.computeTotalConsumedFeeAndDevRwd(tx, vmOutput, builtInFuncGasUsed)
 	sc.txFeeHandler.ProcessTransactionFee(totalConsumedFee, totalDevRwd, txHash)
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
+	sc.saveLogs(txHash, tx, vmOutput.Logs)
 
 	return vmcommon.Ok, nil
 }
 
+func (sc *scProcessor) saveLogs(txHash []byte, tx data.TransactionHandler, logs []*vmcommon.LogEntry) {
+	ignorableError := sc.txLogsProcessor.SaveLog(txHash, tx, logs)
+	if ignorableError != nil {
+		log.Debug("txLogsProcessor.SaveLog() error", "error", ignorableError.Error())
+	}
+}
+
 func (sc *scProcessor) updateDeveloperRewardsV2(
 	tx data.TransactionHandler,
 	vmOutput *vmcommon.VMOutput,
@@ -751,6 +754,10 @@ func (sc *scProcessor) ExecuteBuiltInFunction(
 		if len(newSCRTxs) > 0 {
 			scrResults = append(scrResults, newSCRTxs...)
 		}
+
+		mergedLogs := make([]*vmcommon.LogEntry, 0, len(vmOutput.Logs)+len(newVMOutput.Logs))
+		mergedLogs = append(mergedLogs, vmOutput.Logs...)
+		newVMOutput.Logs = append(mergedLogs, newVMOutput.Logs...)
 	}
 
 	scrForSender, scrForRelayer, err := sc.processSCRForSender(tx, txHash, vmInput, newVMOutput)
@@ -924,12 +931,43 @@ func (sc *scProcessor) isSCExecutionAfterBuiltInFunc(
 }
 
 func fillWithESDTValue(fullVMInput *vmcommon.ContractCallInput, newVMInput *vmcommon.ContractCallInput) {
-	if fullVMInput.Function != core.BuiltInFunctionESDTTransfer {
-		return
+	switch fullVMInput.Function {
+	case core.BuiltInFunctionESDTTransfer:
+		newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
+		newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[1])
+	case core.BuiltInFunctionESDTNFTTransfer:
+		newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
+		newVMInput.ESDTTokenNonce = big.NewInt(0).SetBytes(fullVMInput.Arguments[1]).Uint64()
+		newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[2])
+	case core.BuiltInFunctionESDTMultiTransfer:
+		newVMInput.ESDTTransfers = getESDTMultiTransfers(fullVMInput.Arguments)
+		if len(newVMInput.ESDTTransfers) == 1 {
+			newVMInput.ESDTTokenName = newVMInput.ESDTTransfers[0].ESDTTokenName
+			newVMInput.ESDTValue = newVMInput.ESDTTransfers[0].ESDTValue
+		}
 	}
+}
 
-	newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
-	newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[1])
+// getESDTMultiTransfers returns the transfers from the arguments of an ESDT multi transfer call, which were already
+// validated by the built-in function: number of transfers, followed by the token identifier and value of each transfer
+func getESDTMultiTransfers(arguments [][]byte) []*vmcommon.ESDTTransfer {
+	if len(arguments) == 0 {
+		return nil
+	}
+	numOfTransfers := big.NewInt(0).SetBytes(arguments[0])
+	if !numOfTransfers.IsUint64() || numOfTransfers.Uint64() > uint64(len(arguments)-1)/2 {
+		return nil
+	}
+
+	transfers := make([]*vmcommon.ESDTTransfer, 0, numOfTransfers.Uint64())
+	for i := uint64(0); i < numOfTransfers.Uint64(); i++ {
+		transfers = append(transfers, &vmcommon.ESDTTransfer{
+			ESDTTokenName: arguments[1+2*i],
+			ESDTValue:     big.NewInt(0).SetBytes(arguments[2+2*i]),
+		})
+	}
+
+	return transfers
 }
 
 func (sc *scProcessor) isCrossShardESDTTransfer(tx data.TransactionHandler) bool {
@@ -948,7 +986,12 @@ func (sc *scProcessor) isCrossShardESDTTransfer(tx data.TransactionHandler) bool
 		return false
 	}
 
-	return function == core.BuiltInFunctionESDTTransfer
+	switch function {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionESDTMultiTransfer:
+		return true
+	default:
+		return false
+	}
 }
 
 // ProcessIfError creates a smart contract result, consumed the gas and returns the value to the user
@@ -1193,6 +1236,7 @@ func (sc *scProcessor) DeploySmartContract(tx data.TransactionHandler, acntSnd s
 	sc.txFeeHandler.ProcessTransactionFee(totalConsumedFee, totalDevRwd, txHash)
 	sc.printScDeployed(vmOutput, tx)
 	sc.gasHandler.SetGasRefunded(vmOutput.GasRemaining, txHash)
+	sc.saveLogs(txHash, tx, vmOutput.Logs)
 
 	return 0, nil
 }
@@ -1783,7 +1827,7 @@ func (sc *scProcessor) processSCOutputAccounts(
 			log.Trace("storeUpdate", "acc", outAcc.Address, "key", storeUpdate.Offset, "data", storeUpdate.Data)
 		}
 
-		sc.updateSmartContractCode(acc, outAcc)
+		sc.updateSmartContractCode(vmOutput, acc, outAcc)
 		// change nonce only if there is a change
 		if outAcc.Nonce != acc.GetNonce() && outAcc.Nonce != 0 {
 			if outAcc.Nonce < acc.GetNonce() {
@@ -1828,8 +1872,9 @@ func (sc *scProcessor) processSCOutputAccounts(
 // It receives:
 // 	(1) the account as found in the State
 //	(2) the account as returned in VM Output
-// 	(3) the transaction that, upon execution, produced the VM Output
+//	(3) the VM Output, to which the deploy or upgrade log event is appended
 func (sc *scProcessor) updateSmartContractCode(
+	vmOutput *vmcommon.VMOutput,
 	stateAccount state.UserAccountHandler,
 	outputAccount *vmcommon.OutputAccount,
 ) {
@@ -1868,6 +1913,7 @@ func (sc *scProcessor) updateSmartContractCode(
 		stateAccount.SetCodeMetadata(outputAccount.CodeMetadata)
 		stateAccount.SetCode(outputAccount.Code)
 		log.Info("updateSmartContractCode(): created", "address", sc.pubkeyConv.Encode(outputAccount.Address), "upgradeable", newCodeMetadata.Upgradeable)
+		vmOutput.Logs = append(vmOutput.Logs, createCodeUpdateLogEntry(core.SCDeployIdentifier, outputAccount))
 		return
 	}
 
@@ -1875,10 +1921,19 @@ func (sc *scProcessor) updateSmartContractCode(
 		stateAccount.SetCodeMetadata(outputAccount.CodeMetadata)
 		stateAccount.SetCode(outputAccount.Code)
 		log.Info("updateSmartContractCode(): upgraded", "address", sc.pubkeyConv.Encode(outputAccount.Address), "upgradeable", newCodeMetadata.Upgradeable)
+		vmOutput.Logs = append(vmOutput.Logs, createCodeUpdateLogEntry(core.SCUpgradeIdentifier, outputAccount))
 		return
 	}
 }
 
+func createCodeUpdateLogEntry(identifier string, outputAccount *vmcommon.OutputAccount) *vmcommon.LogEntry {
+	return &vmcommon.LogEntry{
+		Identifier: []byte(identifier),
+		Address:    outputAccount.Address,
+		Topics:     [][]byte{outputAccount.Address, outputAccount.CodeDeployerAddress},
+	}
+}
+
 // delete accounts - only suicide by current SC or another SC called by current SC - protected by VM
 func (sc *scProcessor) deleteAccounts(deletedAccounts [][]byte) error {
 	for _, value := range deletedAccounts {
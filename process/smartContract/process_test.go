@@ -2166,6 +2166,40 @@ func TestScProcessor_checkUpgradePermission(t *testing.T) {
 	require.Equal(t, process.ErrUpgradeNotAllowed, err)
 }
 
+func TestScProcessor_updateSmartContractCodeShouldAddLogEntries(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scAddress := make([]byte, 32)
+	copy(scAddress[10:], "contract")
+	owner := []byte("alice")
+	contract, _ := state.NewUserAccount(scAddress)
+	outputAccount := &vmcommon.OutputAccount{
+		Address:             scAddress,
+		Code:                []byte("code"),
+		CodeMetadata:        []byte{1, 0},
+		CodeDeployerAddress: owner,
+	}
+
+	vmOutput := &vmcommon.VMOutput{}
+	sc.updateSmartContractCode(vmOutput, contract, outputAccount)
+	require.Equal(t, owner, contract.GetOwnerAddress())
+	require.Equal(t, 1, len(vmOutput.Logs))
+	require.Equal(t, []byte(core.SCDeployIdentifier), vmOutput.Logs[0].Identifier)
+	require.Equal(t, scAddress, vmOutput.Logs[0].Address)
+	require.Equal(t, [][]byte{scAddress, owner}, vmOutput.Logs[0].Topics)
+
+	outputAccount.Code = []byte("new code")
+	vmOutput = &vmcommon.VMOutput{}
+	sc.updateSmartContractCode(vmOutput, contract, outputAccount)
+	require.Equal(t, []byte("new code"), contract.GetCode())
+	require.Equal(t, 1, len(vmOutput.Logs))
+	require.Equal(t, []byte(core.SCUpgradeIdentifier), vmOutput.Logs[0].Identifier)
+	require.Equal(t, [][]byte{scAddress, owner}, vmOutput.Logs[0].Topics)
+}
+
 func TestScProcessor_penalizeUserIfNeededShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -2522,3 +2556,72 @@ func TestProcessIfErrorCheckBackwardsCompatibilityProcessTransactionFeeCalledSho
 	require.Nil(t, err)
 	require.False(t, called)
 }
+
+func TestFillWithESDTValue_ESDTTransfer(t *testing.T) {
+	t.Parallel()
+
+	fullVMInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			Arguments: [][]byte{[]byte("token"), big.NewInt(10).Bytes(), []byte("function")},
+		},
+		Function: core.BuiltInFunctionESDTTransfer,
+	}
+	newVMInput := &vmcommon.ContractCallInput{}
+
+	fillWithESDTValue(fullVMInput, newVMInput)
+	assert.Equal(t, []byte("token"), newVMInput.ESDTTokenName)
+	assert.Equal(t, big.NewInt(10), newVMInput.ESDTValue)
+	assert.Nil(t, newVMInput.ESDTTransfers)
+}
+
+func TestFillWithESDTValue_ESDTNFTTransfer(t *testing.T) {
+	t.Parallel()
+
+	fullVMInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			Arguments: [][]byte{[]byte("token"), big.NewInt(5).Bytes(), big.NewInt(2).Bytes(), []byte("marshaledData"), []byte("function")},
+		},
+		Function: core.BuiltInFunctionESDTNFTTransfer,
+	}
+	newVMInput := &vmcommon.ContractCallInput{}
+
+	fillWithESDTValue(fullVMInput, newVMInput)
+	assert.Equal(t, []byte("token"), newVMInput.ESDTTokenName)
+	assert.Equal(t, uint64(5), newVMInput.ESDTTokenNonce)
+	assert.Equal(t, big.NewInt(2), newVMInput.ESDTValue)
+	assert.Nil(t, newVMInput.ESDTTransfers)
+}
+
+func TestFillWithESDTValue_ESDTMultiTransfer(t *testing.T) {
+	t.Parallel()
+
+	fullVMInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			Arguments: [][]byte{
+				big.NewInt(2).Bytes(),
+				[]byte("token1"), big.NewInt(10).Bytes(),
+				[]byte("token2"), big.NewInt(20).Bytes(),
+				[]byte("function"),
+			},
+		},
+		Function: core.BuiltInFunctionESDTMultiTransfer,
+	}
+	newVMInput := &vmcommon.ContractCallInput{}
+
+	fillWithESDTValue(fullVMInput, newVMInput)
+	require.Equal(t, 2, len(newVMInput.ESDTTransfers))
+	assert.Equal(t, []byte("token1"), newVMInput.ESDTTransfers[0].ESDTTokenName)
+	assert.Equal(t, big.NewInt(10), newVMInput.ESDTTransfers[0].ESDTValue)
+	assert.Equal(t, []byte("token2"), newVMInput.ESDTTransfers[1].ESDTTokenName)
+	assert.Equal(t, big.NewInt(20), newVMInput.ESDTTransfers[1].ESDTValue)
+	assert.Nil(t, newVMInput.ESDTTokenName)
+	assert.Nil(t, newVMInput.ESDTValue)
+
+	fullVMInput.Arguments = [][]byte{big.NewInt(1).Bytes(), []byte("token1"), big.NewInt(10).Bytes(), []byte("function")}
+	newVMInput = &vmcommon.ContractCallInput{}
+
+	fillWithESDTValue(fullVMInput, newVMInput)
+	require.Equal(t, 1, len(newVMInput.ESDTTransfers))
+	assert.Equal(t, []byte("token1"), newVMInput.ESDTTokenName)
+	assert.Equal(t, big.NewInt(10), newVMInput.ESDTValue)
+}
@@ -19,12 +19,13 @@ var _ process.SCQueryService = (*SCQueryService)(nil)
 
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
-	vmContainer    process.VirtualMachinesContainer
-	economicsFee   process.FeeHandler
-	mutRunSc       sync.Mutex
-	blockChainHook process.BlockChainHookHandler
-	blockChain     data.ChainHandler
-	numQueries     int
+	vmContainer     process.VirtualMachinesContainer
+	economicsFee    process.FeeHandler
+	mutRunSc        sync.Mutex
+	blockChainHook  process.BlockChainHookHandler
+	blockChain      data.ChainHandler
+	historicalState process.HistoricalStateHandler
+	numQueries      int
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -33,6 +34,7 @@ func NewSCQueryService(
 	economicsFee process.FeeHandler,
 	blockChainHook process.BlockChainHookHandler,
 	blockChain data.ChainHandler,
+	historicalState process.HistoricalStateHandler,
 ) (*SCQueryService, error) {
 	if check.IfNil(vmContainer) {
 		return nil, process.ErrNoVM
@@ -46,12 +48,16 @@ func NewSCQueryService(
 	if check.IfNil(blockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(historicalState) {
+		return nil, process.ErrNilHistoricalStateHandler
+	}
 
 	return &SCQueryService{
-		vmContainer:    vmContainer,
-		economicsFee:   economicsFee,
-		blockChain:     blockChain,
-		blockChainHook: blockChainHook,
+		vmContainer:     vmContainer,
+		economicsFee:    economicsFee,
+		blockChain:      blockChain,
+		blockChainHook:  blockChainHook,
+		historicalState: historicalState,
 	}, nil
 }
 
@@ -63,6 +69,9 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	if len(query.FuncName) == 0 {
 		return nil, process.ErrEmptyFunctionName
 	}
+	if query.HasBlockNonce && len(query.RootHash) > 0 {
+		return nil, process.ErrBlockNonceAndRootHashProvided
+	}
 
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
@@ -74,7 +83,13 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	log.Debug("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	header, err := service.prepareState(query)
+	if err != nil {
+		return nil, err
+	}
+	defer service.historicalState.ResetState()
+
+	service.blockChainHook.SetCurrentHeader(header)
 
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
@@ -105,6 +120,22 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	return vmOutput, nil
 }
 
+// prepareState switches the state used by the VM to the one requested by the query and returns the header
+// that should be exposed to the VM
+func (service *SCQueryService) prepareState(query *process.SCQuery) (data.HeaderHandler, error) {
+	currentHeader := service.blockChain.GetCurrentBlockHeader()
+	if !query.IsHistorical() {
+		return currentHeader, nil
+	}
+
+	if !query.HasBlockNonce {
+		err := service.historicalState.SetStateForRootHash(query.RootHash)
+		return currentHeader, err
+	}
+
+	return service.historicalState.SetStateForBlockNonce(query.BlockNonce)
+}
+
 func prepareScQuery(query *process.SCQuery) *process.SCQuery {
 	if query.CallerAddr == nil {
 		query.CallerAddr = query.ScAddress
@@ -2,6 +2,7 @@ package smartContract
 
 import (
 	"bytes"
+	"errors"
 	"math"
 	"math/big"
 	"sync"
@@ -10,6 +11,8 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -22,7 +25,7 @@ const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb9908
 func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(nil, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalStateHandlerStub{})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNoVM, err)
@@ -31,16 +34,25 @@ func TestNewSCQueryService_NilVmShouldErr(t *testing.T) {
 func TestNewSCQueryService_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, nil, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalStateHandlerStub{})
 
 	assert.Nil(t, target)
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
 }
 
+func TestNewSCQueryService_NilHistoricalStateHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, nil)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilHistoricalStateHandler, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, err := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalStateHandlerStub{})
 
 	assert.NotNil(t, target)
 	assert.Nil(t, err)
@@ -50,7 +62,7 @@ func TestNewSCQueryService_ShouldWork(t *testing.T) {
 func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalStateHandlerStub{})
 
 	query := process.SCQuery{
 		ScAddress: nil,
@@ -67,7 +79,7 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{}, &mock.HistoricalStateHandlerStub{})
 
 	query := process.SCQuery{
 		ScAddress: []byte{0},
@@ -116,6 +128,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalStateHandlerStub{},
 	)
 
 	dataArgs := make([][]byte, len(args))
@@ -159,6 +172,7 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalStateHandlerStub{},
 	)
 
 	query := process.SCQuery{
@@ -174,6 +188,110 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 	assert.Equal(t, d[1], vmOutput.ReturnData[1])
 }
 
+func createSCQueryServiceForHistoricalQueries(
+	blockChainHook process.BlockChainHookHandler,
+	historicalState process.HistoricalStateHandler,
+) *SCQueryService {
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{},
+		blockChainHook,
+		&mock.BlockChainMock{},
+		historicalState,
+	)
+
+	return target
+}
+
+func TestExecuteQuery_BlockNonceAndRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target := createSCQueryServiceForHistoricalQueries(&mock.BlockChainHookHandlerMock{}, &mock.HistoricalStateHandlerStub{})
+
+	query := process.SCQuery{
+		ScAddress:     []byte(DummyScAddress),
+		FuncName:      "function",
+		BlockNonce:    10,
+		HasBlockNonce: true,
+		RootHash:      []byte("root hash"),
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrBlockNonceAndRootHashProvided, err)
+}
+
+func TestExecuteQuery_AtBlockNonceShouldUseHistoricalStateAndReset(t *testing.T) {
+	t.Parallel()
+
+	historicalHeader := &block.Header{Nonce: 10}
+	var setHeader data.HeaderHandler
+	resetWasCalled := false
+	blockChainHook := &mock.BlockChainHookHandlerMock{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			setHeader = hdr
+		},
+	}
+	historicalState := &mock.HistoricalStateHandlerStub{
+		SetStateForBlockNonceCalled: func(nonce uint64) (data.HeaderHandler, error) {
+			assert.Equal(t, historicalHeader.Nonce, nonce)
+			return historicalHeader, nil
+		},
+		ResetStateCalled: func() {
+			resetWasCalled = true
+		},
+	}
+	target := createSCQueryServiceForHistoricalQueries(blockChainHook, historicalState)
+
+	query := process.SCQuery{
+		ScAddress:     []byte(DummyScAddress),
+		FuncName:      "function",
+		BlockNonce:    historicalHeader.Nonce,
+		HasBlockNonce: true,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, historicalHeader, setHeader)
+	assert.True(t, resetWasCalled)
+}
+
+func TestExecuteQuery_AtPrunedRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	historicalState := &mock.HistoricalStateHandlerStub{
+		SetStateForRootHashCalled: func(rootHash []byte) error {
+			return process.ErrStatePruned
+		},
+	}
+	target := createSCQueryServiceForHistoricalQueries(&mock.BlockChainHookHandlerMock{}, historicalState)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		RootHash:  []byte("root hash"),
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.True(t, errors.Is(err, process.ErrStatePruned))
+}
+
 func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -198,6 +316,7 @@ func TestExecuteQuery_WhenNotOkCodeShouldErr(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalStateHandlerStub{},
 	)
 
 	query := process.SCQuery{
@@ -248,6 +367,7 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalStateHandlerStub{},
 	)
 
 	noOfGoRoutines := 50
@@ -298,6 +418,7 @@ func TestSCQueryService_ExecuteQueryShouldNotIncludeCallerAddressAndValue(t *tes
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalStateHandlerStub{},
 	)
 
 	query := process.SCQuery{
@@ -342,6 +463,7 @@ func TestSCQueryService_ExecuteQueryShouldIncludeCallerAddressAndValue(t *testin
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalStateHandlerStub{},
 	)
 
 	query := process.SCQuery{
@@ -383,6 +505,7 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 		},
 		&mock.BlockChainHookHandlerMock{},
 		&mock.BlockChainMock{},
+		&mock.HistoricalStateHandlerStub{},
 	)
 
 	tx := &transaction.Transaction{
@@ -27,6 +27,7 @@ type p2pBlackListProcessor struct {
 	banDuration                time.Duration
 	selfPid                    core.PeerID
 	name                       string
+	trustedPeers               p2p.TrustedPeersChecker
 }
 
 // NewP2PBlackListProcessor creates a new instance of p2pQuotaBlacklistProcessor able to determine
@@ -41,6 +42,7 @@ func NewP2PBlackListProcessor(
 	banDuration time.Duration,
 	name string,
 	selfPid core.PeerID,
+	trustedPeers p2p.TrustedPeersChecker,
 ) (*p2pBlackListProcessor, error) {
 
 	if check.IfNil(cacher) {
@@ -61,6 +63,9 @@ func NewP2PBlackListProcessor(
 	if banDuration < minBanDuration {
 		return nil, fmt.Errorf("%w for ban duration in NewP2PBlackListProcessor", process.ErrInvalidValue)
 	}
+	if check.IfNil(trustedPeers) {
+		return nil, fmt.Errorf("%w, NewP2PBlackListProcessor", p2p.ErrNilTrustedPeersChecker)
+	}
 
 	return &p2pBlackListProcessor{
 		cacher:                     cacher,
@@ -71,6 +76,7 @@ func NewP2PBlackListProcessor(
 		banDuration:                banDuration,
 		selfPid:                    selfPid,
 		name:                       name,
+		trustedPeers:               trustedPeers,
 	}, nil
 }
 
@@ -128,6 +134,15 @@ func (pbp *p2pBlackListProcessor) AddQuota(pid core.PeerID, numReceived uint32,
 		)
 		return
 	}
+	if pbp.trustedPeers.IsTrusted(pid) {
+		log.Trace("trusted peer will not be blacklisted",
+			"name", pbp.name,
+			"pid", pid.Pretty(),
+			"total num messages", numReceived,
+			"total size", sizeReceived,
+		)
+		return
+	}
 
 	pbp.incrementStatsFloodingPeer(pid)
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
@@ -30,6 +31,7 @@ func TestNewP2PQuotaBlacklistProcessor_NilCacherShouldErr(t *testing.T) {
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	assert.True(t, check.IfNil(pbp))
@@ -48,6 +50,7 @@ func TestNewP2PQuotaBlacklistProcessor_NilBlackListHandlerShouldErr(t *testing.T
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	assert.True(t, check.IfNil(pbp))
@@ -66,6 +69,7 @@ func TestNewP2PQuotaBlacklistProcessor_InvalidThresholdNumReceivedFloodShouldErr
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	assert.True(t, check.IfNil(pbp))
@@ -84,6 +88,7 @@ func TestNewP2PQuotaBlacklistProcessor_InvalidThresholdSizeReceivedFloodShouldEr
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	assert.True(t, check.IfNil(pbp))
@@ -102,6 +107,7 @@ func TestNewP2PQuotaBlacklistProcessor_InvalidNumFloodingRoundsShouldErr(t *test
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	assert.True(t, check.IfNil(pbp))
@@ -120,12 +126,32 @@ func TestNewP2PQuotaBlacklistProcessor_InvalidBanDurationShouldErr(t *testing.T)
 		time.Millisecond,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	assert.True(t, check.IfNil(pbp))
 	assert.True(t, errors.Is(err, process.ErrInvalidValue))
 }
 
+func TestNewP2PQuotaBlacklistProcessor_NilTrustedPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pbp, err := blackList.NewP2PBlackListProcessor(
+		testscommon.NewCacherStub(),
+		&mock.PeerBlackListHandlerStub{},
+		1,
+		1,
+		2,
+		time.Second,
+		"",
+		selfPid,
+		nil,
+	)
+
+	assert.True(t, check.IfNil(pbp))
+	assert.True(t, errors.Is(err, p2p.ErrNilTrustedPeersChecker))
+}
+
 func TestNewP2PQuotaBlacklistProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -138,6 +164,7 @@ func TestNewP2PQuotaBlacklistProcessor_ShouldWork(t *testing.T) {
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	assert.False(t, check.IfNil(pbp))
@@ -170,6 +197,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaUnderThresholdShouldNotCallGetOrPut(
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.AddQuota("identifier", thresholdNum-1, thresholdSize-1, 1, 1)
@@ -203,6 +231,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaOverThresholdInexistentDataOnGetShou
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.AddQuota(identifier, thresholdNum, thresholdSize, 1, 1)
@@ -238,6 +267,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaOverThresholdDataNotValidOnGetShould
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.AddQuota(identifier, thresholdNum, thresholdSize, 1, 1)
@@ -274,6 +304,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaShouldIncrement(t *testing.T) {
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.AddQuota(identifier, thresholdNum, thresholdSize, 1, 1)
@@ -306,6 +337,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaForSelfShouldNotIncrement(t *testing
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.AddQuota(selfPid, thresholdNum, thresholdSize, 1, 1)
@@ -315,6 +347,43 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaForSelfShouldNotIncrement(t *testing
 
 //------- ResetStatistics
 
+func TestP2PQuotaBlacklistProcessor_AddQuotaForTrustedPeerShouldNotIncrement(t *testing.T) {
+	t.Parallel()
+
+	thresholdNum := uint32(10)
+	thresholdSize := uint64(20)
+	trustedPid := core.PeerID("trusted pid")
+
+	putCalled := false
+	pbp, _ := blackList.NewP2PBlackListProcessor(
+		&testscommon.CacherStub{
+			GetCalled: func(key []byte) (interface{}, bool) {
+				return uint32(445), true
+			},
+			PutCalled: func(key []byte, value interface{}, sizeInBytes int) (evicted bool) {
+				putCalled = true
+				return false
+			},
+		},
+		&mock.PeerBlackListHandlerStub{},
+		thresholdNum,
+		thresholdSize,
+		2,
+		time.Second,
+		"",
+		selfPid,
+		&mock.TrustedPeersCheckerStub{
+			IsTrustedCalled: func(pid core.PeerID) bool {
+				return pid == trustedPid
+			},
+		},
+	)
+
+	pbp.AddQuota(trustedPid, thresholdNum, thresholdSize, 1, 1)
+
+	assert.False(t, putCalled)
+}
+
 func TestP2PQuotaBlacklistProcessor_ResetStatisticsRemoveNilValueKey(t *testing.T) {
 	t.Parallel()
 
@@ -344,6 +413,7 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsRemoveNilValueKey(t *testing.
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.ResetStatistics()
@@ -380,6 +450,7 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsShouldRemoveInvalidValueKey(t
 		time.Second,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.ResetStatistics()
@@ -424,6 +495,7 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsUnderNumFloodingRoundsShouldN
 		duration,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.ResetStatistics()
@@ -469,6 +541,7 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsOverNumFloodingRoundsShouldBl
 		duration,
 		"",
 		selfPid,
+		&mock.TrustedPeersCheckerStub{},
 	)
 
 	pbp.ResetStatistics()
@@ -34,12 +34,16 @@ func NewP2PAntiFloodAndBlackList(
 	config config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
+	trustedPeers p2p.TrustedPeersChecker,
 ) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
 	if check.IfNil(statusHandler) {
 		return nil, nil, nil, p2p.ErrNilStatusHandler
 	}
+	if check.IfNil(trustedPeers) {
+		return nil, nil, nil, p2p.ErrNilTrustedPeersChecker
+	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodAndBlackList(config, statusHandler, currentPid)
+		return initP2PAntiFloodAndBlackList(config, statusHandler, currentPid, trustedPeers)
 	}
 
 	return &disabled.AntiFlood{}, &disabled.PeerBlacklistCacher{}, &disabled.TimeCache{}, nil
@@ -49,6 +53,7 @@ func initP2PAntiFloodAndBlackList(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
+	trustedPeers p2p.TrustedPeersChecker,
 ) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
 	cache := timecache.NewTimeCache(defaultSpan)
 	p2pPeerBlackList, err := timecache.NewPeerTimeCache(cache)
@@ -65,6 +70,7 @@ func initP2PAntiFloodAndBlackList(
 		fastReactingIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		trustedPeers,
 	)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
@@ -77,6 +83,7 @@ func initP2PAntiFloodAndBlackList(
 		slowReactingIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		trustedPeers,
 	)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
@@ -89,6 +96,7 @@ func initP2PAntiFloodAndBlackList(
 		outOfSpecsIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		trustedPeers,
 	)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("%w when creating out of specs flood preventer", err)
@@ -164,6 +172,7 @@ func createFloodPreventer(
 	quotaIdentifier string,
 	blackListHandler process.PeerBlackListCacher,
 	selfPid core.PeerID,
+	trustedPeers p2p.TrustedPeersChecker,
 ) (process.FloodPreventer, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
 	blackListCache, err := storageUnit.NewCache(cacheConfig)
@@ -180,6 +189,7 @@ func createFloodPreventer(
 		time.Duration(floodPreventerConfig.BlackList.PeerBanDurationInSeconds)*time.Second,
 		quotaIdentifier,
 		selfPid,
+		trustedPeers,
 	)
 	if err != nil {
 		return nil, err
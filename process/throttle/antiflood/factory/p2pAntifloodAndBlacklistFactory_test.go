@@ -17,13 +17,24 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	cfg := config.Config{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, nil, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, nil, currentPid, &mock.TrustedPeersCheckerStub{})
 	assert.Nil(t, af)
 	assert.Nil(t, pids)
 	assert.Nil(t, pks)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
+func TestNewP2PAntiFloodAndBlackList_NilTrustedPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, &mock.AppStatusHandlerMock{}, currentPid, nil)
+	assert.Nil(t, af)
+	assert.Nil(t, pids)
+	assert.Nil(t, pks)
+	assert.Equal(t, p2p.ErrNilTrustedPeersChecker, err)
+}
+
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
 	t.Parallel()
 
@@ -33,7 +44,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 		},
 	}
 	ash := &mock.AppStatusHandlerMock{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, &mock.TrustedPeersCheckerStub{})
 	assert.NotNil(t, af)
 	assert.NotNil(t, pids)
 	assert.NotNil(t, pks)
@@ -68,7 +79,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 	}
 
 	ash := &mock.AppStatusHandlerMock{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, &mock.TrustedPeersCheckerStub{})
 	assert.Nil(t, err)
 	assert.NotNil(t, af)
 	assert.NotNil(t, pids)
@@ -41,6 +41,7 @@ type InterceptedTransaction struct {
 	sndShard               uint32
 	isForCurrentShard      bool
 	enableSignedTxWithHash bool
+	enableGuardedTx        bool
 }
 
 // NewInterceptedTransaction returns a new instance of InterceptedTransaction
@@ -58,6 +59,7 @@ func NewInterceptedTransaction(
 	argsParser process.ArgumentsParser,
 	chainID []byte,
 	enableSignedTxWithHash bool,
+	enableGuardedTx bool,
 	txSignHasher hashing.Hasher,
 	txVersionChecker process.TxVersionCheckerHandler,
 ) (*InterceptedTransaction, error) {
@@ -124,6 +126,7 @@ func NewInterceptedTransaction(
 		argsParser:             argsParser,
 		chainID:                chainID,
 		enableSignedTxWithHash: enableSignedTxWithHash,
+		enableGuardedTx:        enableGuardedTx,
 		txVersionChecker:       txVersionChecker,
 		txSignHasher:           txSignHasher,
 	}
@@ -267,33 +270,90 @@ func (inTx *InterceptedTransaction) integrity(tx *transaction.Transaction) error
 	if len(inTx.tx.SndUserName) > core.MaxUserNameLength {
 		return process.ErrInvalidUserNameLength
 	}
+	err = inTx.checkGuardianFields(tx)
+	if err != nil {
+		return err
+	}
 
 	return inTx.feeHandler.CheckValidityTxValues(tx)
 }
 
-// verifySig checks if the tx is correctly signed
+func (inTx *InterceptedTransaction) checkGuardianFields(tx *transaction.Transaction) error {
+	isGuardedTx := inTx.txVersionChecker.IsGuardedTransaction(tx)
+	hasGuardianFields := len(tx.GuardianAddr) > 0 || len(tx.GuardianSignature) > 0
+	if !inTx.enableGuardedTx && (isGuardedTx || hasGuardianFields) {
+		return process.ErrGuardedAccountsNotEnabled
+	}
+
+	if !isGuardedTx {
+		if hasGuardianFields {
+			return process.ErrGuardianFieldsOnUnguardedTx
+		}
+
+		return nil
+	}
+
+	if len(tx.GuardianAddr) == 0 {
+		return process.ErrNilGuardianAddress
+	}
+	if len(tx.GuardianSignature) == 0 {
+		return process.ErrNilGuardianSignature
+	}
+	if bytes.Equal(tx.GuardianAddr, tx.SndAddr) {
+		return process.ErrGuardianSameAsSender
+	}
+
+	return nil
+}
+
+// verifySig checks if the tx is correctly signed and, for guarded transactions, correctly co-signed by the guardian
 func (inTx *InterceptedTransaction) verifySig(tx *transaction.Transaction) error {
-	buffCopiedTx, err := tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
+	message, err := inTx.getMessageToSign(tx)
 	if err != nil {
 		return err
 	}
 
-	senderPubKey, err := inTx.keyGen.PublicKeyFromByteArray(tx.SndAddr)
+	err = inTx.verifySignature(tx.SndAddr, message, tx.Signature)
 	if err != nil {
 		return err
 	}
 
+	if !inTx.txVersionChecker.IsGuardedTransaction(tx) {
+		return nil
+	}
+
+	err = inTx.verifySignature(tx.GuardianAddr, message, tx.GuardianSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", process.ErrInvalidGuardianSignature, err.Error())
+	}
+
+	return nil
+}
+
+func (inTx *InterceptedTransaction) getMessageToSign(tx *transaction.Transaction) ([]byte, error) {
+	buffCopiedTx, err := tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
 	if !inTx.txVersionChecker.IsSignedWithHash(tx) {
-		return inTx.singleSigner.Verify(senderPubKey, buffCopiedTx, tx.Signature)
+		return buffCopiedTx, nil
 	}
 
 	if !inTx.enableSignedTxWithHash {
-		return process.ErrTransactionSignedWithHashIsNotEnabled
+		return nil, process.ErrTransactionSignedWithHashIsNotEnabled
 	}
 
-	txHash := inTx.txSignHasher.Compute(string(buffCopiedTx))
+	return inTx.txSignHasher.Compute(string(buffCopiedTx)), nil
+}
+
+func (inTx *InterceptedTransaction) verifySignature(pkBytes []byte, message []byte, signature []byte) error {
+	pubKey, err := inTx.keyGen.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return err
+	}
 
-	return inTx.singleSigner.Verify(senderPubKey, txHash, tx.Signature)
+	return inTx.singleSigner.Verify(pubKey, message, signature)
 }
 
 // ReceiverShardId returns the receiver shard id
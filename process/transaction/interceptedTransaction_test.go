@@ -101,6 +101,7 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		&mock.ArgumentParserMock{},
 		chainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
 	)
@@ -140,6 +141,7 @@ func createInterceptedTxFromPlainTxWithArgParser(tx *dataTransaction.Transaction
 		smartContract.NewArgumentParser(),
 		tx.ChainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(tx.Version),
 	)
@@ -164,6 +166,7 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -189,6 +192,7 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -214,6 +218,7 @@ func TestNewInterceptedTransaction_NilSignMarshalizerShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -239,6 +244,7 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -264,6 +270,7 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -289,6 +296,7 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -314,6 +322,7 @@ func TestNewInterceptedTransaction_NilPubkeyConverterShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -339,6 +348,7 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -364,6 +374,7 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -389,6 +400,7 @@ func TestNewInterceptedTransaction_NilWhiteListerVerifiedTxsShouldErr(t *testing
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -414,6 +426,7 @@ func TestNewInterceptedTransaction_InvalidChainIDShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		nil,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -439,6 +452,7 @@ func TestNewInterceptedTransaction_NilTxSignHasherShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		nil,
 		versioning.NewTxVersionChecker(1),
 	)
@@ -470,6 +484,7 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		&mock.ArgumentParserMock{},
 		[]byte("chainID"),
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
 	)
@@ -840,6 +855,145 @@ func TestInterceptedTransaction_CheckValidityOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func createGuardedTransaction(chainID []byte, minTxVersion uint32) *dataTransaction.Transaction {
+	return &dataTransaction.Transaction{
+		Nonce:             1,
+		Value:             big.NewInt(2),
+		Data:              []byte("data"),
+		GasLimit:          3,
+		GasPrice:          4,
+		RcvAddr:           recvAddress,
+		SndAddr:           senderAddress,
+		Signature:         sigOk,
+		ChainID:           chainID,
+		Version:           minTxVersion + 1,
+		Options:           versioning.MaskGuardedTransaction,
+		GuardianAddr:      []byte("guardian"),
+		GuardianSignature: sigOk,
+	}
+}
+
+func TestInterceptedTransaction_CheckValidityGuardianFieldsOnUnguardedTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createGuardedTransaction(chainID, minTxVersion)
+	tx.Options = 0
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+
+	err := txi.CheckValidity()
+
+	assert.Equal(t, process.ErrGuardianFieldsOnUnguardedTx, err)
+}
+
+func TestInterceptedTransaction_CheckValidityGuardedTxWithMissingFieldsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createGuardedTransaction(chainID, minTxVersion)
+	tx.GuardianAddr = nil
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrNilGuardianAddress, err)
+
+	tx = createGuardedTransaction(chainID, minTxVersion)
+	tx.GuardianSignature = nil
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrNilGuardianSignature, err)
+
+	tx = createGuardedTransaction(chainID, minTxVersion)
+	tx.GuardianAddr = tx.SndAddr
+	txi, _ = createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrGuardianSameAsSender, err)
+}
+
+func TestInterceptedTransaction_CheckValidityGuardedTxWrongGuardianSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createGuardedTransaction(chainID, minTxVersion)
+	tx.GuardianSignature = sigBad
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+
+	err := txi.CheckValidity()
+
+	assert.True(t, errors.Is(err, process.ErrInvalidGuardianSignature))
+}
+
+func TestInterceptedTransaction_CheckValidityGuardedTxShouldWork(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := createGuardedTransaction(chainID, minTxVersion)
+	txi, _ := createInterceptedTxFromPlainTx(tx, createFreeTxFeeHandler(), chainID, minTxVersion)
+
+	err := txi.CheckValidity()
+
+	assert.Nil(t, err)
+}
+
+func TestInterceptedTransaction_CheckValidityGuardedTxButNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	marshalizer := &mock.MarshalizerMock{}
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.CurrentShard = 6
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, senderAddress) {
+			return senderShard
+		}
+		if bytes.Equal(address, recvAddress) {
+			return recvShard
+		}
+
+		return shardCoordinator.CurrentShard
+	}
+	createInterceptedTx := func(tx *dataTransaction.Transaction) *transaction.InterceptedTransaction {
+		txBuff, _ := marshalizer.Marshal(tx)
+		txi, _ := transaction.NewInterceptedTransaction(
+			txBuff,
+			marshalizer,
+			marshalizer,
+			mock.HasherMock{},
+			createKeyGenMock(),
+			createDummySigner(),
+			&mock.PubkeyConverterStub{},
+			shardCoordinator,
+			createFreeTxFeeHandler(),
+			&mock.WhiteListHandlerStub{},
+			&mock.ArgumentParserMock{},
+			chainID,
+			false,
+			false,
+			mock.HasherMock{},
+			versioning.NewTxVersionChecker(minTxVersion),
+		)
+
+		return txi
+	}
+
+	tx := createGuardedTransaction(chainID, minTxVersion)
+	err := createInterceptedTx(tx).CheckValidity()
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, err)
+
+	tx.Options = 0
+	err = createInterceptedTx(tx).CheckValidity()
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, err)
+
+	tx.GuardianAddr = nil
+	tx.GuardianSignature = nil
+	err = createInterceptedTx(tx).CheckValidity()
+	assert.Nil(t, err)
+}
+
 func TestInterceptedTransaction_CheckValiditySignedWithHashButNotEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -888,6 +1042,7 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashButNotEnabled(t *test
 		&mock.ArgumentParserMock{},
 		chainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
 	)
@@ -944,6 +1099,7 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashShoudWork(t *testing.
 		&mock.ArgumentParserMock{},
 		chainID,
 		true,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
 	)
@@ -1029,6 +1185,7 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		&mock.ArgumentParserMock{},
 		chainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
 	)
@@ -1164,6 +1321,7 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 		&mock.ArgumentParserMock{},
 		chainID,
 		false,
+		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
 	)
@@ -38,11 +38,15 @@ type txProcessor struct {
 	argsParser                     process.ArgumentsParser
 	scrForwarder                   process.IntermediateTransactionHandler
 	signMarshalizer                marshal.Marshalizer
+	guardedAccounts                process.GuardedAccountHandler
+	txVersionChecker               process.TxVersionCheckerHandler
 	flagRelayedTx                  atomic.Flag
 	flagMetaProtection             atomic.Flag
+	flagGuardian                   atomic.Flag
 	relayedTxEnableEpoch           uint32
 	penalizedTooMuchGasEnableEpoch uint32
 	metaProtectionEnableEpoch      uint32
+	guardianEnableEpoch            uint32
 }
 
 // ArgsNewTxProcessor defines the arguments needed for new tx processor
@@ -64,7 +68,10 @@ type ArgsNewTxProcessor struct {
 	RelayedTxEnableEpoch           uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	MetaProtectionEnableEpoch      uint32
+	GuardianEnableEpoch            uint32
 	EpochNotifier                  process.EpochNotifier
+	GuardedAccounts                process.GuardedAccountHandler
+	TxVersionChecker               process.TxVersionCheckerHandler
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -114,6 +121,12 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 	if check.IfNil(args.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(args.GuardedAccounts) {
+		return nil, process.ErrNilGuardedAccountHandler
+	}
+	if check.IfNil(args.TxVersionChecker) {
+		return nil, process.ErrNilTransactionVersionChecker
+	}
 
 	baseTxProcess := &baseTxProcessor{
 		accounts:         args.Accounts,
@@ -134,9 +147,12 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 		argsParser:                     args.ArgsParser,
 		scrForwarder:                   args.ScrForwarder,
 		signMarshalizer:                args.SignMarshalizer,
+		guardedAccounts:                args.GuardedAccounts,
+		txVersionChecker:               args.TxVersionChecker,
 		relayedTxEnableEpoch:           args.RelayedTxEnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 		metaProtectionEnableEpoch:      args.MetaProtectionEnableEpoch,
+		guardianEnableEpoch:            args.GuardianEnableEpoch,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(txProc)
@@ -202,6 +218,83 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) (vmco
 	return vmcommon.UserError, txProc.executingFailedTransaction(tx, acntSnd, process.ErrWrongTransaction)
 }
 
+// checkTxValues extends the base checks with the guardian verification of the sender account
+func (txProc *txProcessor) checkTxValues(
+	tx *transaction.Transaction,
+	acntSnd, acntDst state.UserAccountHandler,
+	isUserTxOfRelayed bool,
+) error {
+	err := txProc.baseTxProcessor.checkTxValues(tx, acntSnd, acntDst, isUserTxOfRelayed)
+	if err != nil {
+		return err
+	}
+
+	return txProc.checkGuardedAccount(tx, acntSnd)
+}
+
+// checkGuardedAccount requires transactions sent from a guarded account to be co-signed by its active guardian.
+// The only exception is the guardian removal call of the owner, which becomes active after the cooldown period.
+// Setting a new guardian on a guarded account always requires the co-signature of the current guardian
+func (txProc *txProcessor) checkGuardedAccount(tx *transaction.Transaction, acntSnd state.UserAccountHandler) error {
+	if check.IfNil(acntSnd) {
+		return nil
+	}
+
+	isGuardedTx := txProc.txVersionChecker.IsGuardedTransaction(tx)
+	if !txProc.flagGuardian.IsSet() {
+		if isGuardedTx {
+			return process.ErrGuardedAccountsNotEnabled
+		}
+		return nil
+	}
+
+	activeGuardian, err := txProc.getActiveGuardian(acntSnd)
+	if err != nil {
+		return err
+	}
+
+	if len(activeGuardian) == 0 {
+		if isGuardedTx {
+			return process.ErrAccountNotGuarded
+		}
+		return nil
+	}
+
+	if !isGuardedTx {
+		if txProc.isRemoveGuardianCall(tx) {
+			return nil
+		}
+		return process.ErrGuardedTransactionRequired
+	}
+	if !bytes.Equal(activeGuardian, tx.GuardianAddr) {
+		return process.ErrGuardianMismatch
+	}
+
+	return nil
+}
+
+func (txProc *txProcessor) getActiveGuardian(acntSnd state.UserAccountHandler) ([]byte, error) {
+	// the guardians are kept in the account data trie, an account without one can not be guarded
+	if len(acntSnd.GetRootHash()) == 0 {
+		return nil, nil
+	}
+
+	return txProc.guardedAccounts.GetActiveGuardian(acntSnd)
+}
+
+func (txProc *txProcessor) isRemoveGuardianCall(tx *transaction.Transaction) bool {
+	if !bytes.Equal(tx.SndAddr, tx.RcvAddr) {
+		return false
+	}
+
+	function, _, err := txProc.argsParser.ParseCallData(string(tx.Data))
+	if err != nil {
+		return false
+	}
+
+	return function == core.BuiltInFunctionRemoveGuardian
+}
+
 func (txProc *txProcessor) executeAfterFailedMoveBalanceTransaction(
 	tx *transaction.Transaction,
 	txError error,
@@ -861,6 +954,9 @@ func (txProc *txProcessor) EpochConfirmed(epoch uint32) {
 
 	txProc.flagMetaProtection.Toggle(epoch >= txProc.metaProtectionEnableEpoch)
 	log.Debug("txProcessor: meta protection", "enabled", txProc.flagMetaProtection.IsSet())
+
+	txProc.flagGuardian.Toggle(epoch >= txProc.guardianEnableEpoch)
+	log.Debug("txProcessor: guarded accounts", "enabled", txProc.flagGuardian.IsSet())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
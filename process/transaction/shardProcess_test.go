@@ -11,6 +11,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
@@ -83,6 +84,8 @@ func createArgsForTxProcessor() txproc.ArgsNewTxProcessor {
 		ArgsParser:       &mock.ArgumentParserMock{},
 		ScrForwarder:     &mock.IntermediateTransactionHandlerMock{},
 		EpochNotifier:    &mock.EpochNotifierStub{},
+		GuardedAccounts:  &mock.GuardedAccountHandlerStub{},
+		TxVersionChecker: versioning.NewTxVersionChecker(1),
 	}
 	return args
 }
@@ -259,6 +262,28 @@ func TestNewTxProcessor_NilEpochNotifierShouldErr(t *testing.T) {
 	assert.Nil(t, txProc)
 }
 
+func TestNewTxProcessor_NilGuardedAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForTxProcessor()
+	args.GuardedAccounts = nil
+	txProc, err := txproc.NewTxProcessor(args)
+
+	assert.Equal(t, process.ErrNilGuardedAccountHandler, err)
+	assert.Nil(t, txProc)
+}
+
+func TestNewTxProcessor_NilTxVersionCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForTxProcessor()
+	args.TxVersionChecker = nil
+	txProc, err := txproc.NewTxProcessor(args)
+
+	assert.Equal(t, process.ErrNilTransactionVersionChecker, err)
+	assert.Nil(t, txProc)
+}
+
 func TestNewTxProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -568,6 +593,172 @@ func TestTxProcessor_CheckTxValuesOkValsShouldErr(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func createTxProcessorWithActiveGuardian(activeGuardian []byte) txproc.TxProcessor {
+	args := createArgsForTxProcessor()
+	args.GuardedAccounts = &mock.GuardedAccountHandlerStub{
+		GetActiveGuardianCalled: func(account state.UserAccountHandler) ([]byte, error) {
+			return activeGuardian, nil
+		},
+	}
+	args.ArgsParser = &mock.ArgumentParserMock{
+		ParseCallDataCalled: func(data string) (string, [][]byte, error) {
+			return parsers.NewCallArgsParser().ParseData(data)
+		},
+	}
+	txProc, _ := txproc.NewTxProcessor(args)
+
+	return txProc
+}
+
+func createAccountWithDataTrie(address []byte) state.UserAccountHandler {
+	acnt, _ := state.NewUserAccount(address)
+	acnt.Balance = big.NewInt(67)
+	acnt.SetRootHash([]byte("root hash"))
+
+	return acnt
+}
+
+func createGuardedTx(sender []byte, guardian []byte) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:      sender,
+		RcvAddr:      []byte("receiver"),
+		Value:        big.NewInt(0),
+		Version:      2,
+		Options:      versioning.MaskGuardedTransaction,
+		GuardianAddr: guardian,
+	}
+}
+
+func TestTxProcessor_CheckTxValuesGuardedTxFromUnguardedAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	acnt := createAccountWithDataTrie([]byte("sender"))
+	execTx := *createTxProcessorWithActiveGuardian(nil)
+
+	err := execTx.CheckTxValues(createGuardedTx(acnt.AddressBytes(), []byte("guardian")), acnt, nil, false)
+	assert.Equal(t, process.ErrAccountNotGuarded, err)
+}
+
+func TestTxProcessor_CheckTxValuesUnguardedTxFromGuardedAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	acnt := createAccountWithDataTrie([]byte("sender"))
+	execTx := *createTxProcessorWithActiveGuardian([]byte("guardian"))
+
+	tx := &transaction.Transaction{SndAddr: acnt.AddressBytes(), RcvAddr: []byte("receiver"), Value: big.NewInt(0)}
+	err := execTx.CheckTxValues(tx, acnt, nil, false)
+	assert.Equal(t, process.ErrGuardedTransactionRequired, err)
+
+	err = execTx.CheckTxValues(tx, acnt, nil, true)
+	assert.Equal(t, process.ErrGuardedTransactionRequired, err)
+}
+
+func TestTxProcessor_CheckTxValuesGuardianMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	acnt := createAccountWithDataTrie([]byte("sender"))
+	execTx := *createTxProcessorWithActiveGuardian([]byte("guardian"))
+
+	err := execTx.CheckTxValues(createGuardedTx(acnt.AddressBytes(), []byte("other guardian")), acnt, nil, false)
+	assert.Equal(t, process.ErrGuardianMismatch, err)
+}
+
+func TestTxProcessor_CheckTxValuesGuardedTxFromGuardedAccountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	acnt := createAccountWithDataTrie([]byte("sender"))
+	execTx := *createTxProcessorWithActiveGuardian([]byte("guardian"))
+
+	err := execTx.CheckTxValues(createGuardedTx(acnt.AddressBytes(), []byte("guardian")), acnt, nil, false)
+	assert.Nil(t, err)
+}
+
+func TestTxProcessor_CheckTxValuesRemoveGuardianFromGuardedAccountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	acnt := createAccountWithDataTrie([]byte("sender"))
+	execTx := *createTxProcessorWithActiveGuardian([]byte("guardian"))
+
+	tx := &transaction.Transaction{
+		SndAddr: acnt.AddressBytes(),
+		RcvAddr: acnt.AddressBytes(),
+		Value:   big.NewInt(0),
+		Data:    []byte(core.BuiltInFunctionRemoveGuardian),
+	}
+	err := execTx.CheckTxValues(tx, acnt, nil, false)
+	assert.Nil(t, err)
+
+	tx.RcvAddr = []byte("receiver")
+	err = execTx.CheckTxValues(tx, acnt, nil, false)
+	assert.Equal(t, process.ErrGuardedTransactionRequired, err)
+}
+
+func TestTxProcessor_CheckTxValuesSetGuardianFromGuardedAccountRequiresCoSignature(t *testing.T) {
+	t.Parallel()
+
+	acnt := createAccountWithDataTrie([]byte("sender"))
+	execTx := *createTxProcessorWithActiveGuardian([]byte("guardian"))
+
+	tx := &transaction.Transaction{
+		SndAddr: acnt.AddressBytes(),
+		RcvAddr: acnt.AddressBytes(),
+		Value:   big.NewInt(0),
+		Data:    []byte(core.BuiltInFunctionSetGuardian + "@" + hex.EncodeToString([]byte("new guardian"))),
+	}
+	err := execTx.CheckTxValues(tx, acnt, nil, false)
+	assert.Equal(t, process.ErrGuardedTransactionRequired, err)
+
+	guardedTx := createGuardedTx(acnt.AddressBytes(), []byte("guardian"))
+	guardedTx.RcvAddr = acnt.AddressBytes()
+	guardedTx.Data = tx.Data
+	err = execTx.CheckTxValues(guardedTx, acnt, nil, false)
+	assert.Nil(t, err)
+}
+
+func TestTxProcessor_CheckTxValuesGuardedAccountsNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForTxProcessor()
+	args.GuardianEnableEpoch = maxEpoch
+	args.GuardedAccounts = &mock.GuardedAccountHandlerStub{
+		GetActiveGuardianCalled: func(account state.UserAccountHandler) ([]byte, error) {
+			assert.Fail(t, "should not have read the guardians")
+			return nil, nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+	acnt := createAccountWithDataTrie([]byte("sender"))
+
+	err := execTx.CheckTxValues(createGuardedTx(acnt.AddressBytes(), []byte("guardian")), acnt, nil, false)
+	assert.Equal(t, process.ErrGuardedAccountsNotEnabled, err)
+
+	tx := &transaction.Transaction{SndAddr: acnt.AddressBytes(), RcvAddr: []byte("receiver"), Value: big.NewInt(0)}
+	err = execTx.CheckTxValues(tx, acnt, nil, false)
+	assert.Nil(t, err)
+}
+
+func TestTxProcessor_CheckTxValuesAccountWithoutDataTrieShouldNotReadTheGuardians(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForTxProcessor()
+	args.GuardedAccounts = &mock.GuardedAccountHandlerStub{
+		GetActiveGuardianCalled: func(account state.UserAccountHandler) ([]byte, error) {
+			assert.Fail(t, "should not have read the guardians")
+			return nil, nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+	acnt, _ := state.NewUserAccount([]byte("sender"))
+	acnt.Balance = big.NewInt(67)
+
+	tx := &transaction.Transaction{SndAddr: acnt.AddressBytes(), RcvAddr: []byte("receiver"), Value: big.NewInt(0)}
+	err := execTx.CheckTxValues(tx, acnt, nil, false)
+	assert.Nil(t, err)
+
+	err = execTx.CheckTxValues(createGuardedTx(acnt.AddressBytes(), []byte("guardian")), acnt, nil, false)
+	assert.Equal(t, process.ErrAccountNotGuarded, err)
+}
+
 //------- increaseNonce
 
 func TestTxProcessor_IncreaseNonceOkValsShouldWork(t *testing.T) {
@@ -162,3 +162,23 @@ func TestTxLogProcessor_GetLogUnmarshalErr(t *testing.T) {
 
 	require.Equal(t, retErr, err)
 }
+
+func TestTxLogProcessor_GetLogShouldWork(t *testing.T) {
+	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:      mock.NewStorerMock(),
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+
+	txHash := []byte("txhash")
+	logs := []*vmcommon.LogEntry{
+		{Identifier: []byte("identifier"), Address: []byte("address"), Topics: [][]byte{[]byte("topic")}},
+	}
+	err := txLogProcessor.SaveLog(txHash, &transaction.Transaction{RcvAddr: []byte("receiver")}, logs)
+	require.Nil(t, err)
+
+	txLog, err := txLogProcessor.GetLog(txHash)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(txLog.GetLogEvents()))
+	require.Equal(t, []byte("identifier"), txLog.GetLogEvents()[0].GetIdentifier())
+	require.Equal(t, []byte("receiver"), txLog.GetAddress())
+}
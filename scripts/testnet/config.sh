@@ -19,8 +19,10 @@ generateConfig
 
 copyConfig
 
-copySeednodeConfig
-updateSeednodeConfig
+if [ $USE_MDNS -eq 0 ]; then
+  copySeednodeConfig
+  updateSeednodeConfig
+fi
 
 copyNodeConfig
 updateNodeConfig
@@ -72,7 +72,12 @@ updateNodeConfig() {
   pushd $TESTNETDIR/node/config
   cp p2p.toml p2p_edit.toml
 
-  updateTOMLValue p2p_edit.toml "InitialPeerList" "[\"$P2P_SEEDNODE_ADDRESS\"]"
+  if [ $USE_MDNS -eq 1 ]; then
+    sed -i 's,Type = "kad-dht",Type = "mdns",' p2p_edit.toml
+    updateTOMLValue p2p_edit.toml "InitialPeerList" "[]"
+  else
+    updateTOMLValue p2p_edit.toml "InitialPeerList" "[\"$P2P_SEEDNODE_ADDRESS\"]"
+  fi
 
   cp p2p_edit.toml p2p.toml
   rm p2p_edit.toml
@@ -15,7 +15,9 @@ source "$ELRONDTESTNETSCRIPTSDIR/include/tools.sh"
 prepareFolders
 
 # Phase 1: build Seednode, Node and Arwen executables
-buildSeednode
+if [ $USE_MDNS -eq 0 ]; then
+  buildSeednode
+fi
 buildNode
 
 if [ $ALWAYS_BUILD_ARWEN -eq 1 ]; then
@@ -28,8 +30,10 @@ if [ $ALWAYS_UPDATE_CONFIGS -eq 1 ]; then
   copyConfig
 fi
 
-copySeednodeConfig
-updateSeednodeConfig
+if [ $USE_MDNS -eq 0 ]; then
+  copySeednodeConfig
+  updateSeednodeConfig
+fi
 
 if [ $ALWAYS_UPDATE_CONFIGS -eq 1 ]; then
   copyNodeConfig
@@ -38,10 +42,12 @@ fi
 
 
 # Phase 3: start the Seednode
-startSeednode
-showTerminalSession "elrond-tools"
-echo "Waiting for the Seednode to start ($SEEDNODE_DELAY s)..."
-sleep $SEEDNODE_DELAY
+if [ $USE_MDNS -eq 0 ]; then
+  startSeednode
+  showTerminalSession "elrond-tools"
+  echo "Waiting for the Seednode to start ($SEEDNODE_DELAY s)..."
+  sleep $SEEDNODE_DELAY
+fi
 
 # Phase 4: start the Observer Nodes and Validator Nodes
 startObservers
@@ -90,6 +90,10 @@ export PORT_ORIGIN_VALIDATOR_REST="9500"
 # Address of the Seednode. Will be written to the p2p.toml file of the Nodes
 export P2P_SEEDNODE_ADDRESS="/ip4/127.0.0.1/tcp/$PORT_SEEDNODE/p2p/16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
 
+# Use the mDNS peer discovery instead of the Seednode: the Nodes will find each other
+# on the local network and the Seednode will be neither built nor started.
+export USE_MDNS=0
+
 
 # UI configuration profiles
 
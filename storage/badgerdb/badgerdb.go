@@ -0,0 +1,347 @@
+package badgerdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/badger/v2/options"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+// the default badger options are tuned for a single, large database. A node opens tens of databases (one for
+// each storer and epoch) so the memory tables and the value log files are kept smaller
+const (
+	maxTableSize        = 16 << 20
+	numMemtables        = 2
+	numLevelZeroTables  = 2
+	valueLogFileSize    = 256 << 20
+	valueLogGCInterval  = 10 * time.Minute
+	valueLogDiscardRate = 0.5
+	numCompactWorkers   = 2
+)
+
+var log = logger.GetOrCreate("storage/badgerdb")
+
+// DB holds a pointer to the badger database and the path to where it is stored.
+type DB struct {
+	db                *badger.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	dbClosed          chan struct{}
+	closeOnce         sync.Once
+}
+
+// NewDB is a constructor for the badger persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (*DB, error) {
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	badgerOptions := badger.DefaultOptions(path).
+		WithLogger(&badgerLogger{path: path}).
+		WithSyncWrites(true).
+		WithTableLoadingMode(options.FileIO).
+		WithValueLogLoadingMode(options.FileIO).
+		WithMaxTableSize(maxTableSize).
+		WithNumMemtables(numMemtables).
+		WithNumLevelZeroTables(numLevelZeroTables).
+		WithValueLogFileSize(valueLogFileSize)
+
+	db, err := badger.Open(badgerOptions)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		batch:             NewBatch(),
+		dbClosed:          make(chan struct{}),
+	}
+
+	go dbStore.batchTimeoutHandle()
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle() {
+	lastValueLogGC := time.Now()
+	for {
+		select {
+		case <-time.After(time.Duration(s.batchDelaySeconds) * time.Second):
+			s.mutBatch.Lock()
+			err := s.putBatch()
+			if err != nil {
+				log.Warn("badgerdb putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+
+			if time.Since(lastValueLogGC) > valueLogGCInterval {
+				s.runValueLogGC()
+				lastValueLogGC = time.Now()
+			}
+		case <-s.dbClosed:
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// runValueLogGC rewrites the value log files which contain mostly stale values, until there is nothing
+// left to rewrite
+func (s *DB) runValueLogGC() {
+	for {
+		err := s.db.RunValueLogGC(valueLogDiscardRate)
+		if err != nil {
+			if err != badger.ErrNoRewrite {
+				log.Debug("badgerdb RunValueLogGC", "path", s.path, "error", err.Error())
+			}
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch()
+	if err != nil {
+		log.Warn("badgerdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	if s.batch.isRemoved(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+	data := s.batch.Get(key)
+	if data != nil {
+		return data, nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		data, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	if s.batch.isRemoved(key) {
+		return storage.ErrKeyNotFound
+	}
+	if s.batch.Get(key) != nil {
+		return nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *DB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// putBatch writes the batch data into the database
+func (s *DB) putBatch() error {
+	if s.batch.isEmpty() {
+		return nil
+	}
+
+	wb := s.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	err := s.batch.writeTo(wb)
+	if err != nil {
+		return err
+	}
+
+	return wb.Flush()
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			clonedKey := item.KeyCopy(nil)
+			clonedVal, errValue := item.ValueCopy(nil)
+			if errValue != nil {
+				return errValue
+			}
+
+			shouldContinue := handler(clonedKey, clonedVal)
+			if !shouldContinue {
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("badgerdb RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+// Compact will merge all the tables of the database into the last level and will then rewrite the value log files
+// which contain mostly stale values
+func (s *DB) Compact() error {
+	s.mutBatch.Lock()
+	err := s.putBatch()
+	if err == nil {
+		s.batch.Reset()
+		s.sizeBatch = 0
+	}
+	s.mutBatch.Unlock()
+	if err != nil {
+		return err
+	}
+
+	err = s.db.Flatten(numCompactWorkers)
+	if err != nil {
+		return err
+	}
+
+	s.runValueLogGC()
+
+	return nil
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.closeOnce.Do(func() {
+		close(s.dbClosed)
+	})
+
+	if s.db.IsClosed() {
+		return nil
+	}
+
+	return s.db.Close()
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	_ = s.batch.Delete(key)
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.closeOnce.Do(func() {
+		close(s.dbClosed)
+	})
+
+	if !s.db.IsClosed() {
+		err := s.db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
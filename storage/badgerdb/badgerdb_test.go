@@ -0,0 +1,272 @@
+package badgerdb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBadgerDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) *badgerdb.DB {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb, err := badgerdb.NewDB(dir, batchDelaySeconds, maxBatchSize)
+	require.Nil(t, err, "Failed creating badger database")
+
+	return bdb
+}
+
+func TestDB_InitNoError(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Init()
+
+	assert.Nil(t, err)
+}
+
+func TestDB_DoubleOpenShouldError(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb1, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = bdb1.Destroy()
+	}()
+
+	_, err = badgerdb.NewDB(dir, 10, 1)
+	assert.NotNil(t, err)
+}
+
+func TestDB_GetAfterPutBeforeTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	v, err := bdb.Get(key)
+	assert.Equal(t, val, v)
+	assert.Nil(t, err)
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 2)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_RemoveBeforeTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	_ = bdb.Remove(key)
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	time.Sleep(time.Second * 2)
+
+	v, err = bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_RemoveAfterWriteOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	assert.Nil(t, bdb.Has(key))
+
+	err = bdb.Remove(key)
+	assert.Nil(t, err)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, bdb.Has(key))
+}
+
+func TestDB_PutAfterRemoveShouldWork(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 10, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	_ = bdb.Remove(key)
+	_ = bdb.Put(key, val)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_GetAndHasNotPresent(t *testing.T) {
+	key := []byte("key")
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, bdb.Has(key))
+}
+
+func TestDB_CloseShouldPersistTheBatch(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	bdb, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	_ = bdb.Put(key, val)
+
+	err = bdb.Close()
+	require.Nil(t, err)
+	err = bdb.Close()
+	assert.Nil(t, err)
+
+	bdb, err = badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_DestroyShouldRemoveTheFiles(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	err = bdb.Destroy()
+	assert.Nil(t, err)
+
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDB_DestroyClosedShouldRemoveTheFiles(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	_ = bdb.Close()
+	err = bdb.DestroyClosed()
+	assert.Nil(t, err)
+
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+	}
+
+	for key, val := range keysVals {
+		_ = bdb.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	handler := func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	}
+
+	bdb.RangeKeys(handler)
+	assert.Equal(t, keysVals, recovered)
+
+	numCalls := 0
+	bdb.RangeKeys(func(key []byte, val []byte) bool {
+		numCalls++
+		return false
+	})
+	assert.Equal(t, 1, numCalls)
+
+	bdb.RangeKeys(nil)
+}
+
+func TestDB_PutGetLargeValue(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	key := []byte("key")
+	largeValue := make([]byte, 1<<20)
+	for i := range largeValue {
+		largeValue[i] = byte(i)
+	}
+
+	err := bdb.Put(key, largeValue)
+	require.Nil(t, err)
+
+	recovered, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, largeValue, recovered)
+}
+
+func TestDB_CompactShouldPersistTheBatchAndKeepTheData(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 10, 100)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	_ = bdb.Put(key, val)
+	_ = bdb.Put([]byte("removed"), val)
+	_ = bdb.Remove([]byte("removed"))
+
+	err := bdb.Compact()
+	assert.Nil(t, err)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+	assert.Equal(t, storage.ErrKeyNotFound, bdb.Has([]byte("removed")))
+}
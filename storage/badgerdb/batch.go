@@ -0,0 +1,99 @@
+package badgerdb
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+type batch struct {
+	cachedData  map[string][]byte
+	removedKeys map[string]struct{}
+	mutBatch    sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		cachedData:  make(map[string][]byte),
+		removedKeys: make(map[string]struct{}),
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	b.cachedData[string(key)] = val
+	delete(b.removedKeys, string(key))
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	delete(b.cachedData, string(key))
+	b.removedKeys[string(key)] = struct{}{}
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.cachedData = make(map[string][]byte)
+	b.removedKeys = make(map[string]struct{})
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+func (b *batch) isRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	_, found := b.removedKeys[string(key)]
+
+	return found
+}
+
+func (b *batch) writeTo(wb *badger.WriteBatch) error {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	for key, val := range b.cachedData {
+		err := wb.Set([]byte(key), val)
+		if err != nil {
+			return err
+		}
+	}
+	for key := range b.removedKeys {
+		err := wb.Delete([]byte(key))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *batch) isEmpty() bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return len(b.cachedData) == 0 && len(b.removedKeys) == 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
@@ -0,0 +1,36 @@
+package badgerdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// badgerLogger redirects the badger internal logs towards the node's logger. Badger is quite verbose on the
+// info level (compactions, value log rotations), so its info messages are logged as debug messages
+type badgerLogger struct {
+	path string
+}
+
+// Errorf logs an error message
+func (bl *badgerLogger) Errorf(format string, args ...interface{}) {
+	log.Error("badger", "path", bl.path, "message", formatMessage(format, args...))
+}
+
+// Warningf logs a warning message
+func (bl *badgerLogger) Warningf(format string, args ...interface{}) {
+	log.Warn("badger", "path", bl.path, "message", formatMessage(format, args...))
+}
+
+// Infof logs an info message
+func (bl *badgerLogger) Infof(format string, args ...interface{}) {
+	log.Debug("badger", "path", bl.path, "message", formatMessage(format, args...))
+}
+
+// Debugf logs a debug message
+func (bl *badgerLogger) Debugf(format string, args ...interface{}) {
+	log.Trace("badger", "path", bl.path, "message", formatMessage(format, args...))
+}
+
+func formatMessage(format string, args ...interface{}) string {
+	return strings.TrimSpace(fmt.Sprintf(format, args...))
+}
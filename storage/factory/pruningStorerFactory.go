@@ -467,6 +467,38 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, epochByHashUnit)
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	if psf.generalConfig.DbLookupExtensions.AddressIndexEnabled {
+		// Create the addressTransactions (STATIC) storer
+		addressTransactionsConfig := psf.generalConfig.DbLookupExtensions.AddressTransactionsStorageConfig
+		addressTransactionsDbConfig := GetDBFromConfig(addressTransactionsConfig.DB)
+		addressTransactionsDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, addressTransactionsConfig.DB.FilePath)
+		addressTransactionsCacherConfig := GetCacherFromConfig(addressTransactionsConfig.Cache)
+		addressTransactionsBloomFilter := GetBloomFromConfig(addressTransactionsConfig.Bloom)
+		addressTransactionsUnit, errCreate := storageUnit.NewStorageUnitFromConf(addressTransactionsCacherConfig, addressTransactionsDbConfig, addressTransactionsBloomFilter)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		*createdStorers = append(*createdStorers, addressTransactionsUnit)
+		chainStorer.AddStorer(dataRetriever.AddressTransactionsUnit, addressTransactionsUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.LogsIdentifierIndexEnabled {
+		// Create the logsIdentifier (STATIC) storer
+		logsIdentifierConfig := psf.generalConfig.DbLookupExtensions.LogsIdentifierStorageConfig
+		logsIdentifierDbConfig := GetDBFromConfig(logsIdentifierConfig.DB)
+		logsIdentifierDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, logsIdentifierConfig.DB.FilePath)
+		logsIdentifierCacherConfig := GetCacherFromConfig(logsIdentifierConfig.Cache)
+		logsIdentifierBloomFilter := GetBloomFromConfig(logsIdentifierConfig.Bloom)
+		logsIdentifierUnit, errCreate := storageUnit.NewStorageUnitFromConf(logsIdentifierCacherConfig, logsIdentifierDbConfig, logsIdentifierBloomFilter)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		*createdStorers = append(*createdStorers, logsIdentifierUnit)
+		chainStorer.AddStorer(dataRetriever.LogsIdentifierUnit, logsIdentifierUnit)
+	}
+
 	return nil
 }
 
@@ -318,3 +318,23 @@ func TestDB_PutGetLargeValue(t *testing.T) {
 
 	assert.Equal(t, buffLargeValue, recovered)
 }
+
+func TestDB_CompactShouldKeepTheData(t *testing.T) {
+	ldb := createLevelDb(t, 10, 1, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	key, val := []byte("key"), []byte("value")
+	_ = ldb.Put(key, val)
+	_ = ldb.Put([]byte("removed"), val)
+	_ = ldb.Remove([]byte("removed"))
+
+	err := ldb.Compact()
+	assert.Nil(t, err)
+
+	v, err := ldb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+	assert.Equal(t, storage.ErrKeyNotFound, ldb.Has([]byte("removed")))
+}
@@ -9,11 +9,14 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	RevertBlockCalled                  func(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetAddressTransactionsCalled       func(address []byte, beforeNonce uint64, size uint64) ([]*dblookupext.AddressTransaction, uint64, error)
+	GetTxHashesByLogIdentifierCalled   func(identifier []byte) ([][]byte, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -22,11 +25,20 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, receipts)
+	}
+	return nil
+}
+
+// RevertBlock -
+func (hp *HistoryRepositoryStub) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	if hp.RevertBlockCalled != nil {
+		return hp.RevertBlockCalled(blockHeader, blockBody)
 	}
 	return nil
 }
@@ -67,6 +79,22 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetAddressTransactions -
+func (hp *HistoryRepositoryStub) GetAddressTransactions(address []byte, beforeNonce uint64, size uint64) ([]*dblookupext.AddressTransaction, uint64, error) {
+	if hp.GetAddressTransactionsCalled != nil {
+		return hp.GetAddressTransactionsCalled(address, beforeNonce, size)
+	}
+	return nil, 0, nil
+}
+
+// GetTxHashesByLogIdentifier -
+func (hp *HistoryRepositoryStub) GetTxHashesByLogIdentifier(identifier []byte) ([][]byte, error) {
+	if hp.GetTxHashesByLogIdentifierCalled != nil {
+		return hp.GetTxHashesByLogIdentifierCalled(identifier)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -83,6 +83,7 @@ type ArgsNewFullSyncInterceptorsContainerFactory struct {
 	ChainID                   []byte
 	MinTxVersion              uint32
 	EnableSignTxWithHashEpoch uint32
+	GuardianEnableEpoch       uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
 }
@@ -180,6 +181,7 @@ func NewFullSyncInterceptorsContainerFactory(
 		ChainID:                   args.ChainID,
 		MinTransactionVersion:     args.MinTxVersion,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		GuardianEnableEpoch:       args.GuardianEnableEpoch,
 		TxSignHasher:              args.TxSignHasher,
 		EpochNotifier:             args.EpochNotifier,
 	}
@@ -33,6 +33,7 @@ type SystemEIStub struct {
 	CanUnJailCalled                     func(blsKey []byte) bool
 	IsBadRatingCalled                   func(blsKey []byte) bool
 	SendGlobalSettingToAllCalled        func(sender []byte, input []byte)
+	AddLogEntryCalled                   func(entry *vmcommon.LogEntry)
 	GetContractCalled                   func(address []byte) (vm.SystemSmartContract, error)
 	GasLeftCalled                       func() uint64
 	ReturnMessage                       string
@@ -180,6 +181,13 @@ func (s *SystemEIStub) SendGlobalSettingToAll(sender []byte, input []byte) {
 	}
 }
 
+// AddLogEntry -
+func (s *SystemEIStub) AddLogEntry(entry *vmcommon.LogEntry) {
+	if s.AddLogEntryCalled != nil {
+		s.AddLogEntryCalled(entry)
+	}
+}
+
 // Transfer -
 func (s *SystemEIStub) Transfer(destination []byte, sender []byte, value *big.Int, input []byte, _ uint64) error {
 	if s.TransferCalled != nil {
@@ -25,6 +25,14 @@ const serviceFeeKey = "serviceFee"
 const totalActiveKey = "totalActive"
 const rewardKeyPrefix = "reward"
 const fundKeyPrefix = "fund"
+const whitelistKeyPrefix = "whitelist"
+const delegationMetaData = "delegationMetaData"
+
+const (
+	maxMetaDataNameLength       = 100
+	maxMetaDataWebsiteLength    = 100
+	maxMetaDataIdentifierLength = 50
+)
 
 const percentageDenominator = uint64(100000)
 
@@ -44,6 +52,12 @@ type delegation struct {
 	marshalizer            marshal.Marshalizer
 	delegationEnabled      atomic.Flag
 	enableDelegationEpoch  uint32
+	reDelegateEnableEpoch  uint32
+	flagReDelegate         atomic.Flag
+	whitelistEnableEpoch   uint32
+	flagWhitelist          atomic.Flag
+	metaDataEnableEpoch    uint32
+	flagMetaData           atomic.Flag
 	minServiceFee          uint64
 	maxServiceFee          uint64
 	unBondPeriod           uint64
@@ -108,6 +122,9 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		marshalizer:            args.Marshalizer,
 		delegationEnabled:      atomic.Flag{},
 		enableDelegationEpoch:  args.DelegationSCConfig.EnabledEpoch,
+		reDelegateEnableEpoch:  args.DelegationSCConfig.ReDelegateRewardsEnableEpoch,
+		whitelistEnableEpoch:   args.DelegationSCConfig.WhitelistEnableEpoch,
+		metaDataEnableEpoch:    args.DelegationSCConfig.MetaDataEnableEpoch,
 		minServiceFee:          args.DelegationSCConfig.MinServiceFee,
 		maxServiceFee:          args.DelegationSCConfig.MaxServiceFee,
 		sigVerifier:            args.SigVerifier,
@@ -189,6 +206,31 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.updateRewards(args)
 	case "claimRewards":
 		return d.claimRewards(args)
+	case "reDelegateRewards":
+		if !d.flagReDelegate.IsSet() {
+			break
+		}
+		return d.reDelegateRewards(args)
+	case "setWhitelistMode":
+		if !d.flagWhitelist.IsSet() {
+			break
+		}
+		return d.setWhitelistMode(args)
+	case "addToWhitelist":
+		if !d.flagWhitelist.IsSet() {
+			break
+		}
+		return d.addToWhitelist(args)
+	case "removeFromWhitelist":
+		if !d.flagWhitelist.IsSet() {
+			break
+		}
+		return d.removeFromWhitelist(args)
+	case "isWhitelisted":
+		if !d.flagWhitelist.IsSet() {
+			break
+		}
+		return d.isWhitelisted(args)
 	case "getRewardData":
 		return d.getRewardData(args)
 	case "getClaimableRewards":
@@ -213,6 +255,16 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.getAllNodeStates(args)
 	case "getContractConfig":
 		return d.getContractConfig(args)
+	case "setMetaData":
+		if !d.flagMetaData.IsSet() {
+			break
+		}
+		return d.setMetaData(args)
+	case "getMetaData":
+		if !d.flagMetaData.IsSet() {
+			break
+		}
+		return d.getMetaData(args)
 	}
 
 	d.eei.AddReturnMessage(args.Function + "is an unknown function")
@@ -499,6 +551,84 @@ func (d *delegation) setAutomaticActivation(args *vmcommon.ContractCallInput) vm
 	return vmcommon.Ok
 }
 
+func (d *delegation) setWhitelistMode(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	dConfig, returnCode := d.basicArgCheckForConfigChanges(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	switch string(args.Arguments[0]) {
+	case "yes":
+		dConfig.WhitelistEnabled = true
+	case "no":
+		dConfig.WhitelistEnabled = false
+	default:
+		d.eei.AddReturnMessage("invalid argument")
+		return vmcommon.UserError
+	}
+
+	err := d.saveDelegationContractConfig(dConfig)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) addToWhitelist(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := d.checkArgumentsForWhitelistChanges(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	for _, address := range args.Arguments {
+		d.eei.SetStorage(whitelistKey(address), []byte{1})
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) removeFromWhitelist(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := d.checkArgumentsForWhitelistChanges(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	for _, address := range args.Arguments {
+		d.eei.SetStorage(whitelistKey(address), nil)
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) checkArgumentsForWhitelistChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := d.checkOwnerCallValueGasAndDuplicates(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) == 0 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	for _, address := range args.Arguments {
+		if len(address) != len(args.CallerAddr) {
+			d.eei.AddReturnMessage("invalid address to whitelist")
+			return vmcommon.UserError
+		}
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) isAddressWhitelisted(address []byte) bool {
+	return len(d.eei.GetStorage(whitelistKey(address))) > 0
+}
+
+func whitelistKey(address []byte) []byte {
+	return append([]byte(whitelistKeyPrefix), address...)
+}
+
 func (d *delegation) changeServiceFee(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	returnCode := d.checkOwnerCallValueGasAndDuplicates(args)
 	if returnCode != vmcommon.Ok {
@@ -936,8 +1066,28 @@ func (d *delegation) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		d.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if dConfig.WhitelistEnabled && !d.isOwner(args.CallerAddr) && !d.isAddressWhitelisted(args.CallerAddr) {
+		d.eei.AddReturnMessage("caller is not whitelisted to delegate")
+		return vmcommon.UserError
+	}
 
-	return d.delegateUser(args.CallValue, args.CallerAddr, args.RecipientAddr, dStatus)
+	returnCode := d.delegateUser(args.CallValue, args.CallerAddr, args.RecipientAddr, dStatus)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	d.eei.AddLogEntry(&vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.RecipientAddr,
+		Topics:     [][]byte{args.CallerAddr, args.CallValue.Bytes()},
+	})
+
+	return vmcommon.Ok
 }
 
 func (d *delegation) addNewDelegatorToList(dStatus *DelegationContractStatus, address []byte) error {
@@ -1303,6 +1453,58 @@ func (d *delegation) claimRewards(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
+func (d *delegation) reDelegateRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 0 {
+		d.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	isNew, delegator, err := d.getOrCreateDelegatorData(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		d.eei.AddReturnMessage("caller is not a delegator")
+		return vmcommon.UserError
+	}
+
+	err = d.computeAndUpdateRewards(args.CallerAddr, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if delegator.UnClaimedRewards.Cmp(d.minDelegationAmount) < 0 {
+		d.eei.AddReturnMessage("rewards to redelegate must be higher than minDelegationAmount " + d.minDelegationAmount.String())
+		return vmcommon.UserError
+	}
+
+	rewardsToReDelegate := big.NewInt(0).Set(delegator.UnClaimedRewards)
+	delegator.UnClaimedRewards.SetUint64(0)
+	err = d.saveDelegatorData(args.CallerAddr, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.delegateUser(rewardsToReDelegate, args.CallerAddr, args.RecipientAddr, dStatus)
+}
+
 func (d *delegation) executeOnValidatorSCWithValueInArgs(
 	scAddress []byte,
 	functionToCall string,
@@ -1504,6 +1706,30 @@ func (d *delegation) getTotalCumulatedRewards(args *vmcommon.ContractCallInput)
 	return vmcommon.Ok
 }
 
+func (d *delegation) isWhitelisted(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+
+	isWhitelisted := "false"
+	if d.isAddressWhitelisted(args.Arguments[0]) {
+		isWhitelisted = "true"
+	}
+	d.eei.Finish([]byte(isWhitelisted))
+
+	return vmcommon.Ok
+}
+
 func (d *delegation) getNumUsers(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	returnCode := d.checkArgumentsForGeneralViewFunc(args)
 	if returnCode != vmcommon.Ok {
@@ -1635,6 +1861,11 @@ func (d *delegation) getContractConfig(args *vmcommon.ContractCallInput) vmcommo
 		changeableServiceFee = "true"
 	}
 
+	whitelistEnabled := "false"
+	if delegationConfig.WhitelistEnabled {
+		whitelistEnabled = "true"
+	}
+
 	ownerAddress := d.eei.GetStorage([]byte(ownerKey))
 	serviceFee := d.eei.GetStorage([]byte(serviceFeeKey))
 
@@ -1647,6 +1878,72 @@ func (d *delegation) getContractConfig(args *vmcommon.ContractCallInput) vmcommo
 	d.eei.Finish([]byte(changeableServiceFee))
 	d.eei.Finish(big.NewInt(0).SetUint64(delegationConfig.CreatedNonce).Bytes())
 	d.eei.Finish(big.NewInt(0).SetUint64(delegationConfig.UnBondPeriod).Bytes())
+	d.eei.Finish([]byte(whitelistEnabled))
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) setMetaData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.isOwner(args.CallerAddr) {
+		d.eei.AddReturnMessage("only owner can call this method")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 3 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	if len(args.Arguments[0]) > maxMetaDataNameLength ||
+		len(args.Arguments[1]) > maxMetaDataWebsiteLength ||
+		len(args.Arguments[2]) > maxMetaDataIdentifierLength {
+		d.eei.AddReturnMessage(fmt.Sprintf("name, website and identifier can have at most %d, %d and %d bytes",
+			maxMetaDataNameLength, maxMetaDataWebsiteLength, maxMetaDataIdentifierLength))
+		return vmcommon.UserError
+	}
+
+	dMetaData := &DelegationMetaData{
+		Name:       args.Arguments[0],
+		Website:    args.Arguments[1],
+		Identifier: args.Arguments[2],
+	}
+	marshaledData, err := d.marshalizer.Marshal(dMetaData)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.SetStorage([]byte(delegationMetaData), marshaledData)
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) getMetaData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := d.checkArgumentsForGeneralViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	dMetaData := &DelegationMetaData{}
+	marshaledData := d.eei.GetStorage([]byte(delegationMetaData))
+	if len(marshaledData) > 0 {
+		err := d.marshalizer.Unmarshal(dMetaData, marshaledData)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	d.eei.Finish(dMetaData.Name)
+	d.eei.Finish(dMetaData.Website)
+	d.eei.Finish(dMetaData.Identifier)
 
 	return vmcommon.Ok
 }
@@ -1996,6 +2293,15 @@ func (d *delegation) SetNewGasCost(gasCost vm.GasCost) {
 func (d *delegation) EpochConfirmed(epoch uint32) {
 	d.delegationEnabled.Toggle(epoch >= d.enableDelegationEpoch)
 	log.Debug("delegation", "enabled", d.delegationEnabled.IsSet())
+
+	d.flagReDelegate.Toggle(epoch >= d.reDelegateEnableEpoch)
+	log.Debug("delegation: reDelegateRewards", "enabled", d.flagReDelegate.IsSet())
+
+	d.flagWhitelist.Toggle(epoch >= d.whitelistEnableEpoch)
+	log.Debug("delegation: whitelist", "enabled", d.flagWhitelist.IsSet())
+
+	d.flagMetaData.Toggle(epoch >= d.metaDataEnableEpoch)
+	log.Debug("delegation: metadata", "enabled", d.flagMetaData.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
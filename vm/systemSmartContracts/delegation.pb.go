@@ -153,6 +153,7 @@ type DelegationConfig struct {
 	ChangeableServiceFee bool          `protobuf:"varint,4,opt,name=ChangeableServiceFee,proto3" json:"ChangeableServiceFee"`
 	CreatedNonce         uint64        `protobuf:"varint,5,opt,name=CreatedNonce,proto3" json:"CreatedNonce"`
 	UnBondPeriod         uint64        `protobuf:"varint,6,opt,name=UnBondPeriod,proto3" json:"UnBondPeriod"`
+	WhitelistEnabled     bool          `protobuf:"varint,7,opt,name=WhitelistEnabled,proto3" json:"WhitelistEnabled"`
 }
 
 func (m *DelegationConfig) Reset()      { *m = DelegationConfig{} }
@@ -225,6 +226,13 @@ func (m *DelegationConfig) GetUnBondPeriod() uint64 {
 	return 0
 }
 
+func (m *DelegationConfig) GetWhitelistEnabled() bool {
+	if m != nil {
+		return m.WhitelistEnabled
+	}
+	return false
+}
+
 type DelegationContractStatus struct {
 	Delegators    [][]byte     `protobuf:"bytes,1,rep,name=Delegators,proto3" json:"Delegators"`
 	StakedKeys    []*NodesData `protobuf:"bytes,2,rep,name=StakedKeys,proto3" json:"StakedKeys"`
@@ -579,6 +587,61 @@ func (m *RewardComputationData) GetServiceFee() uint64 {
 	return 0
 }
 
+type DelegationMetaData struct {
+	Name       []byte `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name"`
+	Website    []byte `protobuf:"bytes,2,opt,name=Website,proto3" json:"Website"`
+	Identifier []byte `protobuf:"bytes,3,opt,name=Identifier,proto3" json:"Identifier"`
+}
+
+func (m *DelegationMetaData) Reset()      { *m = DelegationMetaData{} }
+func (*DelegationMetaData) ProtoMessage() {}
+func (*DelegationMetaData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{9}
+}
+func (m *DelegationMetaData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationMetaData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegationMetaData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationMetaData.Merge(m, src)
+}
+func (m *DelegationMetaData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationMetaData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationMetaData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationMetaData proto.InternalMessageInfo
+
+func (m *DelegationMetaData) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *DelegationMetaData) GetWebsite() []byte {
+	if m != nil {
+		return m.Website
+	}
+	return nil
+}
+
+func (m *DelegationMetaData) GetIdentifier() []byte {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DelegationManagement)(nil), "proto.DelegationManagement")
 	proto.RegisterType((*DelegationContractList)(nil), "proto.DelegationContractList")
@@ -589,77 +652,82 @@ func init() {
 	proto.RegisterType((*GlobalFundData)(nil), "proto.GlobalFundData")
 	proto.RegisterType((*NodesData)(nil), "proto.NodesData")
 	proto.RegisterType((*RewardComputationData)(nil), "proto.RewardComputationData")
+	proto.RegisterType((*DelegationMetaData)(nil), "proto.DelegationMetaData")
 }
 
 func init() { proto.RegisterFile("delegation.proto", fileDescriptor_b823c7d67e95582e) }
 
 var fileDescriptor_b823c7d67e95582e = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
+	// 1103 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6b, 0xe3, 0x46,
-	0x14, 0xb6, 0x1c, 0x27, 0x4d, 0x5e, 0xe2, 0x34, 0x99, 0xcd, 0xb6, 0xa6, 0x14, 0x29, 0x08, 0x0a,
-	0x81, 0xb2, 0x0e, 0xdb, 0x16, 0x0a, 0xed, 0xa5, 0x91, 0xb3, 0x29, 0x66, 0x63, 0x6f, 0x19, 0x67,
-	0x17, 0xba, 0xec, 0x65, 0x6c, 0x4d, 0x94, 0x21, 0xf6, 0x8c, 0x91, 0x46, 0xc9, 0x06, 0x7a, 0xe8,
-	0xa5, 0xd0, 0x53, 0xe9, 0x3f, 0xe8, 0xb5, 0xec, 0xff, 0x28, 0xf4, 0x18, 0x7a, 0xca, 0x49, 0x6d,
-	0x9c, 0x4b, 0xd1, 0x69, 0x29, 0xfd, 0x01, 0x45, 0x23, 0xc9, 0x1e, 0xd9, 0xde, 0x4b, 0x31, 0x7b,
-	0xb1, 0xe6, 0x7d, 0x9f, 0xe7, 0xd3, 0xcc, 0x7b, 0xdf, 0xbc, 0x11, 0x6c, 0xb9, 0xb4, 0x4f, 0x3d,
-	0x22, 0x99, 0xe0, 0xf5, 0xa1, 0x2f, 0xa4, 0x40, 0xcb, 0xea, 0xf1, 0xc1, 0x03, 0x8f, 0xc9, 0xb3,
-	0xb0, 0x5b, 0xef, 0x89, 0xc1, 0xbe, 0x27, 0x3c, 0xb1, 0xaf, 0xe0, 0x6e, 0x78, 0xaa, 0x22, 0x15,
-	0xa8, 0x51, 0x3a, 0xcb, 0xfe, 0x77, 0x09, 0x76, 0x0e, 0xc7, 0x52, 0x2d, 0xc2, 0x89, 0x47, 0x07,
-	0x94, 0x4b, 0xf4, 0x05, 0x6c, 0xb6, 0xc3, 0xc1, 0x93, 0xd3, 0x86, 0xe0, 0xd2, 0x27, 0x3d, 0x19,
-	0xd4, 0x8c, 0x5d, 0x63, 0xaf, 0xea, 0xa0, 0x38, 0xb2, 0xa6, 0x18, 0x3c, 0x15, 0xa3, 0x87, 0xb0,
-	0x7e, 0x4c, 0x02, 0x79, 0xe0, 0xba, 0x3e, 0x0d, 0x82, 0x5a, 0x79, 0xd7, 0xd8, 0xdb, 0x70, 0xde,
-	0x8d, 0x23, 0x4b, 0x87, 0xb1, 0x1e, 0xa0, 0xcf, 0xa1, 0xda, 0x62, 0xbc, 0x43, 0xfd, 0x0b, 0xd6,
-	0xa3, 0x47, 0x94, 0xd6, 0x96, 0x76, 0x8d, 0xbd, 0x8a, 0xb3, 0x1d, 0x47, 0x56, 0x91, 0xc0, 0xc5,
-	0x50, 0x4d, 0x24, 0x2f, 0xb5, 0x89, 0x15, 0x6d, 0xa2, 0x4e, 0xe0, 0x62, 0x88, 0x7e, 0x30, 0x60,
-	0xcb, 0x21, 0x01, 0x6d, 0x06, 0x41, 0x48, 0x19, 0xf7, 0x1a, 0x22, 0x90, 0xb5, 0x65, 0xb5, 0xd4,
-	0x6f, 0xe3, 0xc8, 0x9a, 0xe1, 0x5e, 0xfd, 0x69, 0x1d, 0x0c, 0x88, 0x3c, 0xdb, 0xef, 0x32, 0xaf,
-	0xde, 0xe4, 0xf2, 0x4b, 0x2d, 0xd1, 0x8f, 0xfa, 0xbe, 0xe0, 0x6e, 0x9b, 0xca, 0x4b, 0xe1, 0x9f,
-	0xef, 0x53, 0x15, 0x3d, 0xf0, 0xc4, 0xbe, 0x4b, 0x24, 0xa9, 0x3b, 0xcc, 0x6b, 0x72, 0xd9, 0x20,
-	0x81, 0xa4, 0x3e, 0x9e, 0x91, 0x45, 0x01, 0x40, 0x8b, 0xf1, 0x43, 0x3a, 0x14, 0x01, 0x93, 0xb5,
-	0x15, 0xb5, 0x80, 0x4e, 0x1c, 0x59, 0x1a, 0xba, 0x98, 0x57, 0x6b, 0x82, 0xf6, 0x23, 0x78, 0x6f,
-	0x52, 0xf5, 0xbc, 0x70, 0xc7, 0x2c, 0x90, 0xe8, 0x63, 0x58, 0xcb, 0x6a, 0x42, 0x93, 0x92, 0x2f,
-	0xed, 0x6d, 0x38, 0xd5, 0x38, 0xb2, 0x26, 0x20, 0x9e, 0x0c, 0xed, 0x57, 0x15, 0xd8, 0x2a, 0xe8,
-	0x9c, 0x32, 0x4f, 0x25, 0xb6, 0x45, 0x5e, 0x6a, 0x38, 0x19, 0x2a, 0xf3, 0x64, 0x89, 0x9d, 0xe6,
-	0x16, 0x94, 0xd8, 0x69, 0x59, 0xf4, 0xa3, 0x01, 0xdb, 0x4d, 0xce, 0x24, 0x23, 0xfd, 0x27, 0x97,
-	0x9c, 0xfa, 0x47, 0x21, 0x77, 0x73, 0x33, 0x3e, 0x8f, 0x23, 0x6b, 0x96, 0x5c, 0xcc, 0x4a, 0x66,
-	0x75, 0x51, 0x13, 0xee, 0x1d, 0x84, 0x52, 0x0c, 0x88, 0x64, 0xbd, 0x83, 0x9e, 0x64, 0x17, 0x6a,
-	0x91, 0xca, 0xe3, 0xab, 0xce, 0xfb, 0x71, 0x64, 0xcd, 0xa3, 0xf1, 0x3c, 0x10, 0x1d, 0xc3, 0x4e,
-	0xe3, 0x8c, 0x70, 0x8f, 0x92, 0x6e, 0x9f, 0x4e, 0xd9, 0x7e, 0xd5, 0xa9, 0xc5, 0x91, 0x35, 0x97,
-	0xc7, 0x73, 0x51, 0xf4, 0x19, 0x6c, 0x34, 0x7c, 0x4a, 0x24, 0x75, 0xdb, 0x82, 0xf7, 0xa8, 0xf2,
-	0x7f, 0xc5, 0xd9, 0x8a, 0x23, 0xab, 0x80, 0xe3, 0x42, 0x94, 0xcc, 0x7a, 0xca, 0x1d, 0xc1, 0xdd,
-	0x6f, 0xa8, 0xcf, 0x84, 0xab, 0x4c, 0x9b, 0xcd, 0xd2, 0x71, 0x5c, 0x88, 0xec, 0x5f, 0xca, 0x50,
-	0x9b, 0x35, 0x5d, 0x47, 0x12, 0x19, 0x06, 0xa8, 0x0e, 0x90, 0x71, 0xc2, 0xcf, 0x7d, 0xb7, 0x99,
-	0x9c, 0x82, 0x09, 0x8a, 0xb5, 0x31, 0xfa, 0x0a, 0xa0, 0x23, 0xc9, 0x39, 0x75, 0x1f, 0xd3, 0xab,
-	0xa4, 0xa8, 0x4b, 0x7b, 0xeb, 0x9f, 0x6c, 0xa5, 0x3d, 0xad, 0xde, 0x16, 0x2e, 0x0d, 0x0e, 0x89,
-	0x24, 0xa9, 0xc2, 0xe4, 0x7f, 0x58, 0x1b, 0xa3, 0x26, 0x54, 0xdb, 0x42, 0x6a, 0x22, 0x4b, 0x6f,
-	0x10, 0x51, 0xad, 0xa4, 0xf0, 0x57, 0x5c, 0x0c, 0xd1, 0x51, 0x92, 0x0f, 0x4d, 0xa9, 0xf2, 0x06,
-	0xa5, 0x2c, 0x43, 0x9a, 0x50, 0x21, 0xb2, 0xff, 0x30, 0xa0, 0x92, 0x18, 0x06, 0xb9, 0xb0, 0xfc,
-	0x8c, 0xf4, 0x43, 0x9a, 0x1d, 0x9b, 0x76, 0x1c, 0x59, 0x29, 0xb0, 0x18, 0x87, 0xa6, 0x5a, 0xe8,
-	0x23, 0x78, 0xa7, 0xd8, 0xa2, 0xd7, 0xe3, 0xc8, 0xca, 0x21, 0x9c, 0x0f, 0x90, 0x05, 0xcb, 0xa9,
-	0x39, 0xd2, 0x96, 0xbc, 0x96, 0x2c, 0x26, 0x75, 0x45, 0xfa, 0x40, 0x1f, 0x42, 0xe5, 0xe4, 0x6a,
-	0x98, 0x5a, 0xb0, 0xea, 0xac, 0xc6, 0x91, 0xa5, 0x62, 0xac, 0x7e, 0xed, 0xdf, 0xca, 0x50, 0x1d,
-	0x17, 0x2e, 0x49, 0x43, 0x52, 0x6b, 0x65, 0x68, 0x9a, 0xec, 0x35, 0xdb, 0xa2, 0xaa, 0xd4, 0x04,
-	0xc5, 0xda, 0x38, 0x69, 0xf1, 0x79, 0x9a, 0xf2, 0x33, 0x9c, 0xd8, 0x43, 0xd5, 0xa5, 0x40, 0xe0,
-	0x62, 0x88, 0x1a, 0xb0, 0x8d, 0xe9, 0x25, 0xf1, 0xdd, 0xa0, 0x71, 0x46, 0x7b, 0xe7, 0x43, 0xc1,
-	0xb8, 0x54, 0xbb, 0xa8, 0x3a, 0xf7, 0x93, 0x06, 0x30, 0x43, 0xe2, 0x59, 0x48, 0xb5, 0xb3, 0xa7,
-	0xbc, 0xd1, 0x27, 0x6c, 0x40, 0xdd, 0x8c, 0x56, 0x5b, 0xcd, 0xda, 0xd9, 0x34, 0xb7, 0xa0, 0x76,
-	0x36, 0x2d, 0x6b, 0xff, 0x53, 0x86, 0xcd, 0xaf, 0xfb, 0xa2, 0x4b, 0xfa, 0xc9, 0xe6, 0x54, 0x22,
-	0x1f, 0xc2, 0xfa, 0x24, 0x4d, 0xf9, 0xa9, 0x51, 0xf7, 0xac, 0x06, 0x63, 0x3d, 0xf8, 0xff, 0xb9,
-	0xbc, 0x80, 0xf5, 0x13, 0x21, 0x49, 0x3f, 0x15, 0x53, 0x59, 0xdc, 0x70, 0x4e, 0x92, 0x77, 0x69,
-	0xf0, 0x62, 0xf6, 0xae, 0x2b, 0xa2, 0xef, 0xa0, 0xaa, 0xc2, 0x7c, 0x35, 0x59, 0xea, 0x9f, 0x25,
-	0x0b, 0x2e, 0x10, 0x8b, 0x79, 0x77, 0x51, 0xd3, 0x7e, 0x01, 0x6b, 0xe3, 0xe3, 0x8b, 0x6c, 0x58,
-	0x71, 0x8e, 0x3b, 0x8f, 0xe9, 0x55, 0xe6, 0x59, 0x88, 0x23, 0x2b, 0x43, 0x70, 0xf6, 0x4c, 0xae,
-	0xcf, 0x0e, 0xf3, 0x38, 0x75, 0x5b, 0x81, 0x97, 0x9d, 0x2a, 0x75, 0x7d, 0x8e, 0x41, 0x3c, 0x19,
-	0xda, 0xd7, 0x65, 0xb8, 0x9f, 0x96, 0xb7, 0x21, 0x06, 0xc3, 0x50, 0xaa, 0xc6, 0xa8, 0x5e, 0xf5,
-	0x93, 0x01, 0xf7, 0xb2, 0xc2, 0x9f, 0x88, 0x43, 0x16, 0x48, 0x9f, 0x75, 0x43, 0x99, 0xf7, 0x83,
-	0x17, 0xc9, 0x8d, 0x31, 0x87, 0x5e, 0x4c, 0x0a, 0xe6, 0x29, 0x4f, 0x97, 0xbf, 0xfc, 0xb6, 0xca,
-	0x5f, 0x07, 0x98, 0xf9, 0x28, 0x4c, 0xbb, 0xfa, 0xe4, 0x6a, 0xd3, 0xc6, 0x4e, 0xfb, 0xfa, 0xd6,
-	0x2c, 0xdd, 0xdc, 0x9a, 0xa5, 0xd7, 0xb7, 0xa6, 0xf1, 0xfd, 0xc8, 0x34, 0x7e, 0x1d, 0x99, 0xc6,
-	0xef, 0x23, 0xd3, 0xb8, 0x1e, 0x99, 0xc6, 0xcd, 0xc8, 0x34, 0xfe, 0x1a, 0x99, 0xc6, 0xdf, 0x23,
-	0xb3, 0xf4, 0x7a, 0x64, 0x1a, 0x3f, 0xdf, 0x99, 0xa5, 0xeb, 0x3b, 0xb3, 0x74, 0x73, 0x67, 0x96,
-	0x9e, 0xef, 0x04, 0x57, 0x81, 0xa4, 0x83, 0xce, 0x80, 0xf8, 0x72, 0xfc, 0x29, 0xdb, 0x5d, 0x51,
-	0x3d, 0xfc, 0xd3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x84, 0xb3, 0x15, 0x70, 0x0b, 0x00,
-	0x00,
+	0x14, 0xb6, 0x1c, 0x27, 0x9b, 0x4c, 0xe2, 0xd4, 0x99, 0xcd, 0xb6, 0xa2, 0x2c, 0x52, 0x10, 0x14,
+	0x02, 0x65, 0x1d, 0xb6, 0x2d, 0x14, 0xda, 0xcb, 0x46, 0x4e, 0x52, 0xcc, 0x26, 0xde, 0x32, 0xce,
+	0xee, 0xd2, 0x65, 0x2f, 0x63, 0x6b, 0xa2, 0x0c, 0xb1, 0x67, 0x8c, 0x34, 0x4e, 0x36, 0xd0, 0x43,
+	0x2f, 0x85, 0xf6, 0x52, 0xfa, 0x0f, 0x7a, 0x2d, 0xfd, 0x1f, 0x85, 0x1e, 0x43, 0x4f, 0x39, 0xa9,
+	0x8d, 0x73, 0x29, 0xa2, 0x87, 0xa5, 0xf4, 0x07, 0x94, 0x19, 0x49, 0xd6, 0xc8, 0xf6, 0x5e, 0x8a,
+	0xd9, 0x8b, 0xf5, 0xde, 0xf7, 0x3c, 0x9f, 0xde, 0x7c, 0xef, 0xcd, 0x1b, 0x81, 0x9a, 0x47, 0x7a,
+	0xc4, 0xc7, 0x82, 0x72, 0x56, 0x1f, 0x04, 0x5c, 0x70, 0xb8, 0xa8, 0x1e, 0xef, 0x3f, 0xf0, 0xa9,
+	0x38, 0x1d, 0x76, 0xea, 0x5d, 0xde, 0xdf, 0xf1, 0xb9, 0xcf, 0x77, 0x14, 0xdc, 0x19, 0x9e, 0x28,
+	0x4f, 0x39, 0xca, 0x4a, 0x56, 0x39, 0xff, 0x2e, 0x80, 0xcd, 0xbd, 0x31, 0xd5, 0x11, 0x66, 0xd8,
+	0x27, 0x7d, 0xc2, 0x04, 0xfc, 0x0c, 0xac, 0xb7, 0x86, 0xfd, 0x27, 0x27, 0x0d, 0xce, 0x44, 0x80,
+	0xbb, 0x22, 0x34, 0x8d, 0x2d, 0x63, 0xbb, 0xea, 0xc2, 0x38, 0xb2, 0x27, 0x22, 0x68, 0xc2, 0x87,
+	0x0f, 0xc1, 0xea, 0x21, 0x0e, 0xc5, 0xae, 0xe7, 0x05, 0x24, 0x0c, 0xcd, 0xf2, 0x96, 0xb1, 0xbd,
+	0xe6, 0xbe, 0x13, 0x47, 0xb6, 0x0e, 0x23, 0xdd, 0x81, 0x9f, 0x82, 0xea, 0x11, 0x65, 0x6d, 0x12,
+	0x9c, 0xd3, 0x2e, 0x39, 0x20, 0xc4, 0x5c, 0xd8, 0x32, 0xb6, 0x2b, 0xee, 0x46, 0x1c, 0xd9, 0xc5,
+	0x00, 0x2a, 0xba, 0x6a, 0x21, 0x7e, 0xa5, 0x2d, 0xac, 0x68, 0x0b, 0xf5, 0x00, 0x2a, 0xba, 0xf0,
+	0x5b, 0x03, 0xd4, 0x5c, 0x1c, 0x92, 0x66, 0x18, 0x0e, 0x09, 0x65, 0x7e, 0x83, 0x87, 0xc2, 0x5c,
+	0x54, 0xa9, 0x7e, 0x15, 0x47, 0xf6, 0x54, 0xec, 0x97, 0x3f, 0xec, 0xdd, 0x3e, 0x16, 0xa7, 0x3b,
+	0x1d, 0xea, 0xd7, 0x9b, 0x4c, 0x7c, 0xae, 0x09, 0xbd, 0xdf, 0x0b, 0x38, 0xf3, 0x5a, 0x44, 0x5c,
+	0xf0, 0xe0, 0x6c, 0x87, 0x28, 0xef, 0x81, 0xcf, 0x77, 0x3c, 0x2c, 0x70, 0xdd, 0xa5, 0x7e, 0x93,
+	0x89, 0x06, 0x0e, 0x05, 0x09, 0xd0, 0x14, 0x2d, 0x0c, 0x01, 0x38, 0xa2, 0x6c, 0x8f, 0x0c, 0x78,
+	0x48, 0x85, 0xb9, 0xa4, 0x12, 0x68, 0xc7, 0x91, 0xad, 0xa1, 0xf3, 0x79, 0xb5, 0x46, 0xe8, 0xec,
+	0x83, 0x77, 0xf3, 0xaa, 0x67, 0x85, 0x3b, 0xa4, 0xa1, 0x80, 0x1f, 0x82, 0x95, 0xb4, 0x26, 0x44,
+	0x96, 0x7c, 0x61, 0x7b, 0xcd, 0xad, 0xc6, 0x91, 0x9d, 0x83, 0x28, 0x37, 0x9d, 0xbf, 0x2b, 0xa0,
+	0x56, 0xe0, 0x39, 0xa1, 0xbe, 0x12, 0xf6, 0x08, 0xbf, 0xd2, 0x70, 0x3c, 0x30, 0x8d, 0x5c, 0xd8,
+	0xc9, 0xd8, 0x9c, 0x84, 0x9d, 0xa4, 0x85, 0xdf, 0x19, 0x60, 0xa3, 0xc9, 0xa8, 0xa0, 0xb8, 0xf7,
+	0xe4, 0x82, 0x91, 0xe0, 0x60, 0xc8, 0xbc, 0xac, 0x19, 0x5f, 0xc4, 0x91, 0x3d, 0x1d, 0x9c, 0x4f,
+	0x26, 0xd3, 0xbc, 0xb0, 0x09, 0xee, 0xee, 0x0e, 0x05, 0xef, 0x63, 0x41, 0xbb, 0xbb, 0x5d, 0x41,
+	0xcf, 0x55, 0x92, 0xaa, 0xc7, 0x97, 0xdd, 0xf7, 0xe2, 0xc8, 0x9e, 0x15, 0x46, 0xb3, 0x40, 0x78,
+	0x08, 0x36, 0x1b, 0xa7, 0x98, 0xf9, 0x04, 0x77, 0x7a, 0x64, 0xa2, 0xed, 0x97, 0x5d, 0x33, 0x8e,
+	0xec, 0x99, 0x71, 0x34, 0x13, 0x85, 0x9f, 0x80, 0xb5, 0x46, 0x40, 0xb0, 0x20, 0x5e, 0x8b, 0xb3,
+	0x2e, 0x51, 0xfd, 0x5f, 0x71, 0x6b, 0x71, 0x64, 0x17, 0x70, 0x54, 0xf0, 0xe4, 0xaa, 0xa7, 0xcc,
+	0xe5, 0xcc, 0xfb, 0x92, 0x04, 0x94, 0x7b, 0xe6, 0x52, 0xbe, 0x4a, 0xc7, 0x51, 0xc1, 0x83, 0x8f,
+	0x40, 0xed, 0xf9, 0x29, 0x15, 0xa4, 0x47, 0x43, 0xb1, 0xcf, 0x64, 0x22, 0x9e, 0x79, 0x47, 0x65,
+	0xbd, 0x29, 0xdb, 0x62, 0x32, 0x86, 0xa6, 0x10, 0xe7, 0xa7, 0x32, 0x30, 0xa7, 0xdb, 0xb6, 0x2d,
+	0xb0, 0x18, 0x86, 0xb0, 0x0e, 0x40, 0x1a, 0xe3, 0x41, 0xd6, 0xb9, 0xeb, 0xf2, 0x1c, 0xe5, 0x28,
+	0xd2, 0x6c, 0xf8, 0x08, 0x80, 0xb6, 0xc0, 0x67, 0xc4, 0x7b, 0x4c, 0x2e, 0x65, 0x5b, 0x2c, 0x6c,
+	0xaf, 0x7e, 0x54, 0x4b, 0xa6, 0x62, 0xbd, 0xc5, 0x3d, 0x12, 0xee, 0x61, 0x81, 0x13, 0x86, 0xfc,
+	0x7f, 0x48, 0xb3, 0x61, 0x13, 0x54, 0x5b, 0x5c, 0x68, 0x24, 0x0b, 0x6f, 0x20, 0x51, 0xc3, 0xa8,
+	0xf0, 0x57, 0x54, 0x74, 0xe1, 0x81, 0x54, 0x54, 0x63, 0xaa, 0xbc, 0x81, 0x29, 0xd5, 0x58, 0x23,
+	0x2a, 0x78, 0xce, 0xef, 0x06, 0xa8, 0xc8, 0x96, 0x83, 0x1e, 0x58, 0x7c, 0x86, 0x7b, 0x43, 0x92,
+	0x1e, 0xbc, 0x56, 0x1c, 0xd9, 0x09, 0x30, 0x9f, 0x1e, 0x4f, 0xb8, 0xe0, 0x07, 0xe0, 0x4e, 0x71,
+	0xc8, 0xaf, 0xc6, 0x91, 0x9d, 0x41, 0x28, 0x33, 0xa0, 0x0d, 0x16, 0x93, 0xf6, 0x4a, 0x86, 0xfa,
+	0x8a, 0x4c, 0x26, 0xe9, 0xab, 0xe4, 0x01, 0xef, 0x83, 0xca, 0xf1, 0xe5, 0x20, 0x69, 0xe2, 0xaa,
+	0xbb, 0x1c, 0x47, 0xb6, 0xf2, 0x91, 0xfa, 0x75, 0x7e, 0x2d, 0x83, 0xea, 0xb8, 0x70, 0x52, 0x06,
+	0x59, 0x6b, 0x75, 0x24, 0x88, 0xdc, 0x6b, 0xba, 0x45, 0x55, 0xa9, 0x1c, 0x45, 0x9a, 0x2d, 0x2f,
+	0x89, 0x4c, 0xa6, 0x6c, 0x0a, 0xc8, 0xf6, 0x50, 0x75, 0x29, 0x04, 0x50, 0xd1, 0x85, 0x0d, 0xb0,
+	0x81, 0xc8, 0x05, 0x0e, 0xbc, 0xb0, 0x71, 0x4a, 0xba, 0x67, 0x03, 0x4e, 0x99, 0x50, 0xbb, 0xa8,
+	0xba, 0xf7, 0xe4, 0x08, 0x99, 0x0a, 0xa2, 0x69, 0x48, 0x0d, 0xc4, 0xa7, 0xac, 0xd1, 0xc3, 0xb4,
+	0x4f, 0xbc, 0x34, 0x6c, 0x56, 0xf2, 0x81, 0x38, 0x19, 0x9b, 0xd3, 0x40, 0x9c, 0xa4, 0x75, 0xfe,
+	0x29, 0x83, 0xf5, 0x2f, 0x7a, 0xbc, 0x83, 0x7b, 0x72, 0x73, 0x4a, 0xc8, 0x87, 0x60, 0x35, 0x97,
+	0x29, 0x3b, 0x35, 0xea, 0xa6, 0xd6, 0x60, 0xa4, 0x3b, 0xff, 0x5f, 0xcb, 0x73, 0xb0, 0x7a, 0xcc,
+	0x05, 0xee, 0x25, 0x64, 0x4a, 0xc5, 0x35, 0xf7, 0x58, 0xbe, 0x4b, 0x83, 0xe7, 0xb3, 0x77, 0x9d,
+	0x11, 0x7e, 0x0d, 0xaa, 0xca, 0xcd, 0xb2, 0x49, 0xa5, 0x7f, 0x26, 0x13, 0x2e, 0x04, 0xe6, 0xf3,
+	0xee, 0x22, 0xa7, 0xf3, 0x12, 0xac, 0x8c, 0x8f, 0x2f, 0x74, 0xc0, 0x92, 0x7b, 0xd8, 0x7e, 0x4c,
+	0x2e, 0xd3, 0x9e, 0x05, 0x71, 0x64, 0xa7, 0x08, 0x4a, 0x9f, 0xf2, 0x02, 0x6e, 0x53, 0x9f, 0x11,
+	0xef, 0x28, 0xf4, 0xd3, 0x53, 0xa5, 0x2e, 0xe0, 0x31, 0x88, 0x72, 0xd3, 0xb9, 0x2a, 0x83, 0x7b,
+	0x49, 0x79, 0x1b, 0xbc, 0x3f, 0x18, 0x0a, 0x35, 0x18, 0xd5, 0xab, 0x7e, 0x30, 0xc0, 0xdd, 0xb4,
+	0xf0, 0xc7, 0x7c, 0x8f, 0x86, 0x22, 0xa0, 0x9d, 0xa1, 0xc8, 0xe6, 0xc1, 0x4b, 0x79, 0xe7, 0xcc,
+	0x08, 0xcf, 0x47, 0x82, 0x59, 0xcc, 0x93, 0xe5, 0x2f, 0xbf, 0xad, 0xf2, 0xd7, 0x01, 0x98, 0xfa,
+	0xac, 0x4c, 0xa6, 0xfa, 0x18, 0x45, 0x9a, 0xed, 0x7c, 0x6f, 0x00, 0xa8, 0x7d, 0x11, 0x13, 0x81,
+	0x95, 0x9e, 0xf7, 0x41, 0xa5, 0x85, 0xfb, 0x99, 0x7e, 0x6a, 0x44, 0x49, 0x1f, 0xa9, 0x5f, 0x39,
+	0x08, 0x9f, 0x93, 0x4e, 0x48, 0x05, 0xd1, 0x07, 0x61, 0x0a, 0xa1, 0xcc, 0x90, 0xb9, 0x34, 0x3d,
+	0xc2, 0x04, 0x3d, 0xa1, 0x24, 0x48, 0x4f, 0x80, 0xca, 0x25, 0x47, 0x91, 0x66, 0xbb, 0xad, 0xab,
+	0x1b, 0xab, 0x74, 0x7d, 0x63, 0x95, 0x5e, 0xdf, 0x58, 0xc6, 0x37, 0x23, 0xcb, 0xf8, 0x79, 0x64,
+	0x19, 0xbf, 0x8d, 0x2c, 0xe3, 0x6a, 0x64, 0x19, 0xd7, 0x23, 0xcb, 0xf8, 0x73, 0x64, 0x19, 0x7f,
+	0x8d, 0xac, 0xd2, 0xeb, 0x91, 0x65, 0xfc, 0x78, 0x6b, 0x95, 0xae, 0x6e, 0xad, 0xd2, 0xf5, 0xad,
+	0x55, 0x7a, 0xb1, 0x19, 0x5e, 0x86, 0x82, 0xf4, 0xdb, 0x7d, 0x1c, 0x88, 0xf1, 0x87, 0x79, 0x67,
+	0x49, 0xdd, 0x27, 0x1f, 0xff, 0x37, 0x00, 0x43, 0x27, 0xe6, 0xb0, 0x3e, 0x0c, 0x00, 0x00,
 }
 
 func (this *DelegationManagement) Equal(that interface{}) bool {
@@ -779,6 +847,9 @@ func (this *DelegationConfig) Equal(that interface{}) bool {
 	if this.UnBondPeriod != that1.UnBondPeriod {
 		return false
 	}
+	if this.WhitelistEnabled != that1.WhitelistEnabled {
+		return false
+	}
 	return true
 }
 func (this *DelegationContractStatus) Equal(that interface{}) bool {
@@ -1023,6 +1094,36 @@ func (this *RewardComputationData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegationMetaData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationMetaData)
+	if !ok {
+		that2, ok := that.(DelegationMetaData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Name, that1.Name) {
+		return false
+	}
+	if !bytes.Equal(this.Website, that1.Website) {
+		return false
+	}
+	if !bytes.Equal(this.Identifier, that1.Identifier) {
+		return false
+	}
+	return true
+}
 func (this *DelegationManagement) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1052,7 +1153,7 @@ func (this *DelegationConfig) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&systemSmartContracts.DelegationConfig{")
 	s = append(s, "MaxDelegationCap: "+fmt.Sprintf("%#v", this.MaxDelegationCap)+",\n")
 	s = append(s, "InitialOwnerFunds: "+fmt.Sprintf("%#v", this.InitialOwnerFunds)+",\n")
@@ -1060,6 +1161,7 @@ func (this *DelegationConfig) GoString() string {
 	s = append(s, "ChangeableServiceFee: "+fmt.Sprintf("%#v", this.ChangeableServiceFee)+",\n")
 	s = append(s, "CreatedNonce: "+fmt.Sprintf("%#v", this.CreatedNonce)+",\n")
 	s = append(s, "UnBondPeriod: "+fmt.Sprintf("%#v", this.UnBondPeriod)+",\n")
+	s = append(s, "WhitelistEnabled: "+fmt.Sprintf("%#v", this.WhitelistEnabled)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1144,6 +1246,18 @@ func (this *RewardComputationData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DelegationMetaData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&systemSmartContracts.DelegationMetaData{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Website: "+fmt.Sprintf("%#v", this.Website)+",\n")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringDelegation(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1271,6 +1385,16 @@ func (m *DelegationConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WhitelistEnabled {
+		i--
+		if m.WhitelistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.UnBondPeriod != 0 {
 		i = encodeVarintDelegation(dAtA, i, uint64(m.UnBondPeriod))
 		i--
@@ -1656,6 +1780,50 @@ func (m *RewardComputationData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegationMetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationMetaData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationMetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDelegation(v)
 	base := offset
@@ -1742,6 +1910,9 @@ func (m *DelegationConfig) Size() (n int) {
 	if m.UnBondPeriod != 0 {
 		n += 1 + sovDelegation(uint64(m.UnBondPeriod))
 	}
+	if m.WhitelistEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1899,6 +2070,27 @@ func (m *RewardComputationData) Size() (n int) {
 	return n
 }
 
+func (m *DelegationMetaData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
 func sovDelegation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1941,6 +2133,7 @@ func (this *DelegationConfig) String() string {
 		`ChangeableServiceFee:` + fmt.Sprintf("%v", this.ChangeableServiceFee) + `,`,
 		`CreatedNonce:` + fmt.Sprintf("%v", this.CreatedNonce) + `,`,
 		`UnBondPeriod:` + fmt.Sprintf("%v", this.UnBondPeriod) + `,`,
+		`WhitelistEnabled:` + fmt.Sprintf("%v", this.WhitelistEnabled) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2035,6 +2228,18 @@ func (this *RewardComputationData) String() string {
 	}, "")
 	return s
 }
+func (this *DelegationMetaData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelegationMetaData{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Website:` + fmt.Sprintf("%v", this.Website) + `,`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringDelegation(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2531,6 +2736,26 @@ func (m *DelegationConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WhitelistEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegation(dAtA[iNdEx:])
@@ -3543,6 +3768,161 @@ func (m *RewardComputationData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegationMetaData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationMetaData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationMetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = append(m.Website[:0], dAtA[iNdEx:postIndex]...)
+			if m.Website == nil {
+				m.Website = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = append(m.Identifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.Identifier == nil {
+				m.Identifier = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDelegation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1558,6 +1558,13 @@ func TestDelegationSystemSC_ExecuteDelegate(t *testing.T) {
 
 	_, dData, _ := d.getOrCreateDelegatorData(delegator1)
 	assert.Equal(t, fundKey, dData.ActiveFund)
+
+	logs := eei.CreateVMOutput().Logs
+	require.True(t, len(logs) > 0)
+	delegateLog := logs[len(logs)-1]
+	assert.Equal(t, []byte("delegate"), delegateLog.Identifier)
+	assert.Equal(t, vmInput.RecipientAddr, delegateLog.Address)
+	assert.Equal(t, [][]byte{delegator1, big.NewInt(15).Bytes()}, delegateLog.Topics)
 }
 
 func TestDelegationSystemSC_ExecuteUnDelegateUserErrors(t *testing.T) {
@@ -2282,6 +2289,250 @@ func TestDelegation_ExecuteClaimRewards(t *testing.T) {
 	assert.Equal(t, uint32(3), delegatorData.RewardsCheckpoint)
 }
 
+func TestDelegation_ExecuteReDelegateRewardsUserErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+
+	vmInput := getDefaultVmInputForFunc("reDelegateRewards", [][]byte{{10}})
+	d, _ := NewDelegationSystemSC(args)
+
+	vmInput.CallValue = big.NewInt(10)
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrCallValueMustBeZero.Error()))
+
+	vmInput.CallValue = big.NewInt(0)
+	d.gasCost.MetaChainSystemSCsCost.DelegationOps = 10
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.OutOfGas, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrNotEnoughGas.Error()))
+
+	d.gasCost.MetaChainSystemSCsCost.DelegationOps = 0
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "wrong number of arguments"))
+
+	vmInput.Arguments = [][]byte{}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "caller is not a delegator"))
+
+	fundKey := append([]byte(fundKeyPrefix), []byte{1}...)
+	_ = d.saveDelegatorData(vmInput.CallerAddr, &DelegatorData{
+		ActiveFund:        fundKey,
+		RewardsCheckpoint: 0,
+		UnClaimedRewards:  big.NewInt(0),
+	})
+	_ = d.saveFund(fundKey, &Fund{
+		Value: big.NewInt(1000),
+	})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "rewards to redelegate must be higher than minDelegationAmount"))
+
+	_ = d.saveDelegatorData(vmInput.CallerAddr, &DelegatorData{
+		ActiveFund:        fundKey,
+		RewardsCheckpoint: 0,
+		UnClaimedRewards:  big.NewInt(5),
+	})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "rewards to redelegate must be higher than minDelegationAmount"))
+
+	_, delegatorData, _ := d.getOrCreateDelegatorData(vmInput.CallerAddr)
+	assert.Equal(t, big.NewInt(5), delegatorData.UnClaimedRewards)
+}
+
+func TestDelegation_ExecuteReDelegateRewards(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return 2
+			},
+		},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	addValidatorAndStakingScToVmContext(eei)
+
+	vmInput := getDefaultVmInputForFunc("reDelegateRewards", [][]byte{})
+	vmInput.CallerAddr = []byte("delegator")
+	d, _ := NewDelegationSystemSC(args)
+
+	fundKey := append([]byte(fundKeyPrefix), []byte{1}...)
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(1100),
+		InitialOwnerFunds: big.NewInt(1000),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		ActiveFunds: [][]byte{fundKey},
+		TotalActive: big.NewInt(1000),
+	})
+	_ = d.saveDelegatorData(vmInput.CallerAddr, &DelegatorData{
+		ActiveFund:        fundKey,
+		RewardsCheckpoint: 0,
+		UnClaimedRewards:  big.NewInt(0),
+	})
+	_ = d.saveFund(fundKey, &Fund{
+		Value: big.NewInt(1000),
+	})
+	_ = d.saveRewardData(0, &RewardComputationData{
+		RewardsToDistribute: big.NewInt(100),
+		TotalActive:         big.NewInt(1000),
+		ServiceFee:          10000,
+	})
+	_ = d.saveRewardData(1, &RewardComputationData{
+		RewardsToDistribute: big.NewInt(100),
+		TotalActive:         big.NewInt(2000),
+		ServiceFee:          10000,
+	})
+
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "total delegation cap reached, no more space to accept"))
+
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(0),
+		InitialOwnerFunds: big.NewInt(1000),
+	})
+	_ = d.saveDelegatorData(vmInput.CallerAddr, &DelegatorData{
+		ActiveFund:        fundKey,
+		RewardsCheckpoint: 0,
+		UnClaimedRewards:  big.NewInt(0),
+	})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	dFund, _ := d.getFund(fundKey)
+	assert.Equal(t, big.NewInt(1135), dFund.Value)
+
+	dGlobalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(1135), dGlobalFund.TotalActive)
+
+	_, delegatorData, _ := d.getOrCreateDelegatorData(vmInput.CallerAddr)
+	assert.Equal(t, uint32(3), delegatorData.RewardsCheckpoint)
+	assert.Equal(t, big.NewInt(0), delegatorData.UnClaimedRewards)
+}
+
+func TestDelegation_ExecuteReDelegateRewardsAndWhitelistBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	args.DelegationSCConfig.ReDelegateRewardsEnableEpoch = 1
+	args.DelegationSCConfig.WhitelistEnableEpoch = 1
+	d, _ := NewDelegationSystemSC(args)
+
+	for _, function := range []string{"reDelegateRewards", "setWhitelistMode", "addToWhitelist", "removeFromWhitelist", "isWhitelisted"} {
+		eei.returnMessage = ""
+		vmInput := getDefaultVmInputForFunc(function, [][]byte{})
+		output := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.True(t, strings.Contains(eei.returnMessage, function+"is an unknown function"))
+	}
+}
+
+func TestDelegation_ExecuteDelegateWithWhitelist(t *testing.T) {
+	t.Parallel()
+
+	delegator1 := bytes.Repeat([]byte{1}, 5)
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	addValidatorAndStakingScToVmContext(eei)
+
+	d, _ := NewDelegationSystemSC(args)
+	ownerAddr := []byte("owner")
+	d.eei.SetStorage([]byte(ownerKey), ownerAddr)
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(0),
+		InitialOwnerFunds: big.NewInt(100),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive: big.NewInt(0),
+	})
+
+	vmInput := getDefaultVmInputForFunc("setWhitelistMode", [][]byte{[]byte("yes")})
+	vmInput.CallerAddr = delegator1
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only owner can call this method"))
+
+	vmInput.CallerAddr = ownerAddr
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	delegateInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+	delegateInput.CallValue = big.NewInt(15)
+	delegateInput.CallerAddr = delegator1
+	output = d.Execute(delegateInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "caller is not whitelisted to delegate"))
+
+	vmInput = getDefaultVmInputForFunc("addToWhitelist", [][]byte{[]byte("a")})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid address to whitelist"))
+
+	vmInput = getDefaultVmInputForFunc("addToWhitelist", [][]byte{delegator1})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("isWhitelisted", [][]byte{delegator1})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{[]byte("true")}, eei.output)
+
+	output = d.Execute(delegateInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmInput = getDefaultVmInputForFunc("removeFromWhitelist", [][]byte{delegator1})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = d.Execute(delegateInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "caller is not whitelisted to delegate"))
+
+	vmInput = getDefaultVmInputForFunc("setWhitelistMode", [][]byte{[]byte("no")})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	output = d.Execute(delegateInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
 func TestDelegation_ExecuteGetRewardDataUserErrors(t *testing.T) {
 	t.Parallel()
 
@@ -3280,7 +3531,7 @@ func TestDelegation_ExecuteGetContractConfig(t *testing.T) {
 
 	output := d.Execute(vmInput)
 	assert.Equal(t, vmcommon.Ok, output)
-	require.Equal(t, 9, len(eei.output))
+	require.Equal(t, 10, len(eei.output))
 	assert.Equal(t, ownerAddress, eei.output[0])
 	assert.Equal(t, big.NewInt(0).SetUint64(serviceFee), big.NewInt(0).SetBytes(eei.output[1]))
 	assert.Equal(t, maxDelegationCap, big.NewInt(0).SetBytes(eei.output[2]))
@@ -3290,6 +3541,7 @@ func TestDelegation_ExecuteGetContractConfig(t *testing.T) {
 	assert.Equal(t, []byte("true"), eei.output[6])
 	assert.Equal(t, big.NewInt(0).SetUint64(createdNonce), big.NewInt(0).SetBytes(eei.output[7]))
 	assert.Equal(t, big.NewInt(0).SetUint64(unBondPeriod), big.NewInt(0).SetBytes(eei.output[8]))
+	assert.Equal(t, []byte("false"), eei.output[9])
 }
 
 func TestDelegation_ExecuteUnknownFunc(t *testing.T) {
@@ -3392,3 +3644,79 @@ func TestDelegation_computeAndUpdateRewardsWithTotalActiveZeroSendsAllRewardsToO
 	assert.Nil(t, err)
 	assert.Equal(t, rewards, dData.UnClaimedRewards)
 }
+
+func TestDelegation_ExecuteSetAndGetMetaDataBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+	args.DelegationSCConfig.MetaDataEnableEpoch = 1
+	d, _ := NewDelegationSystemSC(args)
+
+	for _, function := range []string{"setMetaData", "getMetaData"} {
+		eei.returnMessage = ""
+		vmInput := getDefaultVmInputForFunc(function, [][]byte{})
+		output := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.True(t, strings.Contains(eei.returnMessage, function+"is an unknown function"))
+	}
+}
+
+func TestDelegation_ExecuteSetAndGetMetaData(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	args.Eei = eei
+
+	d, _ := NewDelegationSystemSC(args)
+	ownerAddr := []byte("ownerAddress")
+	eei.SetStorage([]byte(ownerKey), ownerAddr)
+
+	vmInput := getDefaultVmInputForFunc("getMetaData", [][]byte{})
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{nil, nil, nil}, eei.output)
+
+	name := []byte("staking provider")
+	website := []byte("https://staking.provider")
+	identifier := []byte("provider")
+	vmInput = getDefaultVmInputForFunc("setMetaData", [][]byte{name, website, identifier})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only owner can call this method"))
+
+	vmInput.CallerAddr = ownerAddr
+	vmInput.Arguments = [][]byte{name, website}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid number of arguments"))
+
+	vmInput.Arguments = [][]byte{name, website, bytes.Repeat([]byte{'a'}, maxMetaDataIdentifierLength+1)}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "name, website and identifier can have at most"))
+
+	vmInput.Arguments = [][]byte{name, website, identifier}
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getMetaData", [][]byte{})
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{name, website, identifier}, eei.output)
+}
@@ -26,6 +26,7 @@ type vmContext struct {
 
 	returnMessage string
 	output        [][]byte
+	logs          []*vmcommon.LogEntry
 }
 
 // NewVMContext creates a context where smart contracts can run and write
@@ -245,6 +246,7 @@ func (host *vmContext) copyToNewContext() *vmContext {
 		storageUpdate:  host.storageUpdate,
 		outputAccounts: host.outputAccounts,
 		output:         host.output,
+		logs:           host.logs,
 		scAddress:      host.scAddress,
 	}
 
@@ -253,6 +255,7 @@ func (host *vmContext) copyToNewContext() *vmContext {
 
 func (host *vmContext) copyFromContext(currContext *vmContext) {
 	host.output = append(host.output, currContext.output...)
+	host.logs = append(currContext.logs, host.logs...)
 	host.AddReturnMessage(currContext.returnMessage)
 
 	for key, storageUpdate := range currContext.storageUpdate {
@@ -427,6 +430,15 @@ func (host *vmContext) Finish(value []byte) {
 	host.output = append(host.output, value)
 }
 
+// AddLogEntry will add a log entry to the current output
+func (host *vmContext) AddLogEntry(entry *vmcommon.LogEntry) {
+	if entry == nil {
+		return
+	}
+
+	host.logs = append(host.logs, entry)
+}
+
 // AddReturnMessage will set the return message
 func (host *vmContext) AddReturnMessage(message string) {
 	if message == "" {
@@ -456,6 +468,7 @@ func (host *vmContext) CleanCache() {
 	host.storageUpdate = make(map[string]map[string][]byte)
 	host.outputAccounts = make(map[string]*vmcommon.OutputAccount)
 	host.output = make([][]byte, 0)
+	host.logs = make([]*vmcommon.LogEntry, 0)
 	host.returnMessage = ""
 	host.gasRemaining = 0
 }
@@ -482,6 +495,7 @@ func (host *vmContext) GasLeft() uint64 {
 func (host *vmContext) softCleanCache() {
 	host.outputAccounts = make(map[string]*vmcommon.OutputAccount)
 	host.output = make([][]byte, 0)
+	host.logs = make([]*vmcommon.LogEntry, 0)
 	host.returnMessage = ""
 }
 
@@ -551,6 +565,10 @@ func (host *vmContext) CreateVMOutput() *vmcommon.VMOutput {
 		vmOutput.ReturnData = append(vmOutput.ReturnData, host.output...)
 	}
 
+	if len(host.logs) > 0 {
+		vmOutput.Logs = append(vmOutput.Logs, host.logs...)
+	}
+
 	return vmOutput
 }
 
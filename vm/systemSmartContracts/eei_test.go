@@ -276,3 +276,29 @@ func TestVmContext_IsValidator(t *testing.T) {
 		assert.Equal(t, tio.expectedResult, vmCtx.IsValidator(blsKey))
 	}
 }
+
+func TestVmContext_AddLogEntry(t *testing.T) {
+	t.Parallel()
+
+	vmCtx, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	entry := &vmcommon.LogEntry{
+		Identifier: []byte("identifier"),
+		Address:    []byte("address"),
+		Topics:     [][]byte{[]byte("topic")},
+	}
+	vmCtx.AddLogEntry(nil)
+	vmCtx.AddLogEntry(entry)
+
+	vmOutput := vmCtx.CreateVMOutput()
+	assert.Equal(t, []*vmcommon.LogEntry{entry}, vmOutput.Logs)
+
+	vmCtx.CleanCache()
+	vmOutput = vmCtx.CreateVMOutput()
+	assert.Equal(t, 0, len(vmOutput.Logs))
+}
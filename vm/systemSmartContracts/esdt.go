@@ -37,6 +37,7 @@ const canFreeze = "canFreeze"
 const canWipe = "canWipe"
 const canChangeOwner = "canChangeOwner"
 const upgradable = "canUpgrade"
+const transferRestricted = "transferRestricted"
 
 const conversionBase = 10
 
@@ -51,6 +52,12 @@ type esdt struct {
 	hasher                 hashing.Hasher
 	enabledEpoch           uint32
 	flagEnabled            atomic.Flag
+	nftEnableEpoch         uint32
+	flagNFT                atomic.Flag
+	rolesEnableEpoch       uint32
+	flagRoles              atomic.Flag
+	limitedTransferEpoch   uint32
+	flagLimitedTransfer    atomic.Flag
 	mutExecution           sync.RWMutex
 	addressPubKeyConverter core.PubkeyConverter
 }
@@ -100,6 +107,9 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		hasher:                 args.Hasher,
 		marshalizer:            args.Marshalizer,
 		enabledEpoch:           args.ESDTSCConfig.EnabledEpoch,
+		nftEnableEpoch:         args.ESDTSCConfig.NFTEnableEpoch,
+		rolesEnableEpoch:       args.ESDTSCConfig.SpecialRolesEnableEpoch,
+		limitedTransferEpoch:   args.ESDTSCConfig.LimitedTransferEnableEpoch,
 		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 	}
@@ -129,6 +139,16 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	switch args.Function {
 	case "issue":
 		return e.issue(args)
+	case "issueNonFungible":
+		if !e.flagNFT.IsSet() {
+			break
+		}
+		return e.issueNonFungible(args)
+	case "issueSemiFungible":
+		if !e.flagNFT.IsSet() {
+			break
+		}
+		return e.issueSemiFungible(args)
 	case core.BuiltInFunctionESDTBurn:
 		return e.burn(args)
 	case "mint":
@@ -155,6 +175,16 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.getAllESDTTokens(args)
 	case "getTokenProperties":
 		return e.getTokenProperties(args)
+	case "setSpecialRole":
+		if !e.flagRoles.IsSet() {
+			break
+		}
+		return e.setSpecialRole(args)
+	case "unSetSpecialRole":
+		if !e.flagRoles.IsSet() {
+			break
+		}
+		return e.unSetSpecialRole(args)
 	}
 
 	e.eei.AddReturnMessage("invalid method to call")
@@ -201,15 +231,131 @@ func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return vmcommon.OutOfFunds
 	}
 
-	err = e.issueToken(args.CallerAddr, args.Arguments)
+	tokenIdentifier, err := e.issueToken(args.CallerAddr, args.Arguments)
 	if err != nil {
 		e.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
 
+	e.eei.AddLogEntry(&vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.RecipientAddr,
+		Topics:     [][]byte{tokenIdentifier, args.CallerAddr, args.Arguments[0], args.Arguments[1], args.Arguments[2]},
+	})
+
 	return vmcommon.Ok
 }
 
+// the NFT create role is not given to the owner on issue as it can not be moved afterwards: the owner chooses its
+// single holder through setSpecialRole
+func (e *esdt) issueNonFungible(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	roles := [][]byte{[]byte(core.ESDTRoleNFTBurn)}
+	return e.issueNFT(args, core.NonFungibleESDT, roles)
+}
+
+func (e *esdt) issueSemiFungible(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	roles := [][]byte{[]byte(core.ESDTRoleNFTAddQuantity), []byte(core.ESDTRoleNFTBurn)}
+	return e.issueNFT(args, core.SemiFungibleESDT, roles)
+}
+
+func (e *esdt) issueNFT(args *vmcommon.ContractCallInput, tokenType string, roles [][]byte) vmcommon.ReturnCode {
+	if len(args.Arguments) < 2 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[0]) < minLengthForTickerName ||
+		len(args.Arguments[0]) > int(esdtConfig.MaxTokenNameLength) {
+		e.eei.AddReturnMessage("token name length not in parameters")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
+		e.eei.AddReturnMessage("callValue not equals with baseIssuingCost")
+		return vmcommon.OutOfFunds
+	}
+
+	tokenIdentifier, err := e.issueNFTToken(args.CallerAddr, args.Arguments, tokenType, roles)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	e.eei.Finish(tokenIdentifier)
+	e.eei.AddLogEntry(&vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.RecipientAddr,
+		Topics:     [][]byte{tokenIdentifier, args.CallerAddr, args.Arguments[0], args.Arguments[1], []byte(tokenType)},
+	})
+
+	return vmcommon.Ok
+}
+
+// format: issueNonFungible/issueSemiFungible@tokenName@ticker@optional-list-of-properties
+func (e *esdt) issueNFTToken(owner []byte, arguments [][]byte, tokenType string, roles [][]byte) ([]byte, error) {
+	tokenName := arguments[0]
+	if !isTokenNameHumanReadable(tokenName) {
+		return nil, vm.ErrTokenNameNotHumanReadable
+	}
+
+	tickerName := arguments[1]
+	if !isTickerValid(tickerName) {
+		return nil, vm.ErrTickerNameNotValid
+	}
+
+	tokenIdentifier, err := e.createNewTokenIdentifier(owner, tickerName)
+	if err != nil {
+		return nil, err
+	}
+
+	newESDTToken := &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		TickerName:   tickerName,
+		TokenType:    []byte(tokenType),
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		Upgradable:   true,
+		SpecialRoles: []*ESDTRoles{{Address: owner, Roles: roles}},
+	}
+	err = e.upgradeProperties(newESDTToken, arguments[2:])
+	if err != nil {
+		return nil, err
+	}
+	err = e.saveToken(tokenIdentifier, newESDTToken)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtSetTokenTypeData := core.BuiltInFunctionESDTSetTokenType + "@" + hex.EncodeToString(tokenIdentifier) + "@" + hex.EncodeToString([]byte(tokenType))
+	e.eei.SendGlobalSettingToAll(e.eSDTSCAddress, []byte(esdtSetTokenTypeData))
+
+	esdtSetRoleData := core.BuiltInFunctionSetESDTRole + "@" + hex.EncodeToString(tokenIdentifier)
+	for _, role := range roles {
+		esdtSetRoleData += "@" + hex.EncodeToString(role)
+	}
+	err = e.eei.Transfer(owner, e.eSDTSCAddress, big.NewInt(0), []byte(esdtSetRoleData), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	e.addToIssuedTokens(string(tokenIdentifier))
+
+	return tokenIdentifier, nil
+}
+
+func isFungibleToken(token *ESDTData) bool {
+	return len(token.TokenType) == 0 || string(token.TokenType) == core.FungibleESDT
+}
+
 func isTickerValid(tickerName []byte) bool {
 	if len(tickerName) < minLengthForTickerName || len(tickerName) > maxLengthForTickerName {
 		return false
@@ -263,25 +409,25 @@ func (e *esdt) createNewTokenIdentifier(caller []byte, ticker []byte) ([]byte, e
 }
 
 // format: issue@tokenName@ticker@initialSupply@numOfDecimals@optional-list-of-properties
-func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
+func (e *esdt) issueToken(owner []byte, arguments [][]byte) ([]byte, error) {
 	tokenName := arguments[0]
 	if !isTokenNameHumanReadable(tokenName) {
-		return vm.ErrTokenNameNotHumanReadable
+		return nil, vm.ErrTokenNameNotHumanReadable
 	}
 
 	tickerName := arguments[1]
 	if !isTickerValid(tickerName) {
-		return vm.ErrTickerNameNotValid
+		return nil, vm.ErrTickerNameNotValid
 	}
 
 	initialSupply := big.NewInt(0).SetBytes(arguments[2])
 	if initialSupply.Cmp(big.NewInt(0)) <= 0 {
-		return vm.ErrNegativeOrZeroInitialSupply
+		return nil, vm.ErrNegativeOrZeroInitialSupply
 	}
 
 	numOfDecimals := uint32(big.NewInt(0).SetBytes(arguments[3]).Uint64())
 	if numOfDecimals < minNumberOfDecimals || numOfDecimals > maxNumberOfDecimals {
-		return fmt.Errorf("%w, minimum: %d, maximum: %d, provided: %d",
+		return nil, fmt.Errorf("%w, minimum: %d, maximum: %d, provided: %d",
 			vm.ErrInvalidNumberOfDecimals,
 			minNumberOfDecimals,
 			maxNumberOfDecimals,
@@ -291,7 +437,7 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 
 	tokenIdentifier, err := e.createNewTokenIdentifier(owner, tickerName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newESDTToken := &ESDTData{
@@ -303,27 +449,33 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 		BurntValue:   big.NewInt(0),
 		Upgradable:   true,
 	}
-	err = upgradeProperties(newESDTToken, arguments[4:])
+	if e.flagNFT.IsSet() {
+		newESDTToken.TokenType = []byte(core.FungibleESDT)
+	}
+	err = e.upgradeProperties(newESDTToken, arguments[4:])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = e.saveToken(tokenIdentifier, newESDTToken)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if newESDTToken.TransferRestricted {
+		e.sendTransferRestriction(tokenIdentifier, true)
 	}
 
 	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenIdentifier) + "@" + hex.EncodeToString(initialSupply.Bytes())
 	err = e.eei.Transfer(owner, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	e.addToIssuedTokens(string(tokenIdentifier))
 
-	return nil
+	return tokenIdentifier, nil
 }
 
-func upgradeProperties(token *ESDTData, args [][]byte) error {
+func (e *esdt) upgradeProperties(token *ESDTData, args [][]byte) error {
 	if len(args) == 0 {
 		return nil
 	}
@@ -352,6 +504,14 @@ func upgradeProperties(token *ESDTData, args [][]byte) error {
 			token.Upgradable = val
 		case canChangeOwner:
 			token.CanChangeOwner = val
+		case transferRestricted:
+			if !e.flagLimitedTransfer.IsSet() {
+				return vm.ErrInvalidArgument
+			}
+			if val && !isFungibleToken(token) {
+				return vm.ErrInvalidArgument
+			}
+			token.TransferRestricted = val
 		default:
 			return vm.ErrInvalidArgument
 		}
@@ -396,6 +556,10 @@ func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
+	if !isFungibleToken(token) {
+		e.eei.AddReturnMessage("burn is not allowed for non fungible tokens")
+		return vmcommon.UserError
+	}
 	if !token.Burnable {
 		esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(args.Arguments[0]) + "@" + hex.EncodeToString(args.Arguments[1])
 		err = e.eei.Transfer(args.CallerAddr, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
@@ -434,6 +598,10 @@ func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if returnCode != vmcommon.Ok {
 		return returnCode
 	}
+	if !isFungibleToken(token) {
+		e.eei.AddReturnMessage("mint is not allowed for non fungible tokens")
+		return vmcommon.UserError
+	}
 	mintValue := big.NewInt(0).SetBytes(args.Arguments[1])
 	if mintValue.Cmp(big.NewInt(0)) <= 0 {
 		e.eei.AddReturnMessage("negative or zero mint value")
@@ -508,6 +676,10 @@ func (e *esdt) wipe(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if returnCode != vmcommon.Ok {
 		return returnCode
 	}
+	if !isFungibleToken(token) {
+		e.eei.AddReturnMessage("wipe is not allowed for non fungible tokens")
+		return vmcommon.UserError
+	}
 	if !token.CanWipe {
 		e.eei.AddReturnMessage("cannot wipe")
 		return vmcommon.UserError
@@ -700,10 +872,192 @@ func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.Ret
 	e.eei.Finish([]byte("CanPause-" + getStringFromBool(esdtToken.CanPause)))
 	e.eei.Finish([]byte("CanFreeze-" + getStringFromBool(esdtToken.CanFreeze)))
 	e.eei.Finish([]byte("CanWipe-" + getStringFromBool(esdtToken.CanWipe)))
+	e.eei.Finish([]byte("TokenType-" + getTokenType(esdtToken)))
+	e.eei.Finish([]byte("TransferRestricted-" + getStringFromBool(esdtToken.TransferRestricted)))
+
+	return vmcommon.Ok
+}
+
+func getTokenType(token *ESDTData) string {
+	if len(token.TokenType) == 0 {
+		return core.FungibleESDT
+	}
+
+	return string(token.TokenType)
+}
+
+// format: setSpecialRole@tokenIdentifier@address@role1@role2...
+func (e *esdt) setSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, address, roles, returnCode := e.checkSpecialRoleArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	addressRoles, index := getRolesForAddress(token, address)
+	for _, role := range roles {
+		if isRoleSet(addressRoles, role) {
+			e.eei.AddReturnMessage("special role already exists for given address")
+			return vmcommon.UserError
+		}
+		if isSingleHolderRole(role) && hasRoleHolder(token, role) {
+			e.eei.AddReturnMessage(fmt.Sprintf("special role %s can be held by a single address", role))
+			return vmcommon.UserError
+		}
+		addressRoles.Roles = append(addressRoles.Roles, role)
+	}
+	if index < 0 {
+		token.SpecialRoles = append(token.SpecialRoles, addressRoles)
+	}
+
+	return e.saveTokenAndSendRoles(args.Arguments[0], token, address, roles, core.BuiltInFunctionSetESDTRole)
+}
+
+// format: unSetSpecialRole@tokenIdentifier@address@role1@role2...
+func (e *esdt) unSetSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, address, roles, returnCode := e.checkSpecialRoleArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	addressRoles, index := getRolesForAddress(token, address)
+	for _, role := range roles {
+		if !isRoleSet(addressRoles, role) {
+			e.eei.AddReturnMessage("special role does not exist for given address")
+			return vmcommon.UserError
+		}
+		if isSingleHolderRole(role) {
+			e.eei.AddReturnMessage(fmt.Sprintf("special role %s cannot be removed", role))
+			return vmcommon.UserError
+		}
+		addressRoles.Roles = removeRole(addressRoles.Roles, role)
+	}
+	if len(addressRoles.Roles) == 0 {
+		token.SpecialRoles = append(token.SpecialRoles[:index], token.SpecialRoles[index+1:]...)
+	}
+
+	return e.saveTokenAndSendRoles(args.Arguments[0], token, address, roles, core.BuiltInFunctionUnSetESDTRole)
+}
+
+func (e *esdt) checkSpecialRoleArguments(args *vmcommon.ContractCallInput) (*ESDTData, []byte, [][]byte, vmcommon.ReturnCode) {
+	if len(args.Arguments) < 3 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return nil, nil, nil, vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return nil, nil, nil, returnCode
+	}
+
+	address := args.Arguments[1]
+	if !e.isAddressValid(address) {
+		e.eei.AddReturnMessage("invalid address")
+		return nil, nil, nil, vmcommon.UserError
+	}
+
+	roles := args.Arguments[2:]
+	for _, role := range roles {
+		if !isSpecialRoleAllowed(token, role) {
+			e.eei.AddReturnMessage(fmt.Sprintf("invalid special role %s for token type %s", role, getTokenType(token)))
+			return nil, nil, nil, vmcommon.UserError
+		}
+	}
+
+	return token, address, roles, vmcommon.Ok
+}
+
+func (e *esdt) saveTokenAndSendRoles(
+	tokenID []byte,
+	token *ESDTData,
+	address []byte,
+	roles [][]byte,
+	builtInFunc string,
+) vmcommon.ReturnCode {
+	err := e.saveToken(tokenID, token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	esdtRoleData := builtInFunc + "@" + hex.EncodeToString(tokenID)
+	for _, role := range roles {
+		esdtRoleData += "@" + hex.EncodeToString(role)
+	}
+	err = e.eei.Transfer(address, e.eSDTSCAddress, big.NewInt(0), []byte(esdtRoleData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
 
 	return vmcommon.Ok
 }
 
+func isSpecialRoleAllowed(token *ESDTData, role []byte) bool {
+	var allowedRoles []string
+	switch getTokenType(token) {
+	case core.FungibleESDT:
+		allowedRoles = []string{core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn, core.ESDTRoleTransfer}
+	case core.NonFungibleESDT:
+		allowedRoles = []string{core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn}
+	case core.SemiFungibleESDT:
+		allowedRoles = []string{core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity, core.ESDTRoleNFTBurn}
+	}
+
+	for _, allowedRole := range allowedRoles {
+		if string(role) == allowedRole {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSingleHolderRole returns true for the NFT create role: the nonces of the created tokens are kept in the data trie
+// of the holder, so a second holder, or a new one after the role was removed, would create the same nonces again.
+// For this reason the role is not given on issue, the owner sets it once for the address that will create the tokens
+func isSingleHolderRole(role []byte) bool {
+	return string(role) == core.ESDTRoleNFTCreate
+}
+
+func hasRoleHolder(token *ESDTData, role []byte) bool {
+	for _, addressRoles := range token.SpecialRoles {
+		if isRoleSet(addressRoles, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getRolesForAddress(token *ESDTData, address []byte) (*ESDTRoles, int) {
+	for i, addressRoles := range token.SpecialRoles {
+		if bytes.Equal(addressRoles.Address, address) {
+			return addressRoles, i
+		}
+	}
+
+	return &ESDTRoles{Address: address}, -1
+}
+
+func isRoleSet(addressRoles *ESDTRoles, role []byte) bool {
+	for _, existingRole := range addressRoles.Roles {
+		if bytes.Equal(existingRole, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeRole(roles [][]byte, role []byte) [][]byte {
+	for i, existingRole := range roles {
+		if bytes.Equal(existingRole, role) {
+			return append(roles[:i], roles[i+1:]...)
+		}
+	}
+
+	return roles
+}
+
 func (e *esdt) addToIssuedTokens(newToken string) {
 	allTokens := e.eei.GetStorage([]byte(allIssuedTokens))
 	if len(allTokens) == 0 {
@@ -780,7 +1134,8 @@ func (e *esdt) controlChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return vmcommon.UserError
 	}
 
-	err := upgradeProperties(token, args.Arguments[1:])
+	wasTransferRestricted := token.TransferRestricted
+	err := e.upgradeProperties(token, args.Arguments[1:])
 	if err != nil {
 		e.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
@@ -790,10 +1145,23 @@ func (e *esdt) controlChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		e.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
+	if wasTransferRestricted != token.TransferRestricted {
+		e.sendTransferRestriction(args.Arguments[0], token.TransferRestricted)
+	}
 
 	return vmcommon.Ok
 }
 
+func (e *esdt) sendTransferRestriction(tokenID []byte, restricted bool) {
+	builtInFunc := core.BuiltInFunctionESDTUnSetLimitedTransfer
+	if restricted {
+		builtInFunc = core.BuiltInFunctionESDTSetLimitedTransfer
+	}
+
+	esdtTransferData := builtInFunc + "@" + hex.EncodeToString(tokenID)
+	e.eei.SendGlobalSettingToAll(e.eSDTSCAddress, []byte(esdtTransferData))
+}
+
 func (e *esdt) saveToken(identifier []byte, token *ESDTData) error {
 	marshaledData, err := e.marshalizer.Marshal(token)
 	if err != nil {
@@ -845,6 +1213,15 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagNFT.Toggle(epoch >= e.nftEnableEpoch)
+	log.Debug("esdt contract: non fungible tokens", "enabled", e.flagNFT.IsSet())
+
+	e.flagRoles.Toggle(epoch >= e.rolesEnableEpoch)
+	log.Debug("esdt contract: special roles", "enabled", e.flagRoles.IsSet())
+
+	e.flagLimitedTransfer.Toggle(epoch >= e.limitedTransferEpoch)
+	log.Debug("esdt contract: limited transfer", "enabled", e.flagLimitedTransfer.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
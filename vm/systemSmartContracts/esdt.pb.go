@@ -29,20 +29,23 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ESDTData struct {
-	OwnerAddress   []byte        `protobuf:"bytes,1,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
-	TokenName      []byte        `protobuf:"bytes,2,opt,name=TokenName,proto3" json:"TokenName"`
-	TickerName     []byte        `protobuf:"bytes,3,opt,name=TickerName,proto3" json:"TickerName"`
-	Mintable       bool          `protobuf:"varint,4,opt,name=Mintable,proto3" json:"Mintable"`
-	Burnable       bool          `protobuf:"varint,5,opt,name=Burnable,proto3" json:"Burnable"`
-	CanPause       bool          `protobuf:"varint,6,opt,name=CanPause,proto3" json:"CanPause"`
-	CanFreeze      bool          `protobuf:"varint,7,opt,name=CanFreeze,proto3" json:"CanFreeze"`
-	CanWipe        bool          `protobuf:"varint,8,opt,name=CanWipe,proto3" json:"CanWipe"`
-	Upgradable     bool          `protobuf:"varint,9,opt,name=Upgradable,proto3" json:"CanUpgrade"`
-	CanChangeOwner bool          `protobuf:"varint,10,opt,name=CanChangeOwner,proto3" json:"CanChangeOwner"`
-	IsPaused       bool          `protobuf:"varint,11,opt,name=IsPaused,proto3" json:"IsPaused"`
-	MintedValue    *math_big.Int `protobuf:"bytes,12,opt,name=MintedValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MintedValue"`
-	BurntValue     *math_big.Int `protobuf:"bytes,13,opt,name=BurntValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BurntValue"`
-	NumDecimals    uint32        `protobuf:"varint,14,opt,name=NumDecimals,proto3" json:"NumDecimals"`
+	OwnerAddress       []byte        `protobuf:"bytes,1,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
+	TokenName          []byte        `protobuf:"bytes,2,opt,name=TokenName,proto3" json:"TokenName"`
+	TickerName         []byte        `protobuf:"bytes,3,opt,name=TickerName,proto3" json:"TickerName"`
+	Mintable           bool          `protobuf:"varint,4,opt,name=Mintable,proto3" json:"Mintable"`
+	Burnable           bool          `protobuf:"varint,5,opt,name=Burnable,proto3" json:"Burnable"`
+	CanPause           bool          `protobuf:"varint,6,opt,name=CanPause,proto3" json:"CanPause"`
+	CanFreeze          bool          `protobuf:"varint,7,opt,name=CanFreeze,proto3" json:"CanFreeze"`
+	CanWipe            bool          `protobuf:"varint,8,opt,name=CanWipe,proto3" json:"CanWipe"`
+	Upgradable         bool          `protobuf:"varint,9,opt,name=Upgradable,proto3" json:"CanUpgrade"`
+	CanChangeOwner     bool          `protobuf:"varint,10,opt,name=CanChangeOwner,proto3" json:"CanChangeOwner"`
+	IsPaused           bool          `protobuf:"varint,11,opt,name=IsPaused,proto3" json:"IsPaused"`
+	MintedValue        *math_big.Int `protobuf:"bytes,12,opt,name=MintedValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MintedValue"`
+	BurntValue         *math_big.Int `protobuf:"bytes,13,opt,name=BurntValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BurntValue"`
+	NumDecimals        uint32        `protobuf:"varint,14,opt,name=NumDecimals,proto3" json:"NumDecimals"`
+	TokenType          []byte        `protobuf:"bytes,15,opt,name=TokenType,proto3" json:"TokenType"`
+	SpecialRoles       []*ESDTRoles  `protobuf:"bytes,16,rep,name=SpecialRoles,proto3" json:"SpecialRoles"`
+	TransferRestricted bool          `protobuf:"varint,17,opt,name=TransferRestricted,proto3" json:"TransferRestricted"`
 }
 
 func (m *ESDTData) Reset()      { *m = ESDTData{} }
@@ -171,6 +174,74 @@ func (m *ESDTData) GetNumDecimals() uint32 {
 	return 0
 }
 
+func (m *ESDTData) GetTokenType() []byte {
+	if m != nil {
+		return m.TokenType
+	}
+	return nil
+}
+
+func (m *ESDTData) GetSpecialRoles() []*ESDTRoles {
+	if m != nil {
+		return m.SpecialRoles
+	}
+	return nil
+}
+
+func (m *ESDTData) GetTransferRestricted() bool {
+	if m != nil {
+		return m.TransferRestricted
+	}
+	return false
+}
+
+type ESDTRoles struct {
+	Address []byte   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address"`
+	Roles   [][]byte `protobuf:"bytes,2,rep,name=Roles,proto3" json:"Roles"`
+}
+
+func (m *ESDTRoles) Reset()      { *m = ESDTRoles{} }
+func (*ESDTRoles) ProtoMessage() {}
+func (*ESDTRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e413e402abc6a34c, []int{1}
+}
+func (m *ESDTRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTRoles.Merge(m, src)
+}
+func (m *ESDTRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTRoles proto.InternalMessageInfo
+
+func (m *ESDTRoles) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ESDTRoles) GetRoles() [][]byte {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type ESDTConfig struct {
 	OwnerAddress       []byte        `protobuf:"bytes,1,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
 	BaseIssuingCost    *math_big.Int `protobuf:"bytes,2,opt,name=BaseIssuingCost,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BaseIssuingCost"`
@@ -181,7 +252,7 @@ type ESDTConfig struct {
 func (m *ESDTConfig) Reset()      { *m = ESDTConfig{} }
 func (*ESDTConfig) ProtoMessage() {}
 func (*ESDTConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e413e402abc6a34c, []int{1}
+	return fileDescriptor_e413e402abc6a34c, []int{2}
 }
 func (m *ESDTConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,52 +307,59 @@ func (m *ESDTConfig) GetMaxTokenNameLength() uint32 {
 
 func init() {
 	proto.RegisterType((*ESDTData)(nil), "proto.ESDTData")
+	proto.RegisterType((*ESDTRoles)(nil), "proto.ESDTRoles")
 	proto.RegisterType((*ESDTConfig)(nil), "proto.ESDTConfig")
 }
 
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xb3, 0xfd, 0xf5, 0x4f, 0xba, 0x49, 0xda, 0x9f, 0x56, 0x08, 0x59, 0x1c, 0xd6, 0x55,
-	0x25, 0xa4, 0x48, 0xa8, 0x89, 0x10, 0x9c, 0xe0, 0x54, 0xbb, 0xad, 0x14, 0x89, 0x06, 0xb4, 0x0d,
-	0x7f, 0xc4, 0x6d, 0x13, 0x6f, 0x1d, 0xab, 0xf1, 0xba, 0xda, 0x5d, 0x53, 0xe0, 0x84, 0x78, 0x02,
-	0x5e, 0x02, 0x09, 0xf1, 0x24, 0x1c, 0x7b, 0xa3, 0x27, 0x43, 0xdd, 0x0b, 0xf2, 0xa9, 0x8f, 0x80,
-	0x3c, 0x25, 0xb6, 0x1b, 0x72, 0x42, 0x3d, 0xe5, 0x3b, 0x9f, 0xf9, 0xee, 0x6c, 0x66, 0x36, 0x13,
-	0x8c, 0x85, 0xf6, 0x4c, 0xe7, 0x58, 0x45, 0x26, 0x22, 0x4b, 0xf0, 0x71, 0x67, 0xcb, 0x0f, 0xcc,
-	0x38, 0x1e, 0x76, 0x46, 0x51, 0xd8, 0xf5, 0x23, 0x3f, 0xea, 0x02, 0x1e, 0xc6, 0x87, 0x10, 0x41,
-	0x00, 0xea, 0xea, 0xd4, 0xe6, 0xe7, 0x65, 0x5c, 0xdf, 0x3d, 0xd8, 0x19, 0xec, 0x70, 0xc3, 0xc9,
-	0x43, 0xdc, 0x7c, 0x7a, 0x22, 0x85, 0xda, 0xf6, 0x3c, 0x25, 0xb4, 0xb6, 0xd0, 0x06, 0x6a, 0x37,
-	0x9d, 0xff, 0xb3, 0xc4, 0xbe, 0xc6, 0xd9, 0xb5, 0x88, 0xdc, 0xc3, 0xab, 0x83, 0xe8, 0x48, 0xc8,
-	0x3e, 0x0f, 0x85, 0xb5, 0x00, 0x47, 0x5a, 0x59, 0x62, 0x97, 0x90, 0x95, 0x92, 0x74, 0x30, 0x1e,
-	0x04, 0xa3, 0x23, 0xa1, 0xc0, 0xfd, 0x1f, 0xb8, 0xd7, 0xb2, 0xc4, 0xae, 0x50, 0x56, 0xd1, 0xa4,
-	0x8d, 0xeb, 0xfb, 0x81, 0x34, 0x7c, 0x38, 0x11, 0xd6, 0xe2, 0x06, 0x6a, 0xd7, 0x9d, 0x66, 0x96,
-	0xd8, 0x05, 0x63, 0x85, 0xca, 0x9d, 0x4e, 0xac, 0x24, 0x38, 0x97, 0x4a, 0xe7, 0x94, 0xb1, 0x42,
-	0xe5, 0x4e, 0x97, 0xcb, 0x67, 0x3c, 0xd6, 0xc2, 0x5a, 0x2e, 0x9d, 0x53, 0xc6, 0x0a, 0x95, 0xb7,
-	0xe6, 0x72, 0xb9, 0xa7, 0x84, 0x78, 0x2f, 0xac, 0x15, 0xb0, 0x42, 0x6b, 0x05, 0x64, 0xa5, 0x24,
-	0x77, 0xf1, 0x8a, 0xcb, 0xe5, 0xcb, 0xe0, 0x58, 0x58, 0x75, 0xb0, 0x36, 0xb2, 0xc4, 0x9e, 0x22,
-	0x36, 0x15, 0xf9, 0x04, 0x9e, 0x1f, 0xfb, 0x8a, 0x7b, 0xf0, 0x4d, 0x57, 0xc1, 0x09, 0x13, 0x70,
-	0xb9, 0xbc, 0x4a, 0x08, 0x56, 0x71, 0x90, 0x47, 0x78, 0xcd, 0xe5, 0xd2, 0x1d, 0x73, 0xe9, 0x0b,
-	0x98, 0xbb, 0x85, 0xe1, 0x0c, 0xc9, 0x12, 0x7b, 0x26, 0xc3, 0x66, 0xe2, 0xbc, 0xd3, 0x9e, 0x86,
-	0x56, 0x3c, 0xab, 0x51, 0x76, 0x3a, 0x65, 0xac, 0x50, 0xe4, 0x0d, 0x6e, 0xe4, 0x93, 0x14, 0xde,
-	0x0b, 0x3e, 0x89, 0x85, 0xd5, 0x84, 0x87, 0x19, 0x64, 0x89, 0x5d, 0xc5, 0x5f, 0x7f, 0xd8, 0xdb,
-	0x21, 0x37, 0xe3, 0xee, 0x30, 0xf0, 0x3b, 0x3d, 0x69, 0x1e, 0x57, 0x7e, 0x6b, 0xbb, 0x13, 0x15,
-	0x49, 0xaf, 0x2f, 0xcc, 0x49, 0xa4, 0x8e, 0xba, 0x02, 0xa2, 0x2d, 0x3f, 0xea, 0x7a, 0xdc, 0xf0,
-	0x8e, 0x13, 0xf8, 0x3d, 0x69, 0x5c, 0xae, 0x8d, 0x50, 0xac, 0x5a, 0x91, 0x68, 0x8c, 0xf3, 0x77,
-	0x31, 0x57, 0xd7, 0xb6, 0xe0, 0xda, 0x83, 0x7c, 0x1a, 0x25, 0xbd, 0x99, 0x5b, 0x2b, 0x05, 0xc9,
-	0x7d, 0xdc, 0xe8, 0xc7, 0xe1, 0x8e, 0x18, 0x05, 0x21, 0x9f, 0x68, 0x6b, 0x6d, 0x03, 0xb5, 0x5b,
-	0xce, 0x7a, 0xde, 0x6c, 0x05, 0xb3, 0x6a, 0xb0, 0xf9, 0x7d, 0x01, 0xe3, 0x7c, 0x4f, 0xdc, 0x48,
-	0x1e, 0x06, 0xfe, 0x3f, 0x6e, 0xca, 0x47, 0x84, 0xd7, 0x1d, 0xae, 0x45, 0x4f, 0xeb, 0x38, 0x90,
-	0xbe, 0x1b, 0x69, 0xf3, 0x67, 0x61, 0x5e, 0x65, 0x89, 0x3d, 0x9b, 0xba, 0x99, 0xbe, 0x67, 0xab,
-	0x92, 0x3d, 0x4c, 0xf6, 0x03, 0x59, 0x6c, 0xe4, 0x13, 0x21, 0x7d, 0x33, 0x86, 0x4d, 0x6c, 0x39,
-	0xb7, 0xb3, 0xc4, 0x9e, 0x93, 0x65, 0x73, 0x18, 0xd4, 0xe1, 0x6f, 0x67, 0xeb, 0x2c, 0x56, 0xea,
-	0xfc, 0x95, 0x65, 0x73, 0x98, 0xd3, 0x3f, 0x3d, 0xa7, 0xb5, 0xb3, 0x73, 0x5a, 0xbb, 0x3c, 0xa7,
-	0xe8, 0x43, 0x4a, 0xd1, 0x97, 0x94, 0xa2, 0x6f, 0x29, 0x45, 0xa7, 0x29, 0x45, 0x67, 0x29, 0x45,
-	0x3f, 0x53, 0x8a, 0x7e, 0xa5, 0xb4, 0x76, 0x99, 0x52, 0xf4, 0xe9, 0x82, 0xd6, 0x4e, 0x2f, 0x68,
-	0xed, 0xec, 0x82, 0xd6, 0x5e, 0xdf, 0xd2, 0xef, 0xb4, 0x11, 0xe1, 0x41, 0xc8, 0x95, 0x71, 0x23,
-	0x69, 0x14, 0x1f, 0x19, 0x3d, 0x5c, 0x86, 0x3f, 0xb6, 0x07, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x1d, 0x26, 0x99, 0x78, 0x1c, 0x05, 0x00, 0x00,
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbb, 0x6e, 0xdb, 0x4a,
+	0x10, 0x15, 0xfd, 0x94, 0x56, 0x92, 0xed, 0xbb, 0xb8, 0xb8, 0x20, 0x6e, 0x41, 0x0a, 0x06, 0x02,
+	0x08, 0x08, 0x2c, 0x21, 0x8f, 0x2a, 0xa9, 0x4c, 0xda, 0x06, 0x04, 0xc4, 0x4a, 0xb0, 0x52, 0x1e,
+	0x48, 0xb7, 0x12, 0xc7, 0x14, 0x61, 0x69, 0x29, 0xec, 0xae, 0xe2, 0x38, 0x55, 0x90, 0x2f, 0xc8,
+	0x67, 0x04, 0xf9, 0x92, 0x94, 0xee, 0xe2, 0x8a, 0x89, 0xe9, 0x26, 0x60, 0xe5, 0x4f, 0x08, 0xb8,
+	0x0c, 0x1f, 0x92, 0x55, 0x05, 0xae, 0x78, 0xe6, 0xcc, 0xd9, 0x59, 0xce, 0xec, 0xcc, 0x20, 0x04,
+	0xc2, 0x91, 0xad, 0x29, 0xf7, 0xa5, 0x8f, 0xd7, 0xd5, 0xe7, 0xff, 0x3d, 0xd7, 0x93, 0xa3, 0xd9,
+	0xa0, 0x35, 0xf4, 0x27, 0x6d, 0xd7, 0x77, 0xfd, 0xb6, 0xa2, 0x07, 0xb3, 0x13, 0x65, 0x29, 0x43,
+	0xa1, 0xe4, 0xd4, 0x6e, 0xb8, 0x89, 0xca, 0x87, 0xbd, 0x83, 0xfe, 0x01, 0x95, 0x14, 0x3f, 0x46,
+	0xb5, 0xe7, 0x67, 0x0c, 0xf8, 0xbe, 0xe3, 0x70, 0x10, 0x42, 0xd7, 0x1a, 0x5a, 0xb3, 0x66, 0xed,
+	0x44, 0x81, 0x39, 0xc7, 0x93, 0x39, 0x0b, 0xdf, 0x47, 0x95, 0xbe, 0x7f, 0x0a, 0xac, 0x4b, 0x27,
+	0xa0, 0xaf, 0xa8, 0x23, 0xf5, 0x28, 0x30, 0x73, 0x92, 0xe4, 0x10, 0xb7, 0x10, 0xea, 0x7b, 0xc3,
+	0x53, 0xe0, 0x4a, 0xbd, 0xaa, 0xd4, 0x5b, 0x51, 0x60, 0x16, 0x58, 0x52, 0xc0, 0xb8, 0x89, 0xca,
+	0xc7, 0x1e, 0x93, 0x74, 0x30, 0x06, 0x7d, 0xad, 0xa1, 0x35, 0xcb, 0x56, 0x2d, 0x0a, 0xcc, 0x8c,
+	0x23, 0x19, 0x8a, 0x95, 0xd6, 0x8c, 0x33, 0xa5, 0x5c, 0xcf, 0x95, 0x29, 0x47, 0x32, 0x14, 0x2b,
+	0x6d, 0xca, 0x5e, 0xd0, 0x99, 0x00, 0x7d, 0x23, 0x57, 0xa6, 0x1c, 0xc9, 0x50, 0x9c, 0x9a, 0x4d,
+	0xd9, 0x11, 0x07, 0xf8, 0x00, 0xfa, 0xa6, 0x92, 0xaa, 0xd4, 0x32, 0x92, 0xe4, 0x10, 0xdf, 0x43,
+	0x9b, 0x36, 0x65, 0xaf, 0xbd, 0x29, 0xe8, 0x65, 0x25, 0xad, 0x46, 0x81, 0x99, 0x52, 0x24, 0x05,
+	0x71, 0x05, 0x5e, 0x4e, 0x5d, 0x4e, 0x1d, 0xf5, 0xa7, 0x15, 0xa5, 0x54, 0x15, 0xb0, 0x29, 0x4b,
+	0x1c, 0x40, 0x0a, 0x0a, 0xfc, 0x04, 0x6d, 0xd9, 0x94, 0xd9, 0x23, 0xca, 0x5c, 0x50, 0x75, 0xd7,
+	0x91, 0x3a, 0x83, 0xa3, 0xc0, 0x5c, 0xf0, 0x90, 0x05, 0x3b, 0xce, 0xb4, 0x23, 0x54, 0x2a, 0x8e,
+	0x5e, 0xcd, 0x33, 0x4d, 0x39, 0x92, 0x21, 0xfc, 0x0e, 0x55, 0xe3, 0x4a, 0x82, 0xf3, 0x8a, 0x8e,
+	0x67, 0xa0, 0xd7, 0xd4, 0xc3, 0xf4, 0xa3, 0xc0, 0x2c, 0xd2, 0x5f, 0x7f, 0x98, 0xfb, 0x13, 0x2a,
+	0x47, 0xed, 0x81, 0xe7, 0xb6, 0x3a, 0x4c, 0x3e, 0x2d, 0xf4, 0xda, 0xe1, 0x98, 0xfb, 0xcc, 0xe9,
+	0x82, 0x3c, 0xf3, 0xf9, 0x69, 0x1b, 0x94, 0xb5, 0xe7, 0xfa, 0x6d, 0x87, 0x4a, 0xda, 0xb2, 0x3c,
+	0xb7, 0xc3, 0xa4, 0x4d, 0x85, 0x04, 0x4e, 0x8a, 0x11, 0xb1, 0x40, 0x28, 0x7e, 0x17, 0x99, 0x5c,
+	0x5b, 0x57, 0xd7, 0xf6, 0xe2, 0x6a, 0xe4, 0xec, 0xdd, 0xdc, 0x5a, 0x08, 0x88, 0x1f, 0xa0, 0x6a,
+	0x77, 0x36, 0x39, 0x80, 0xa1, 0x37, 0xa1, 0x63, 0xa1, 0x6f, 0x35, 0xb4, 0x66, 0xdd, 0xda, 0x8e,
+	0x93, 0x2d, 0xd0, 0xa4, 0x68, 0x64, 0x4d, 0xde, 0x3f, 0x9f, 0x82, 0xbe, 0xbd, 0xd0, 0xe4, 0x31,
+	0x49, 0x72, 0x88, 0x8f, 0x50, 0xad, 0x37, 0x85, 0xa1, 0x47, 0xc7, 0xc4, 0x1f, 0x83, 0xd0, 0x77,
+	0x1a, 0xab, 0xcd, 0xea, 0xc3, 0x9d, 0x64, 0xe4, 0x5a, 0xf1, 0xb8, 0x29, 0x3e, 0x99, 0xac, 0xa2,
+	0x92, 0xcc, 0x59, 0xf8, 0x08, 0xe1, 0x3e, 0xa7, 0x4c, 0x9c, 0x00, 0x27, 0x20, 0x24, 0xf7, 0x86,
+	0x12, 0x1c, 0xfd, 0x1f, 0xf5, 0x90, 0xff, 0x45, 0x81, 0xb9, 0xc4, 0x4b, 0x96, 0x70, 0xbb, 0x3d,
+	0x54, 0xc9, 0x2e, 0x8d, 0xdb, 0x74, 0x7e, 0xbe, 0x55, 0x9b, 0xa6, 0xa3, 0x9d, 0x02, 0x6c, 0xa2,
+	0xf5, 0xe4, 0xe7, 0x57, 0x1a, 0xab, 0xcd, 0x9a, 0x55, 0x89, 0x02, 0x33, 0x21, 0x48, 0xf2, 0xd9,
+	0xfd, 0xbe, 0x82, 0x50, 0x1c, 0xd5, 0xf6, 0xd9, 0x89, 0xe7, 0xfe, 0xe5, 0xee, 0xf8, 0xa4, 0xa1,
+	0x6d, 0x8b, 0x0a, 0xe8, 0x08, 0x31, 0xf3, 0x98, 0x6b, 0xfb, 0x42, 0xfe, 0x59, 0x21, 0x6f, 0xa2,
+	0xc0, 0x5c, 0x74, 0xdd, 0x4d, 0x27, 0x2c, 0x46, 0x8d, 0xcb, 0x7c, 0xec, 0xb1, 0x6c, 0x47, 0x3d,
+	0x03, 0xe6, 0xca, 0x91, 0xda, 0x4d, 0xf5, 0xa4, 0xcc, 0xb7, 0xbd, 0x64, 0x09, 0xa7, 0xe2, 0xd0,
+	0xf7, 0x8b, 0x71, 0xd6, 0x0a, 0x71, 0x6e, 0x79, 0xc9, 0x12, 0xce, 0xea, 0x5e, 0x5c, 0x19, 0xa5,
+	0xcb, 0x2b, 0xa3, 0x74, 0x73, 0x65, 0x68, 0x1f, 0x43, 0x43, 0xfb, 0x12, 0x1a, 0xda, 0xb7, 0xd0,
+	0xd0, 0x2e, 0x42, 0x43, 0xbb, 0x0c, 0x0d, 0xed, 0x67, 0x68, 0x68, 0xbf, 0x42, 0xa3, 0x74, 0x13,
+	0x1a, 0xda, 0xe7, 0x6b, 0xa3, 0x74, 0x71, 0x6d, 0x94, 0x2e, 0xaf, 0x8d, 0xd2, 0xdb, 0x7f, 0xc5,
+	0xb9, 0x90, 0x30, 0xe9, 0x4d, 0x28, 0x97, 0xb6, 0xcf, 0x24, 0xa7, 0x43, 0x29, 0x06, 0x1b, 0xaa,
+	0xef, 0x1e, 0xfd, 0x1e, 0x00, 0x97, 0xe6, 0x1f, 0xb3, 0x2e, 0x06, 0x00, 0x00,
 }
 
 func (this *ESDTData) Equal(that interface{}) bool {
@@ -351,6 +429,52 @@ func (this *ESDTData) Equal(that interface{}) bool {
 	if this.NumDecimals != that1.NumDecimals {
 		return false
 	}
+	if !bytes.Equal(this.TokenType, that1.TokenType) {
+		return false
+	}
+	if len(this.SpecialRoles) != len(that1.SpecialRoles) {
+		return false
+	}
+	for i := range this.SpecialRoles {
+		if !this.SpecialRoles[i].Equal(that1.SpecialRoles[i]) {
+			return false
+		}
+	}
+	if this.TransferRestricted != that1.TransferRestricted {
+		return false
+	}
+	return true
+}
+func (this *ESDTRoles) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTRoles)
+	if !ok {
+		that2, ok := that.(ESDTRoles)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !bytes.Equal(this.Roles[i], that1.Roles[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ESDTConfig) Equal(that interface{}) bool {
@@ -393,7 +517,7 @@ func (this *ESDTData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 21)
 	s = append(s, "&systemSmartContracts.ESDTData{")
 	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
 	s = append(s, "TokenName: "+fmt.Sprintf("%#v", this.TokenName)+",\n")
@@ -409,6 +533,22 @@ func (this *ESDTData) GoString() string {
 	s = append(s, "MintedValue: "+fmt.Sprintf("%#v", this.MintedValue)+",\n")
 	s = append(s, "BurntValue: "+fmt.Sprintf("%#v", this.BurntValue)+",\n")
 	s = append(s, "NumDecimals: "+fmt.Sprintf("%#v", this.NumDecimals)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	if this.SpecialRoles != nil {
+		s = append(s, "SpecialRoles: "+fmt.Sprintf("%#v", this.SpecialRoles)+",\n")
+	}
+	s = append(s, "TransferRestricted: "+fmt.Sprintf("%#v", this.TransferRestricted)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTRoles) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.ESDTRoles{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Roles: "+fmt.Sprintf("%#v", this.Roles)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -453,6 +593,41 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferRestricted {
+		i--
+		if m.TransferRestricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.SpecialRoles) > 0 {
+		for iNdEx := len(m.SpecialRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpecialRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEsdt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.NumDecimals != 0 {
 		i = encodeVarintEsdt(dAtA, i, uint64(m.NumDecimals))
 		i--
@@ -584,6 +759,45 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ESDTRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintEsdt(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ESDTConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -701,6 +915,38 @@ func (m *ESDTData) Size() (n int) {
 	if m.NumDecimals != 0 {
 		n += 1 + sovEsdt(uint64(m.NumDecimals))
 	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if len(m.SpecialRoles) > 0 {
+		for _, e := range m.SpecialRoles {
+			l = e.Size()
+			n += 2 + l + sovEsdt(uint64(l))
+		}
+	}
+	if m.TransferRestricted {
+		n += 3
+	}
+	return n
+}
+
+func (m *ESDTRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, b := range m.Roles {
+			l = len(b)
+			n += 1 + l + sovEsdt(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -738,6 +984,11 @@ func (this *ESDTData) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSpecialRoles := "[]*ESDTRoles{"
+	for _, f := range this.SpecialRoles {
+		repeatedStringForSpecialRoles += strings.Replace(f.String(), "ESDTRoles", "ESDTRoles", 1) + ","
+	}
+	repeatedStringForSpecialRoles += "}"
 	s := strings.Join([]string{`&ESDTData{`,
 		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
 		`TokenName:` + fmt.Sprintf("%v", this.TokenName) + `,`,
@@ -753,6 +1004,20 @@ func (this *ESDTData) String() string {
 		`MintedValue:` + fmt.Sprintf("%v", this.MintedValue) + `,`,
 		`BurntValue:` + fmt.Sprintf("%v", this.BurntValue) + `,`,
 		`NumDecimals:` + fmt.Sprintf("%v", this.NumDecimals) + `,`,
+		`TokenType:` + fmt.Sprintf("%v", this.TokenType) + `,`,
+		`SpecialRoles:` + repeatedStringForSpecialRoles + `,`,
+		`TransferRestricted:` + fmt.Sprintf("%v", this.TransferRestricted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTRoles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTRoles{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1164,6 +1429,213 @@ func (m *ESDTData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = append(m.TokenType[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenType == nil {
+				m.TokenType = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialRoles = append(m.SpecialRoles, &ESDTRoles{})
+			if err := m.SpecialRoles[len(m.SpecialRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferRestricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransferRestricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, make([]byte, postIndex-iNdEx))
+			copy(m.Roles[len(m.Roles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
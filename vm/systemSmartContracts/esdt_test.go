@@ -14,10 +14,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
@@ -161,6 +163,14 @@ func TestEsdt_ExecuteIssue(t *testing.T) {
 	output = e.Execute(vmInput)
 	assert.Equal(t, vmcommon.Ok, output)
 
+	vmOutput := eei.CreateVMOutput()
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte("issue"), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, vmInput.RecipientAddr, vmOutput.Logs[0].Address)
+	assert.Equal(t, 5, len(vmOutput.Logs[0].Topics))
+	assert.Equal(t, vmInput.CallerAddr, vmOutput.Logs[0].Topics[1])
+	assert.Equal(t, []byte("name"), vmOutput.Logs[0].Topics[2])
+
 	vmInput.Arguments[0] = []byte("01234567891&*@")
 	output = e.Execute(vmInput)
 	assert.Equal(t, vmcommon.UserError, output)
@@ -171,6 +181,164 @@ func TestEsdt_ExecuteIssue(t *testing.T) {
 	assert.Equal(t, vmcommon.Ok, output)
 }
 
+func TestEsdt_ExecuteIssueBeforeNFTEnableEpochShouldNotSetTokenType(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.NFTEnableEpoch = 1
+	args.Marshalizer = &marshal.GogoProtoMarshalizer{}
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("issue", [][]byte{[]byte("name"), []byte("TICKER"), big.NewInt(100).Bytes(), big.NewInt(10).Bytes()})
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	require.Equal(t, 1, len(vmOutput.Logs))
+	tokenIdentifier := vmOutput.Logs[0].Topics[0]
+
+	expectedESDTData := &ESDTData{
+		OwnerAddress: vmInput.CallerAddr,
+		TokenName:    []byte("name"),
+		TickerName:   []byte("TICKER"),
+		NumDecimals:  10,
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(0),
+		Upgradable:   true,
+	}
+	expectedBytes, _ := args.Marshalizer.Marshal(expectedESDTData)
+	assert.Equal(t, expectedBytes, eei.GetStorage(tokenIdentifier))
+}
+
+func TestEsdt_ExecuteIssueNonFungibleAndSemiFungible(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	owner := []byte("owner")
+	vmInput := getDefaultVmInputForFunc("issueNonFungible", [][]byte{[]byte("name")})
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = [][]byte{[]byte("name"), []byte("TICKER")}
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.OutOfFunds, output)
+
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 1, len(eei.output))
+
+	tokenID := eei.output[0]
+	token, err := e.getExistingToken(tokenID)
+	require.Nil(t, err)
+	assert.Equal(t, []byte(core.NonFungibleESDT), token.TokenType)
+	assert.Equal(t, owner, token.OwnerAddress)
+
+	outAcc := eei.outputAccounts[string(owner)]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	expectedData := core.BuiltInFunctionSetESDTRole + "@" + hex.EncodeToString(tokenID) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTBurn))
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+
+	systemAddress := make([]byte, len(core.SystemAccountAddress))
+	copy(systemAddress, core.SystemAccountAddress)
+	systemAddress[len(core.SystemAccountAddress)-1] = 0
+	systemAcc := eei.outputAccounts[string(systemAddress)]
+	require.NotNil(t, systemAcc)
+	require.Equal(t, 1, len(systemAcc.OutputTransfers))
+	expectedData = core.BuiltInFunctionESDTSetTokenType + "@" + hex.EncodeToString(tokenID) +
+		"@" + hex.EncodeToString([]byte(core.NonFungibleESDT))
+	assert.Equal(t, []byte(expectedData), systemAcc.OutputTransfers[0].Data)
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("mint", [][]byte{tokenID, {200}})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "mint is not allowed for non fungible tokens"))
+
+	vmInput = getDefaultVmInputForFunc(core.BuiltInFunctionESDTBurn, [][]byte{tokenID, {200}})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "burn is not allowed for non fungible tokens"))
+
+	vmInput = getDefaultVmInputForFunc("wipe", [][]byte{tokenID, owner})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "wipe is not allowed for non fungible tokens"))
+
+	eei.output = make([][]byte, 0)
+	eei.gasRemaining = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	vmInput = getDefaultVmInputForFunc("issueSemiFungible", [][]byte{[]byte("name"), []byte("TICKER")})
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 1, len(eei.output))
+
+	token, err = e.getExistingToken(eei.output[0])
+	require.Nil(t, err)
+	assert.Equal(t, []byte(core.SemiFungibleESDT), token.TokenType)
+}
+
+func TestEsdt_IssueNFTShouldAddLogEntry(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	issueFunctions := map[string]string{
+		"issueNonFungible":  core.NonFungibleESDT,
+		"issueSemiFungible": core.SemiFungibleESDT,
+	}
+	for function, tokenType := range issueFunctions {
+		eei.CleanCache()
+		vmInput := getDefaultVmInputForFunc(function, [][]byte{[]byte("name"), []byte("TICKER")})
+		vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+		vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+		eei.gasRemaining = vmInput.GasProvided
+		output := e.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, output)
+		require.Equal(t, 1, len(eei.output))
+
+		vmOutput := eei.CreateVMOutput()
+		require.Equal(t, 1, len(vmOutput.Logs))
+		assert.Equal(t, []byte(function), vmOutput.Logs[0].Identifier)
+		assert.Equal(t, vmInput.RecipientAddr, vmOutput.Logs[0].Address)
+		expectedTopics := [][]byte{eei.output[0], vmInput.CallerAddr, []byte("name"), []byte("TICKER"), []byte(tokenType)}
+		assert.Equal(t, expectedTopics, vmOutput.Logs[0].Topics)
+	}
+}
+
 func TestEsdt_IssueInvalidNumberOfDecimals(t *testing.T) {
 	t.Parallel()
 
@@ -797,6 +965,36 @@ func TestEsdt_ExecuteMintWithThreeArgsShouldSetThirdArgAsDestination(t *testing.
 	assert.Equal(t, vmcommon.DirectCall, outputTransfer.CallType)
 }
 
+func TestEsdt_ExecuteIssueNonFungibleBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.NFTEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("issueNonFungible", [][]byte{[]byte("name"), []byte("TICKER")})
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	vmInput.Function = "issueSemiFungible"
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	e.EpochConfirmed(1)
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
 func TestEsdt_ExecuteIssueDisabled(t *testing.T) {
 	t.Parallel()
 
@@ -2190,7 +2388,7 @@ func TestEsdt_ExecuteEsdtControlChangesSavesTokenWithUpgradedPropreties(t *testi
 	output = e.Execute(vmInput)
 	assert.Equal(t, vmcommon.Ok, output)
 
-	assert.Equal(t, 13, len(eei.output))
+	assert.Equal(t, 15, len(eei.output))
 	assert.Equal(t, []byte("esdtToken"), eei.output[0])
 	assert.Equal(t, vmInput.CallerAddr, eei.output[1])
 }
@@ -2267,3 +2465,320 @@ func getAddress() []byte {
 	_, _ = rand.Read(key)
 	return key
 }
+
+func TestEsdt_SetSpecialRoleAndUnSetSpecialRole(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	tokenName := []byte("esdtToken")
+	owner := []byte("owner")
+	address := bytes.Repeat([]byte{1}, 32)
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		OwnerAddress: owner,
+		TokenType:    []byte(core.FungibleESDT),
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid special role"))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)})
+	vmInput.CallerAddr = []byte("not owner")
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be called by owner only"))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	require.Equal(t, 1, len(token.SpecialRoles))
+	assert.Equal(t, address, token.SpecialRoles[0].Address)
+	assert.Equal(t, 2, len(token.SpecialRoles[0].Roles))
+
+	outAcc := eei.outputAccounts[string(address)]
+	require.NotNil(t, outAcc)
+	expectedData := core.BuiltInFunctionSetESDTRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalMint)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalBurn))
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "special role already exists"))
+
+	vmInput = getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, _ = e.getExistingToken(tokenName)
+	require.Equal(t, 1, len(token.SpecialRoles))
+	assert.Equal(t, [][]byte{[]byte(core.ESDTRoleLocalBurn)}, token.SpecialRoles[0].Roles)
+
+	vmInput = getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "special role does not exist"))
+
+	vmInput = getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, _ = e.getExistingToken(tokenName)
+	assert.Equal(t, 0, len(token.SpecialRoles))
+}
+
+func TestEsdt_SetSpecialRoleBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.SpecialRolesEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	address := bytes.Repeat([]byte{2}, 32)
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{[]byte("esdtToken"), address, []byte(core.ESDTRoleLocalMint)})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	vmInput.Function = "unSetSpecialRole"
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+}
+
+func TestEsdt_NFTCreateRoleCanBeHeldByASingleAddress(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	tokenName := []byte("esdtToken")
+	owner := []byte("owner")
+	holder := bytes.Repeat([]byte{1}, 32)
+	address := bytes.Repeat([]byte{2}, 32)
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		OwnerAddress: owner,
+		TokenType:    []byte(core.NonFungibleESDT),
+		SpecialRoles: []*ESDTRoles{{Address: holder, Roles: [][]byte{[]byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)}}},
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate)})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be held by a single address"))
+
+	vmInput = getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, holder, []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "cannot be removed"))
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTBurn)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	require.Equal(t, 2, len(token.SpecialRoles))
+	assert.Equal(t, [][]byte{[]byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)}, token.SpecialRoles[0].Roles)
+}
+
+func TestEsdt_IssueNFTShouldLetTheOwnerChooseTheNFTCreateRoleHolder(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	owner := []byte("owner")
+	vmInput := getDefaultVmInputForFunc("issueSemiFungible", [][]byte{[]byte("name"), []byte("TICKER")})
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 1, len(eei.output))
+
+	tokenID := eei.output[0]
+	token, _ := e.getExistingToken(tokenID)
+	require.Equal(t, 1, len(token.SpecialRoles))
+	assert.Equal(t, owner, token.SpecialRoles[0].Address)
+	assert.False(t, hasRoleHolder(token, []byte(core.ESDTRoleNFTCreate)))
+
+	creator := bytes.Repeat([]byte{1}, 32)
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenID, creator, []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, _ = e.getExistingToken(tokenID)
+	creatorRoles, index := getRolesForAddress(token, creator)
+	require.True(t, index >= 0)
+	assert.Equal(t, [][]byte{[]byte(core.ESDTRoleNFTCreate)}, creatorRoles.Roles)
+
+	anotherCreator := bytes.Repeat([]byte{2}, 32)
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenID, anotherCreator, []byte(core.ESDTRoleNFTCreate)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be held by a single address"))
+}
+
+func TestEsdt_ControlChangesTransferRestrictedShouldSendGlobalSetting(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	tokenName := []byte("esdtToken")
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		OwnerAddress: []byte("owner"),
+		TokenType:    []byte(core.FungibleESDT),
+		Upgradable:   true,
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("controlChanges", [][]byte{tokenName, []byte(transferRestricted), []byte("true")})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.True(t, token.TransferRestricted)
+
+	systemAddress := make([]byte, len(core.SystemAccountAddress))
+	copy(systemAddress, core.SystemAccountAddress)
+	systemAddress[len(core.SystemAccountAddress)-1] = 0
+
+	outAcc := eei.outputAccounts[string(systemAddress)]
+	require.NotNil(t, outAcc)
+	expectedData := core.BuiltInFunctionESDTSetLimitedTransfer + "@" + hex.EncodeToString(tokenName)
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, bytes.Repeat([]byte{1}, 32), []byte(core.ESDTRoleTransfer)})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getTokenProperties", [][]byte{tokenName})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, []byte("TransferRestricted-true"), eei.output[len(eei.output)-1])
+
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	vmInput = getDefaultVmInputForFunc("controlChanges", [][]byte{tokenName, []byte(transferRestricted), []byte("false")})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	outAcc = eei.outputAccounts[string(systemAddress)]
+	require.NotNil(t, outAcc)
+	expectedData = core.BuiltInFunctionESDTUnSetLimitedTransfer + "@" + hex.EncodeToString(tokenName)
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+}
+
+func TestEsdt_TransferRestrictedNotAllowedForNonFungible(t *testing.T) {
+	t.Parallel()
+
+	e, _ := NewESDTSmartContract(createMockArgumentsForESDT())
+
+	token := &ESDTData{TokenType: []byte(core.NonFungibleESDT)}
+	err := e.upgradeProperties(token, [][]byte{[]byte(transferRestricted), []byte("true")})
+	assert.Equal(t, vm.ErrInvalidArgument, err)
+	assert.False(t, token.TransferRestricted)
+}
+
+func TestEsdt_TransferRestrictedBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.LimitedTransferEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	tokenName := []byte("esdtToken")
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		OwnerAddress: []byte("owner"),
+		Upgradable:   true,
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("controlChanges", [][]byte{tokenName, []byte(transferRestricted), []byte("true")})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidArgument.Error()))
+
+	token, _ := e.getExistingToken(tokenName)
+	assert.False(t, token.TransferRestricted)
+
+	eei.returnMessage = ""
+	vmInput = getDefaultVmInputForFunc("issue", [][]byte{[]byte("name"), []byte("TICKER"), big.NewInt(100).Bytes(), big.NewInt(10).Bytes(), []byte(transferRestricted), []byte("true")})
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidArgument.Error()))
+
+	e.EpochConfirmed(1)
+	eei.returnMessage = ""
+	vmInput = getDefaultVmInputForFunc("controlChanges", [][]byte{tokenName, []byte(transferRestricted), []byte("true")})
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
@@ -52,6 +52,8 @@ type governanceContract struct {
 	governanceConfig    config.GovernanceSystemSCConfig
 	enabledEpoch        uint32
 	flagEnabled         atomic.Flag
+	viewFunctionsEpoch  uint32
+	flagViewFunctions   atomic.Flag
 	mutExecution        sync.RWMutex
 }
 
@@ -87,6 +89,7 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 		hasher:              args.Hasher,
 		governanceConfig:    args.GovernanceConfig,
 		enabledEpoch:        args.GovernanceConfig.EnabledEpoch,
+		viewFunctionsEpoch:  args.GovernanceConfig.ViewFunctionsEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(g)
 
@@ -127,6 +130,26 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.changeConfig(args)
 	case "closeProposal":
 		return g.closeProposal(args)
+	case "getProposal":
+		if !g.flagViewFunctions.IsSet() {
+			break
+		}
+		return g.viewProposal(args)
+	case "getProposalVotes":
+		if !g.flagViewFunctions.IsSet() {
+			break
+		}
+		return g.viewProposalVotes(args)
+	case "getVotingPower":
+		if !g.flagViewFunctions.IsSet() {
+			break
+		}
+		return g.viewVotingPower(args)
+	case "getConfig":
+		if !g.flagViewFunctions.IsSet() {
+			break
+		}
+		return g.viewConfig(args)
 	}
 
 	g.eei.AddReturnMessage("invalid method to call")
@@ -908,10 +931,132 @@ func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error
 	return nil
 }
 
+func (g *governanceContract) checkViewFuncArguments(args *vmcommon.ContractCallInput, numArgs int) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != numArgs {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments, expected %d", numArgs))
+		return vmcommon.FunctionWrongSignature
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) viewProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkViewFuncArguments(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	generalProposal, err := g.getGeneralProposal(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage("getGeneralProposal error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	passed := "false"
+	if generalProposal.Voted {
+		passed = "true"
+	}
+
+	g.eei.Finish(generalProposal.IssuerAddress)
+	g.eei.Finish(generalProposal.GitHubCommit)
+	g.eei.Finish(big.NewInt(0).SetUint64(generalProposal.StartVoteNonce).Bytes())
+	g.eei.Finish(big.NewInt(0).SetUint64(generalProposal.EndVoteNonce).Bytes())
+	g.eei.Finish([]byte(g.proposalStatus(generalProposal)))
+	g.eei.Finish([]byte(passed))
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) proposalStatus(generalProposal *GeneralProposal) string {
+	if generalProposal.Closed {
+		return "closed"
+	}
+
+	currentNonce := g.eei.BlockChainHook().CurrentNonce()
+	if currentNonce < generalProposal.StartVoteNonce {
+		return "pending"
+	}
+	if currentNonce <= generalProposal.EndVoteNonce {
+		return "active"
+	}
+
+	return "ended"
+}
+
+func (g *governanceContract) viewProposalVotes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkViewFuncArguments(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	generalProposal, err := g.getGeneralProposal(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage("getGeneralProposal error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(big.NewInt(int64(generalProposal.Yes)).Bytes())
+	g.eei.Finish(big.NewInt(int64(generalProposal.No)).Bytes())
+	g.eei.Finish(big.NewInt(int64(generalProposal.Veto)).Bytes())
+	g.eei.Finish(big.NewInt(int64(generalProposal.DontCare)).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) viewVotingPower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkViewFuncArguments(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	numStakedNodes, err := g.numOfStakedNodes(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage("numOfStakedNodes error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(big.NewInt(int64(numStakedNodes)).Bytes())
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) viewConfig(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkViewFuncArguments(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	scConfig, err := g.getConfig()
+	if err != nil {
+		g.eei.AddReturnMessage("getConfig error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(big.NewInt(scConfig.NumNodes).Bytes())
+	g.eei.Finish(big.NewInt(int64(scConfig.MinQuorum)).Bytes())
+	g.eei.Finish(big.NewInt(int64(scConfig.MinPassThreshold)).Bytes())
+	g.eei.Finish(big.NewInt(int64(scConfig.MinVetoThreshold)).Bytes())
+	g.eei.Finish(scConfig.ProposalFee.Bytes())
+
+	return vmcommon.Ok
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (g *governanceContract) EpochConfirmed(epoch uint32) {
 	g.flagEnabled.Toggle(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagViewFunctions.Toggle(epoch >= g.viewFunctionsEpoch)
+	log.Debug("governance contract: view functions", "enabled", g.flagViewFunctions.IsSet())
 }
 
 // CanUseContract returns true if contract is enabled
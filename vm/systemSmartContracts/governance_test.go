@@ -669,3 +669,118 @@ func openProposal(t *testing.T, g *governanceContract, propType string, WLAddr,
 	retCode := g.Execute(callInput)
 	require.Equal(t, vmcommon.Ok, retCode)
 }
+
+func TestGovernanceContract_ExecuteViewFunctionsBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+
+	args := createMockGovernanceArgs()
+	args.Eei = eei
+	args.GovernanceConfig.ViewFunctionsEnableEpoch = 1
+	gsc, _ := NewGovernanceContract(args)
+
+	for _, function := range []string{"getProposal", "getProposalVotes", "getVotingPower", "getConfig"} {
+		eei.returnMessage = ""
+		callInput := createVMInput(big.NewInt(0), function, []byte("addr"), []byte("addr"))
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionNotFound, retCode)
+		require.Equal(t, "invalid method to call", eei.returnMessage)
+	}
+}
+
+func TestGovernanceContract_ExecuteViewFunctions(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	validatorAddress := []byte("vala1")
+	blsKey := []byte("blsKey1")
+	validatorData := &ValidatorDataV2{
+		NumRegistered: 1,
+		BlsPubKeys:    [][]byte{blsKey},
+	}
+	validatorDataBytes, _ := json.Marshal(validatorData)
+	eei.SetStorageForAddress(args.ValidatorSCAddress, validatorAddress, validatorDataBytes)
+	stakedDataBytes, _ := json.Marshal(&StakedDataV2_0{Staked: true})
+	eei.SetStorageForAddress(args.StakingSCAddress, blsKey, stakedDataBytes)
+
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	recipientAddr := []byte("recipientAddress")
+	proposerAddr := []byte("genesisAddr")
+	startNonce := uint64(100)
+	stopNonce := uint64(1000)
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+
+	initGovernanceSc(t, gsc, []byte("addr1"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, proposerAddr, recipientAddr)
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	openProposal(t, gsc, "proposal", proposerAddr, recipientAddr, gitHubCommit, startNonce, stopNonce)
+
+	returnData := executeGovernanceViewFunc(t, gsc, eei, "getProposal", gitHubCommit)
+	require.Equal(t, [][]byte{
+		proposerAddr,
+		gitHubCommit,
+		big.NewInt(int64(startNonce)).Bytes(),
+		big.NewInt(int64(stopNonce)).Bytes(),
+		[]byte("pending"),
+		[]byte("false"),
+	}, returnData)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return startNonce + 1
+	}
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, recipientAddr, "veto")
+
+	returnData = executeGovernanceViewFunc(t, gsc, eei, "getProposalVotes", gitHubCommit)
+	require.Equal(t, [][]byte{{}, {}, {1}, {}}, returnData)
+
+	returnData = executeGovernanceViewFunc(t, gsc, eei, "getProposal", gitHubCommit)
+	require.Equal(t, []byte("active"), returnData[4])
+
+	returnData = executeGovernanceViewFunc(t, gsc, eei, "getVotingPower", validatorAddress)
+	require.Equal(t, [][]byte{{1}}, returnData)
+
+	returnData = executeGovernanceViewFunc(t, gsc, eei, "getConfig")
+	require.Equal(t, [][]byte{{3}, {2}, {1}, {2}, {100}}, returnData)
+
+	callInput := createVMInput(big.NewInt(0), "getProposal", proposerAddr, recipientAddr)
+	callInput.Arguments = [][]byte{[]byte("missing proposal")}
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	callInput = createVMInput(big.NewInt(1), "getConfig", proposerAddr, recipientAddr)
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func executeGovernanceViewFunc(t *testing.T, g *governanceContract, eei *vmContext, funcName string, arguments ...[]byte) [][]byte {
+	numOutputs := len(eei.output)
+	callInput := createVMInput(big.NewInt(0), funcName, []byte("viewCaller"), []byte("recipientAddress"))
+	callInput.Arguments = arguments
+	retCode := g.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	return eei.output[numOutputs:]
+}
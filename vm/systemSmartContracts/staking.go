@@ -187,6 +187,8 @@ func (s *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return s.getRemainingUnbondPeriod(args)
 	case "getQueueRegisterNonceAndRewardAddress":
 		return s.getWaitingListRegisterNonceAndRewardAddress(args)
+	case "getQueueData":
+		return s.getWaitingListData(args)
 	case "updateConfigMinNodes":
 		return s.updateConfigMinNodes(args)
 	case "setOwnersOnAddresses":
@@ -1380,6 +1382,32 @@ func (s *stakingSC) getWaitingListRegisterNonceAndRewardAddress(args *vmcommon.C
 	return vmcommon.Ok
 }
 
+func (s *stakingSC) getWaitingListData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, s.stakeAccessAddr) {
+		s.eei.AddReturnMessage("this is only a view function")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		s.eei.AddReturnMessage("number of arguments must be equal to 0")
+		return vmcommon.UserError
+	}
+
+	waitingListData, err := s.getFirstElementsFromWaitingList(math.MaxUint32)
+	if err != nil {
+		s.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for i, stakedData := range waitingListData.stakedDataList {
+		s.eei.Finish([]byte(hex.EncodeToString(waitingListData.blsKeys[i])))
+		s.eei.Finish([]byte(hex.EncodeToString(stakedData.OwnerAddress)))
+		s.eei.Finish([]byte(hex.EncodeToString(stakedData.RewardAddress)))
+		s.eei.Finish([]byte(strconv.Itoa(int(stakedData.RegisterNonce))))
+	}
+
+	return vmcommon.Ok
+}
+
 func (s *stakingSC) setOwnersOnAddresses(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if !s.flagStakingV2.IsSet() {
 		s.eei.AddReturnMessage("invalid method to call")
@@ -1188,6 +1188,16 @@ func TestStakingSc_ExecuteStakeStakeStakeJailJailUnJailTwice(t *testing.T) {
 		}
 	}
 
+	outPut = doGetWaitingListData(t, stakingSmartContract, eei)
+	assert.Equal(t, 16, len(outPut))
+	expectedKeys := []string{"firsstKey", "secondKey", "fifthhKey", "sixthhKey"}
+	for i, blsKey := range expectedKeys {
+		assert.Equal(t, []byte(hex.EncodeToString([]byte(blsKey))), outPut[i*4])
+		assert.Equal(t, []byte(""), outPut[i*4+1])
+		assert.Equal(t, []byte(hex.EncodeToString(stakerAddress)), outPut[i*4+2])
+		assert.Equal(t, []byte(strconv.Itoa(0)), outPut[i*4+3])
+	}
+
 	stakingSmartContract.unBondPeriod = 0
 	doUnStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, []byte("secondKey"), vmcommon.Ok)
 	doUnBond(t, stakingSmartContract, stakingAccessAddress, []byte("secondKey"), vmcommon.Ok)
@@ -1909,6 +1919,19 @@ func doGetWaitingListRegisterNonceAndRewardAddress(t *testing.T, sc *stakingSC,
 	return eei.output[currentOutPutIndex:]
 }
 
+func doGetWaitingListData(t *testing.T, sc *stakingSC, eei *vmContext) [][]byte {
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "getQueueData"
+	arguments.CallerAddr = sc.stakeAccessAddr
+
+	currentOutPutIndex := len(eei.output)
+
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	return eei.output[currentOutPutIndex:]
+}
+
 func doGetWaitingListIndex(t *testing.T, sc *stakingSC, eei *vmContext, blsKey []byte, expectedCode vmcommon.ReturnCode, expectedIndex int) {
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "getQueueIndex"
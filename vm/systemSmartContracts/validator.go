@@ -729,7 +729,11 @@ func (v *validatorSC) stake(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 
 	lenArgs := len(args.Arguments)
 	if lenArgs == 0 {
-		return v.updateStakeValue(registrationData, args.CallerAddr)
+		returnCode := v.updateStakeValue(registrationData, args.CallerAddr)
+		if returnCode == vmcommon.Ok {
+			v.addStakeLogEntry(args, nil)
+		}
+		return returnCode
 	}
 
 	if !isNumArgsCorrectToStake(args.Arguments) {
@@ -805,9 +809,23 @@ func (v *validatorSC) stake(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return vmcommon.UserError
 	}
 
+	v.addStakeLogEntry(args, blsKeys)
+
 	return vmcommon.Ok
 }
 
+func (v *validatorSC) addStakeLogEntry(args *vmcommon.ContractCallInput, blsKeys [][]byte) {
+	topics := make([][]byte, 0, len(blsKeys)+2)
+	topics = append(topics, args.CallerAddr, args.CallValue.Bytes())
+	topics = append(topics, blsKeys...)
+
+	v.eei.AddLogEntry(&vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.RecipientAddr,
+		Topics:     topics,
+	})
+}
+
 func (v *validatorSC) activateStakingFor(
 	blsKeys [][]byte,
 	numQualified uint64,
@@ -311,6 +311,11 @@ func TestStakingValidatorSC_ExecuteStakeAddedNewPubKeysShouldWork(t *testing.T)
 
 	errCode := stakingValidatorSc.Execute(arguments)
 	assert.Equal(t, vmcommon.Ok, errCode)
+
+	logs := eei.CreateVMOutput().Logs
+	require.Equal(t, 1, len(logs))
+	assert.Equal(t, []byte("stake"), logs[0].Identifier)
+	assert.Equal(t, [][]byte{arguments.CallerAddr, arguments.CallValue.Bytes(), key1, key2}, logs[0].Topics)
 }
 
 func TestStakingAuctionSC_ExecuteStakeDoubleKeyAndCleanup(t *testing.T) {